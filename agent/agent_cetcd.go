@@ -27,13 +27,12 @@ import (
 // startCetcd starts cetcd. This assumes that etcd is already started.
 func startCetcd(fs *flags, t *transporterServer) error {
 	if !exist(fs.cetcdExec) {
-		return fmt.Errorf("cetcd binary %q does not exist", globalFlags.cetcdExec)
+		return fmt.Errorf("cetcd binary %q does not exist", fs.cetcdExec)
 	}
 
-	peerIPs := strings.Split(t.req.PeerIPsString, "___")
-	clientURLs := make([]string, len(peerIPs))
-	for i, u := range peerIPs {
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return err
 	}
 
 	var flags []string
@@ -41,17 +40,24 @@ func startCetcd(fs *flags, t *transporterServer) error {
 	case dbtesterpb.DatabaseID_cetcd__beta:
 		flags = []string{
 			// "-consuladdr", "0.0.0.0:8500",
-			"-consuladdr", fmt.Sprintf("%s:8500", peerIPs[t.req.IPIndex]),
-			"-etcd", clientURLs[t.req.IPIndex], // etcd endpoint
+			"-consuladdr", self.ClientAddr(),
+			"-etcd", etcdClientURL(t.req.DatabaseID, self), // etcd endpoint
 		}
 
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
+	ex, err := t.renderExtraOptions(fs)
+	if err != nil {
+		return err
+	}
+	flags = append(flags, ex.proxyFlags...)
+
 	flagString := strings.Join(flags, " ")
 
 	cmd := exec.Command(fs.cetcdExec, flags...)
+	cmd.Env = ex.env
 	cmd.Stdout = t.proxyDatabaseLogfile
 	cmd.Stderr = t.proxyDatabaseLogfile
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
@@ -15,8 +15,8 @@
 package agent
 
 import (
+	"encoding/json"
 	"fmt"
-	"os"
 	"os/exec"
 	"strings"
 
@@ -25,49 +25,128 @@ import (
 	"go.uber.org/zap"
 )
 
+// ConsulConfig is the Consul configuration file in JSON.
+// See https://www.consul.io/docs/agent/options.html#configuration_files for more.
+type ConsulConfig struct {
+	Datacenter            string             `json:"datacenter,omitempty"`
+	LeaveOnTerminate      bool               `json:"leave_on_terminate,omitempty"`
+	RaftSnapshotThreshold int64              `json:"raft_snapshot_threshold,omitempty"`
+	RaftSnapshotInterval  string             `json:"raft_snapshot_interval,omitempty"`
+	Performance           *ConsulPerformance `json:"performance,omitempty"`
+	Ports                 ConsulPorts        `json:"ports"`
+
+	// EnableDebug serves '/debug/pprof' for diagnostics.
+	EnableDebug bool `json:"enable_debug"`
+}
+
+// ConsulPerformance is the performance tuning of Consul.
+type ConsulPerformance struct {
+	RaftMultiplier uint32 `json:"raft_multiplier,omitempty"`
+}
+
+// ConsulPorts are the ports of the Consul agent, so that
+// several agents can run on the same host.
+type ConsulPorts struct {
+	HTTP    int64 `json:"http"`
+	Server  int64 `json:"server"`
+	SerfLAN int64 `json:"serf_lan"`
+	SerfWAN int64 `json:"serf_wan"`
+	// DNS is -1 to disable the DNS interface.
+	DNS int64 `json:"dns"`
+}
+
 // startConsul starts Consul.
 func startConsul(fs *flags, t *transporterServer) error {
-	if !exist(fs.consulExec) {
-		return fmt.Errorf("Consul binary %q does not exist", globalFlags.consulExec)
+	execPath := fs.databaseExec(t.req.DatabaseID, fs.consulExec)
+	if !exist(execPath) {
+		return fmt.Errorf("Consul binary %q does not exist", execPath)
 	}
 
-	if err := os.RemoveAll(fs.consulDataDir); err != nil {
+	peers, err := requestPeers(t.req)
+	if err != nil {
 		return err
 	}
+	self := peers[t.req.IPIndex]
+	serfLAN, serfWAN := self.ConsulSerfPorts()
 
-	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	cluster := clusterIPIndexes(t.req)
 
 	var flags []string
 	switch t.req.DatabaseID {
-	case dbtesterpb.DatabaseID_consul__v1_0_2:
-		switch t.req.IPIndex {
-		case 0: // leader
+	case dbtesterpb.DatabaseID_consul__v1_0_2, dbtesterpb.DatabaseID_consul:
+		switch {
+		case !t.joinExisting && int(t.req.IPIndex) == cluster[0]: // leader
 			flags = []string{
 				"agent",
 				"-server",
 				"-data-dir", fs.consulDataDir,
-				"-bind", peerIPs[t.req.IPIndex],
-				"-client", peerIPs[t.req.IPIndex],
-				"-bootstrap-expect", fmt.Sprintf("%d", len(peerIPs)),
+				"-bind", self.Host,
+				"-client", self.Host,
+				"-bootstrap-expect", fmt.Sprintf("%d", len(cluster)),
 			}
 		default:
+			join := peers[cluster[0]]
+			if t.joinExisting {
+				others, err := otherMembers(t.req)
+				if err != nil {
+					return err
+				}
+				join = others[0]
+			}
+			lan, _ := join.ConsulSerfPorts()
 			flags = []string{
 				"agent",
 				"-server",
 				"-data-dir", fs.consulDataDir,
-				"-bind", peerIPs[t.req.IPIndex],
-				"-client", peerIPs[t.req.IPIndex],
-				"-join", peerIPs[0],
+				"-bind", self.Host,
+				"-client", self.Host,
+				"-join", join.Addr(lan),
 			}
 		}
 
+		cfg := ConsulConfig{
+			Ports: ConsulPorts{
+				HTTP:    self.ClientPort,
+				Server:  self.PeerPort,
+				SerfLAN: serfLAN,
+				SerfWAN: serfWAN,
+				DNS:     -1,
+			},
+			EnableDebug: true,
+		}
+		if fc := t.req.Flag_Consul; fc != nil {
+			cfg.Datacenter = fc.Datacenter
+			cfg.LeaveOnTerminate = fc.LeaveOnTerminate
+			cfg.RaftSnapshotThreshold = fc.RaftSnapshotThreshold
+			cfg.RaftSnapshotInterval = fc.RaftSnapshotInterval
+			if fc.RaftMultiplier > 0 {
+				cfg.Performance = &ConsulPerformance{RaftMultiplier: fc.RaftMultiplier}
+			}
+		}
+		b, err := json.MarshalIndent(cfg, "", "  ")
+		if err != nil {
+			return err
+		}
+		t.lg.Info("writing Consul config file", zap.String("path", fs.consulConfig), zap.String("config", string(b)))
+		if err = toFile(string(b), fs.consulConfig); err != nil {
+			return err
+		}
+		flags = append(flags, "-config-file", fs.consulConfig)
+
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
+	ex, err := t.renderExtraOptions(fs)
+	if err != nil {
+		return err
+	}
+	flags = append(flags, ex.flags...)
+
 	flagString := strings.Join(flags, " ")
 
-	cmd := exec.Command(fs.consulExec, flags...)
+	cmd := exec.Command(execPath, flags...)
+	cmd.Env = ex.env
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
@@ -0,0 +1,72 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// startCustom starts the custom database with the command template,
+// in the shell. Extra flags are appended to the rendered command.
+func startCustom(fs *flags, t *transporterServer) error {
+	fl := t.req.Flag_Custom
+	if fl == nil || strings.TrimSpace(fl.Command) == "" {
+		return fmt.Errorf("database ID %q has no command", t.req.DatabaseID)
+	}
+
+	data, err := t.extraTemplateData(fs)
+	if err != nil {
+		return err
+	}
+	command, err := renderTemplate("command", fl.Command, data)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(fs.customDataDir, 0777); err != nil {
+		return err
+	}
+
+	ex, err := t.renderExtraOptions(fs)
+	if err != nil {
+		return err
+	}
+	for _, f := range ex.flags {
+		command += " " + shellQuote(f)
+	}
+
+	// 'exec' replaces the shell, so that signals reach the database
+	args := []string{shell, "-c", "exec " + command}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = ex.env
+	cmd.Stdout = t.databaseLogFile
+	cmd.Stderr = t.databaseLogFile
+	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(args[1:], " "))
+
+	t.lg.Info("starting database", zap.String("command", cs))
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	t.cmd = cmd
+	t.cmdWait = make(chan struct{})
+	t.pid = int64(cmd.Process.Pid)
+	t.lg.Info("started database", zap.String("command", cs), zap.Int64("pid", t.pid))
+
+	return nil
+}
@@ -16,7 +16,6 @@ package agent
 
 import (
 	"fmt"
-	"os"
 	"os/exec"
 	"strings"
 
@@ -25,118 +24,105 @@ import (
 	"go.uber.org/zap"
 )
 
-// startEtcd starts etcd v3.
-func startEtcd(fs *flags, t *transporterServer) error {
-	if !exist(fs.etcdExec) {
-		return fmt.Errorf("etcd binary %q does not exist", globalFlags.etcdExec)
-	}
+const etcdClusterToken = "mytoken"
 
-	if err := os.RemoveAll(fs.etcdDataDir); err != nil {
-		return err
-	}
+// etcdMember is the etcd configuration of this member.
+type etcdMember struct {
+	name      string
+	clientURL string
+	peerURL   string
 
-	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	initialCluster string
+	clusterState   string
+}
 
-	names := make([]string, len(peerIPs))
-	clientURLs := make([]string, len(peerIPs))
-	peerURLs := make([]string, len(peerIPs))
-	members := make([]string, len(peerIPs))
-	for i, u := range peerIPs {
-		names[i] = fmt.Sprintf("etcd-%d", i+1)
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
-		peerURLs[i] = fmt.Sprintf("http://%s:2380", u)
-		members[i] = fmt.Sprintf("%s=%s", names[i], peerURLs[i])
+// newEtcdMember returns the configuration of the member at 'IPIndex',
+// in the cluster of 'ClusterIPIndexes'.
+func newEtcdMember(t *transporterServer) (etcdMember, error) {
+	peers, err := requestPeers(t.req)
+	if err != nil {
+		return etcdMember{}, err
 	}
 
-	var flags []string
-	switch t.req.DatabaseID {
-	case dbtesterpb.DatabaseID_etcd__other:
-		flags = []string{
-			"--name", names[t.req.IPIndex],
-			"--data-dir", fs.etcdDataDir,
-			"--quota-backend-bytes", fmt.Sprintf("%d", t.req.Flag_Etcd_Other.QuotaSizeBytes),
-
-			"--snapshot-count", fmt.Sprintf("%d", t.req.Flag_Etcd_Other.SnapshotCount),
-
-			"--listen-client-urls", clientURLs[t.req.IPIndex],
-			"--advertise-client-urls", clientURLs[t.req.IPIndex],
-
-			"--listen-peer-urls", peerURLs[t.req.IPIndex],
-			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
-
-			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
-			"--logger", "zap",
-			"--log-outputs", "stderr",
-		}
-
-	case dbtesterpb.DatabaseID_etcd__tip:
-		flags = []string{
-			"--name", names[t.req.IPIndex],
-			"--data-dir", fs.etcdDataDir,
-			"--quota-backend-bytes", fmt.Sprintf("%d", t.req.Flag_Etcd_Tip.QuotaSizeBytes),
-
-			"--snapshot-count", fmt.Sprintf("%d", t.req.Flag_Etcd_Tip.SnapshotCount),
-
-			"--listen-client-urls", clientURLs[t.req.IPIndex],
-			"--advertise-client-urls", clientURLs[t.req.IPIndex],
+	names := make([]string, len(peers))
+	clientURLs := make([]string, len(peers))
+	peerURLs := make([]string, len(peers))
+	for i, p := range peers {
+		names[i] = fmt.Sprintf("etcd-%d", i+1)
+		clientURLs[i] = etcdClientURL(t.req.DatabaseID, p)
+		peerURLs[i] = "http://" + p.PeerAddr()
+	}
+	var members []string
+	for _, i := range clusterIPIndexes(t.req) {
+		members = append(members, fmt.Sprintf("%s=%s", names[i], peerURLs[i]))
+	}
+	clusterState := "new"
+	if t.joinExisting {
+		clusterState = "existing"
+	}
 
-			"--listen-peer-urls", peerURLs[t.req.IPIndex],
-			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
+	return etcdMember{
+		name:           names[t.req.IPIndex],
+		clientURL:      clientURLs[t.req.IPIndex],
+		peerURL:        peerURLs[t.req.IPIndex],
+		initialCluster: strings.Join(members, ","),
+		clusterState:   clusterState,
+	}, nil
+}
 
-			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
-			"--logger", "zap",
-			"--log-outputs", "stderr",
-		}
+// startEtcd starts etcd v3.
+func startEtcd(fs *flags, t *transporterServer) error {
+	execPath := fs.databaseExec(t.req.DatabaseID, fs.etcdExec)
+	if !exist(execPath) {
+		return fmt.Errorf("etcd binary %q does not exist", execPath)
+	}
 
-	case dbtesterpb.DatabaseID_etcd__v3_2:
-		flags = []string{
-			"--name", names[t.req.IPIndex],
-			"--data-dir", fs.etcdDataDir,
-			"--quota-backend-bytes", fmt.Sprintf("%d", t.req.Flag_Etcd_V3_2.QuotaSizeBytes),
+	m, err := newEtcdMember(t)
+	if err != nil {
+		return err
+	}
 
-			"--snapshot-count", fmt.Sprintf("%d", t.req.Flag_Etcd_V3_2.SnapshotCount),
+	flags := []string{
+		"--name", m.name,
+		"--data-dir", fs.etcdDataDir,
 
-			"--listen-client-urls", clientURLs[t.req.IPIndex],
-			"--advertise-client-urls", clientURLs[t.req.IPIndex],
+		"--listen-client-urls", m.clientURL,
+		"--advertise-client-urls", m.clientURL,
 
-			"--listen-peer-urls", peerURLs[t.req.IPIndex],
-			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
+		"--listen-peer-urls", m.peerURL,
+		"--initial-advertise-peer-urls", m.peerURL,
 
-			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
+		"--initial-cluster-token", etcdClusterToken,
+		"--initial-cluster", m.initialCluster,
+		"--initial-cluster-state", m.clusterState,
+		"--enable-pprof",
+	}
+	if fl := t.req.Flag_Etcd; fl != nil {
+		if fl.QuotaSizeBytes > 0 {
+			flags = append(flags, "--quota-backend-bytes", fmt.Sprintf("%d", fl.QuotaSizeBytes))
 		}
-
-	case dbtesterpb.DatabaseID_etcd__v3_3:
-		flags = []string{
-			"--name", names[t.req.IPIndex],
-			"--data-dir", fs.etcdDataDir,
-			"--quota-backend-bytes", fmt.Sprintf("%d", t.req.Flag_Etcd_V3_3.QuotaSizeBytes),
-
-			"--snapshot-count", fmt.Sprintf("%d", t.req.Flag_Etcd_V3_3.SnapshotCount),
-
-			"--listen-client-urls", clientURLs[t.req.IPIndex],
-			"--advertise-client-urls", clientURLs[t.req.IPIndex],
-
-			"--listen-peer-urls", peerURLs[t.req.IPIndex],
-			"--initial-advertise-peer-urls", peerURLs[t.req.IPIndex],
-
-			"--initial-cluster-token", "mytoken",
-			"--initial-cluster", strings.Join(members, ","),
-			"--initial-cluster-state", "new",
+		if fl.SnapshotCount > 0 {
+			flags = append(flags, "--snapshot-count", fmt.Sprintf("%d", fl.SnapshotCount))
 		}
+	}
+	// structured logging is since etcd v3.4
+	switch {
+	case t.req.DatabaseID == dbtesterpb.DatabaseID_etcd__other,
+		t.req.DatabaseID == dbtesterpb.DatabaseID_etcd__tip,
+		versionAtLeast(t.databaseVersion, 3, 4):
+		flags = append(flags, "--logger", "zap", "--log-outputs", "stderr")
+	}
 
-	default:
-		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	ex, err := t.renderExtraOptions(fs)
+	if err != nil {
+		return err
 	}
+	flags = append(flags, ex.flags...)
 
 	flagString := strings.Join(flags, " ")
 
-	cmd := exec.Command(fs.etcdExec, flags...)
+	cmd := exec.Command(execPath, flags...)
+	cmd.Env = ex.env
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
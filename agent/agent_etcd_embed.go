@@ -0,0 +1,111 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"syscall"
+
+	"github.com/coreos/etcd/embed"
+	"github.com/coreos/pkg/capnslog"
+	"go.uber.org/zap"
+)
+
+// startEmbeddedEtcd starts etcd in the agent process, and closes
+// 't.cmdWait' after the server stops and its listeners are closed.
+// The agent process is the database process (e.g. for metrics),
+// so resource limits and extra options are not supported.
+func startEmbeddedEtcd(fs *flags, t *transporterServer) error {
+	if t.req.ResourceLimits != nil {
+		return fmt.Errorf("%q runs in the agent process, and does not support resource limits", t.req.DatabaseID)
+	}
+	if t.req.ExtraOptions != nil {
+		return fmt.Errorf("%q runs in the agent process, and does not support extra options", t.req.DatabaseID)
+	}
+
+	m, err := newEtcdMember(t)
+	if err != nil {
+		return err
+	}
+	clientURL, err := url.Parse(m.clientURL)
+	if err != nil {
+		return err
+	}
+	peerURL, err := url.Parse(m.peerURL)
+	if err != nil {
+		return err
+	}
+
+	cfg := embed.NewConfig()
+	cfg.Name = m.name
+	cfg.Dir = fs.etcdDataDir
+	cfg.LCUrls, cfg.ACUrls = []url.URL{*clientURL}, []url.URL{*clientURL}
+	cfg.LPUrls, cfg.APUrls = []url.URL{*peerURL}, []url.URL{*peerURL}
+	cfg.InitialClusterToken = etcdClusterToken
+	cfg.InitialCluster = m.initialCluster
+	cfg.ClusterState = m.clusterState
+	cfg.EnablePprof = true
+	if fl := t.req.Flag_Etcd; fl != nil {
+		if fl.QuotaSizeBytes > 0 {
+			cfg.QuotaBackendBytes = fl.QuotaSizeBytes
+		}
+		if fl.SnapshotCount > 0 {
+			cfg.SnapCount = uint64(fl.SnapshotCount)
+		}
+	}
+	// etcd v3.3 logs with capnslog, which is global to the process
+	// (e.g. with 'dbtester local', all members log to the last member)
+	capnslog.SetFormatter(capnslog.NewPrettyFormatter(t.databaseLogFile, false))
+
+	t.lg.Info("starting database", zap.String("database", t.req.DatabaseID.String()), zap.String("name", cfg.Name))
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		return err
+	}
+	t.cmd = nil
+	t.embedded = e
+	t.cmdWait = make(chan struct{})
+	t.pid = int64(os.Getpid())
+
+	id := t.req.DatabaseID.String()
+	go func(e *embed.Etcd, cmdWait chan struct{}) {
+		defer close(cmdWait)
+		<-e.Server.StopNotify()
+		// release the ports, so that the member can restart
+		e.Close()
+		t.lg.Info("exiting", zap.String("database", id))
+	}(e, t.cmdWait)
+	t.lg.Info("started database", zap.String("database", id), zap.Int64("pid", t.pid))
+
+	return nil
+}
+
+// signalEmbeddedEtcd emulates the signal on the etcd server in the
+// agent process, and returns immediately as the signals do.
+func (t *transporterServer) signalEmbeddedEtcd(sig syscall.Signal) error {
+	switch sig {
+	case syscall.SIGKILL:
+		// no leadership transfer, as if the process had crashed
+		go t.embedded.Server.HardStop()
+	case syscall.SIGINT, syscall.SIGTERM:
+		go t.embedded.Server.Stop()
+	default:
+		// e.g. SIGSTOP would freeze the agent
+		return fmt.Errorf("%q runs in the agent process, and does not support signal %d (%v)", t.req.DatabaseID, sig, sig)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// TestEmbeddedEtcd kills, restarts and stops the etcd server in the
+// agent process, which must release its ports after stopping.
+func TestEmbeddedEtcd(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dbtester-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs := globalFlags.withDir(dir)
+	srv := newServer(zap.NewNop(), &fs)
+	if srv.databaseLogFile, err = openToAppend(fs.databaseLog); err != nil {
+		t.Fatal(err)
+	}
+	defer srv.databaseLogFile.Close()
+	srv.req = dbtesterpb.Request{
+		DatabaseID:    dbtesterpb.DatabaseID_etcd__embed,
+		PeerIPsString: "127.0.0.1:22379:22380",
+	}
+	if err = srv.detectVersion(); err != nil {
+		t.Fatal(err)
+	}
+	if err = srv.startDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = srv.waitReady(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = srv.Transfer(context.Background(), &dbtesterpb.Request{Operation: dbtesterpb.Operation_Pause}); err == nil {
+		t.Fatal("expected error on pause")
+	}
+	for _, op := range []dbtesterpb.Operation{dbtesterpb.Operation_Kill, dbtesterpb.Operation_Restart} {
+		if _, err = srv.Transfer(context.Background(), &dbtesterpb.Request{Operation: op}); err != nil {
+			t.Fatalf("%v failed (%v)", op, err)
+		}
+	}
+	if _, err = srv.waitReady(); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.stopDatabase()
+	if !srv.databaseExited() {
+		t.Fatal("embedded etcd has not exited")
+	}
+	for _, addr := range []string{"127.0.0.1:22379", "127.0.0.1:22380"} {
+		ln, err := net.Listen("tcp", addr)
+		if err != nil {
+			t.Fatalf("%q is not released (%v)", addr, err)
+		}
+		ln.Close()
+	}
+}
@@ -27,13 +27,12 @@ import (
 // startZetcd starts zetcd. This assumes that etcd is already started.
 func startZetcd(fs *flags, t *transporterServer) error {
 	if !exist(fs.zetcdExec) {
-		return fmt.Errorf("zetcd binary %q does not exist", globalFlags.zetcdExec)
+		return fmt.Errorf("zetcd binary %q does not exist", fs.zetcdExec)
 	}
 
-	peerIPs := strings.Split(t.req.PeerIPsString, "___")
-	clientURLs := make([]string, len(peerIPs))
-	for i, u := range peerIPs {
-		clientURLs[i] = fmt.Sprintf("http://%s:2379", u)
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return err
 	}
 
 	var flags []string
@@ -41,17 +40,24 @@ func startZetcd(fs *flags, t *transporterServer) error {
 	case dbtesterpb.DatabaseID_zetcd__beta:
 		flags = []string{
 			// "-zkaddr", "0.0.0.0:2181",
-			"-zkaddr", fmt.Sprintf("%s:2181", peerIPs[t.req.IPIndex]),
-			"-endpoint", clientURLs[t.req.IPIndex],
+			"-zkaddr", self.ClientAddr(),
+			"-endpoint", etcdClientURL(t.req.DatabaseID, self),
 		}
 
 	default:
 		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
 	}
 
+	ex, err := t.renderExtraOptions(fs)
+	if err != nil {
+		return err
+	}
+	flags = append(flags, ex.proxyFlags...)
+
 	flagString := strings.Join(flags, " ")
 
 	cmd := exec.Command(fs.zetcdExec, flags...)
+	cmd.Env = ex.env
 	cmd.Stdout = t.proxyDatabaseLogfile
 	cmd.Stderr = t.proxyDatabaseLogfile
 	cs := fmt.Sprintf("%s %s", cmd.Path, flagString)
@@ -21,9 +21,11 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
-	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/gclog"
 
 	"go.uber.org/zap"
 )
@@ -36,7 +38,11 @@ initLimit={{.InitLimit}}
 syncLimit={{.SyncLimit}}
 maxClientCnxns={{.MaxClientConnections}}
 snapCount={{.SnapCount}}
-{{range .Peers}}server.{{.MyID}}={{.IP}}:2888:3888
+4lw.commands.whitelist=ruok,srvr,mntr
+admin.enableServer=false
+{{if .ReconfigEnabled}}reconfigEnabled=true
+standaloneEnabled=false
+{{end}}{{range .Peers}}server.{{.MyID}}={{.IP}}:{{.QuorumPort}}:{{.ElectionPort}}
 {{end}}
 `
 )
@@ -52,12 +58,18 @@ type ZookeeperConfig struct {
 	MaxClientConnections int64
 	SnapCount            int64
 	Peers                []ZookeeperPeer
+
+	// ReconfigEnabled enables dynamic reconfiguration,
+	// when the cluster membership changes.
+	ReconfigEnabled bool
 }
 
 // ZookeeperPeer defines Zookeeper peer configuration.
 type ZookeeperPeer struct {
-	MyID int
-	IP   string
+	MyID         int
+	IP           string
+	QuorumPort   int64
+	ElectionPort int64
 }
 
 var shell = os.Getenv("SHELL")
@@ -68,24 +80,63 @@ func init() {
 	}
 }
 
-// Java class paths for Zookeeper.
-// '-cp' is for 'class search path of directories and zip/jar files'.
-// See https://zookeeper.apache.org/doc/trunk/zookeeperAdmin.html for more.
-// UPDATE FOR EACH ZOOKEEPER RELEASES!
-// Search correct paths with 'find ./zookeeper/lib | sort'.
+// Java main classes of Zookeeper server and command line client.
+// See http://zookeeper.apache.org/doc/r3.5.3-beta/zookeeperAdmin.html#sc_zkMulitServerSetup for more.
 const (
-	// JavaClassPathZookeeperr353beta is the Java class paths of Zookeeper r3.5.3-beta.
-	// http://zookeeper.apache.org/doc/r3.5.3-beta/zookeeperAdmin.html#sc_zkMulitServerSetup
-	JavaClassPathZookeeperr353beta = `-cp zookeeper-3.5.3-beta.jar:lib/slf4j-api-1.7.5.jar:lib/slf4j-log4j12-1.7.5.jar:lib/log4j-1.2.17.jar:conf org.apache.zookeeper.server.quorum.QuorumPeerMain`
+	zookeeperServerMain = "org.apache.zookeeper.server.quorum.QuorumPeerMain"
+	zookeeperCLIMain    = "org.apache.zookeeper.ZooKeeperMain"
 )
 
+// zookeeperJars returns the jar files in the Zookeeper working directory
+// and its 'lib', relative to the working directory, with the Zookeeper
+// jar first. Releases since 3.5.5 keep the Zookeeper jar under 'lib'.
+func zookeeperJars(workDir string) ([]string, error) {
+	var jars []string
+	for _, dir := range []string{".", "lib"} {
+		fs, err := filepath.Glob(filepath.Join(workDir, dir, "*.jar"))
+		if err != nil {
+			return nil, err
+		}
+		for _, fpath := range fs {
+			jars = append(jars, filepath.Join(dir, filepath.Base(fpath)))
+		}
+	}
+	sort.Strings(jars)
+
+	for i, jar := range jars {
+		if isZookeeperJar(jar) {
+			return append([]string{jar}, append(jars[:i:i], jars[i+1:]...)...), nil
+		}
+	}
+	return nil, fmt.Errorf("no Zookeeper jar in %q", workDir)
+}
+
+// isZookeeperJar returns true if the jar is the Zookeeper server
+// (e.g. "zookeeper-3.5.3-beta.jar", not "zookeeper-jute-3.5.5.jar").
+func isZookeeperJar(jar string) bool {
+	name := filepath.Base(jar)
+	if !strings.HasPrefix(name, "zookeeper-") || !strings.HasSuffix(name, ".jar") {
+		return false
+	}
+	rest := strings.TrimPrefix(name, "zookeeper-")
+	return len(rest) > 0 && rest[0] >= '0' && rest[0] <= '9'
+}
+
+// zookeeperClassPath returns the Java class paths of the main class,
+// with all jars in the Zookeeper working directory and its 'lib'.
+// '-cp' is for 'class search path of directories and zip/jar files'.
+func zookeeperClassPath(workDir, mainClass string) (string, error) {
+	jars, err := zookeeperJars(workDir)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("-cp %s:conf %s", strings.Join(jars, ":"), mainClass), nil
+}
+
 // startZookeeper starts Zookeeper.
 func startZookeeper(fs *flags, t *transporterServer) error {
 	if !exist(fs.javaExec) {
-		return fmt.Errorf("Java binary %q does not exist", globalFlags.javaExec)
-	}
-	if err := os.RemoveAll(fs.zkDataDir); err != nil {
-		return err
+		return fmt.Errorf("Java binary %q does not exist", fs.javaExec)
 	}
 	if err := os.MkdirAll(fs.zkDataDir, 0777); err != nil {
 		return err
@@ -97,45 +148,50 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 		return err
 	}
 
-	ipath := filepath.Join(fs.zkDataDir, "myid")
-	switch t.req.DatabaseID {
-	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-		if t.req.Flag_Zookeeper_R3_5_3Beta == nil {
-			return fmt.Errorf("request 'Flag_Zookeeper_R3_5_3Beta' is nil")
-		}
-		t.lg.Info(
-			"writing Zookeeper myid file",
-			zap.Int("myid", int(t.req.Flag_Zookeeper_R3_5_3Beta.MyID)),
-			zap.String("path", ipath),
-		)
-		if err := toFile(fmt.Sprintf("%d", t.req.Flag_Zookeeper_R3_5_3Beta.MyID), ipath); err != nil {
-			return err
-		}
+	fl := t.req.Flag_Zookeeper
+	if fl == nil {
+		return fmt.Errorf("request has no Zookeeper flags for %q", t.req.DatabaseID)
+	}
+	cp, err := zookeeperClassPath(fs.zkWorkDir, zookeeperServerMain)
+	if err != nil {
+		return err
+	}
 
-	default:
-		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	ipath := filepath.Join(fs.zkDataDir, "myid")
+	t.lg.Info(
+		"writing Zookeeper myid file",
+		zap.Int("myid", int(fl.MyID)),
+		zap.String("path", ipath),
+	)
+	if err := toFile(fmt.Sprintf("%d", fl.MyID), ipath); err != nil {
+		return err
 	}
 
-	var cfg ZookeeperConfig
-	peerIPs := strings.Split(t.req.PeerIPsString, "___")
+	members, err := requestPeers(t.req)
+	if err != nil {
+		return err
+	}
 	peers := []ZookeeperPeer{}
-	for i := range peerIPs {
-		peers = append(peers, ZookeeperPeer{MyID: i + 1, IP: peerIPs[i]})
-	}
-	switch t.req.DatabaseID {
-	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-		cfg = ZookeeperConfig{
-			TickTime:             t.req.Flag_Zookeeper_R3_5_3Beta.TickTime,
-			DataDir:              fs.zkDataDir,
-			ClientPort:           t.req.Flag_Zookeeper_R3_5_3Beta.ClientPort,
-			InitLimit:            t.req.Flag_Zookeeper_R3_5_3Beta.InitLimit,
-			SyncLimit:            t.req.Flag_Zookeeper_R3_5_3Beta.SyncLimit,
-			MaxClientConnections: t.req.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections,
-			Peers:                peers,
-			SnapCount:            t.req.Flag_Zookeeper_R3_5_3Beta.SnapCount,
-		}
-	default:
-		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	for _, i := range clusterIPIndexes(t.req) {
+		peers = append(peers, ZookeeperPeer{
+			MyID:         i + 1,
+			IP:           members[i].Host,
+			QuorumPort:   members[i].PeerPort,
+			ElectionPort: members[i].ZookeeperElectionPort(),
+		})
+	}
+	// membership changes with 'reconfig' always set 'ClusterIPIndexes'
+	reconfig := len(t.req.ClusterIPIndexes) > 0
+	cfg := ZookeeperConfig{
+		TickTime:             fl.TickTime,
+		DataDir:              fs.zkDataDir,
+		ClientPort:           members[t.req.IPIndex].ClientPort,
+		InitLimit:            fl.InitLimit,
+		SyncLimit:            fl.SyncLimit,
+		MaxClientConnections: fl.MaxClientConnections,
+		Peers:                peers,
+		SnapCount:            fl.SnapCount,
+		ReconfigEnabled:      reconfig,
 	}
 	tpl := template.Must(template.New("zkTemplate").Parse(zkTemplate))
 	buf := new(bytes.Buffer)
@@ -143,44 +199,77 @@ func startZookeeper(fs *flags, t *transporterServer) error {
 		return err
 	}
 	zctxt := buf.String()
+
+	ex, err := t.renderExtraOptions(fs)
+	if err != nil {
+		return err
+	}
+	if ex.config != "" {
+		// later keys override the ones above
+		zctxt += "\n" + ex.config
+	}
 	t.lg.Info("writing Zookeeper config file", zap.String("path", fs.zkConfig))
 	if err := toFile(zctxt, fs.zkConfig); err != nil {
 		return err
 	}
 
 	var flagString string
-	switch t.req.DatabaseID {
-	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-		if t.req.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer != 0 {
-			if len(flagString) > 0 {
-				flagString += " "
-			}
-			flagString += fmt.Sprintf("-Djute.maxbuffer=%d", t.req.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer)
+	if fl.JavaDJuteMaxBuffer != 0 {
+		if len(flagString) > 0 {
+			flagString += " "
+		}
+		flagString += fmt.Sprintf("-Djute.maxbuffer=%d", fl.JavaDJuteMaxBuffer)
+	}
+	if fl.JavaDJuteMaxBuffer != 0 {
+		if len(flagString) > 0 {
+			flagString += " "
 		}
-		if t.req.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer != 0 {
-			if len(flagString) > 0 {
-				flagString += " "
-			}
-			flagString += fmt.Sprintf("-Xms%s", t.req.Flag_Zookeeper_R3_5_3Beta.JavaXms)
+		flagString += fmt.Sprintf("-Xms%s", fl.JavaXms)
+	}
+	if fl.JavaDJuteMaxBuffer != 0 {
+		if len(flagString) > 0 {
+			flagString += " "
+		}
+		flagString += fmt.Sprintf("-Xmx%s", fl.JavaXmx)
+	}
+	// 'reconfig' requires super user, unless ACL is skipped
+	if reconfig {
+		if len(flagString) > 0 {
+			flagString += " "
 		}
-		if t.req.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer != 0 {
-			if len(flagString) > 0 {
-				flagString += " "
-			}
-			flagString += fmt.Sprintf("-Xmx%s", t.req.Flag_Zookeeper_R3_5_3Beta.JavaXmx)
+		flagString += "-Dzookeeper.skipACL=yes"
+	}
+	// the JVM overwrites the GC log on start, so keep the one
+	// before restart, to be merged on stop
+	if exist(fs.zkGCLog) {
+		if err := os.Rename(fs.zkGCLog, fmt.Sprintf("%s.%d", fs.zkGCLog, time.Now().UnixNano())); err != nil {
+			return err
 		}
-		// -Djute.maxbuffer=33554432 -Xms50G -Xmx50G
+	}
+	javaMajor, err := javaMajorVersion(fs.javaExec)
+	if err != nil {
+		return err
+	}
+	for _, f := range gclog.Flags(fs.zkGCLog, javaMajor) {
 		if len(flagString) > 0 {
 			flagString += " "
 		}
-		flagString += JavaClassPathZookeeperr353beta
+		flagString += shellQuote(f)
+	}
+	for _, f := range ex.flags {
+		flagString += " " + shellQuote(f)
+	}
 
-	default:
-		return fmt.Errorf("database ID %q is not supported", t.req.DatabaseID)
+	// -Djute.maxbuffer=33554432 -Xms50G -Xmx50G
+	if len(flagString) > 0 {
+		flagString += " "
 	}
+	flagString += cp
 
-	args := []string{shell, "-c", fs.javaExec + " " + flagString + " " + fs.zkConfig}
+	// 'exec' replaces the shell, so that signals reach the JVM
+	args := []string{shell, "-c", "exec " + fs.javaExec + " " + flagString + " " + fs.zkConfig}
 	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Env = ex.env
 	cmd.Stdout = t.databaseLogFile
 	cmd.Stderr = t.databaseLogFile
 	cs := fmt.Sprintf("%s %s", cmd.Path, strings.Join(args[1:], " "))
@@ -19,6 +19,8 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
 	"github.com/etcd-io/dbtester/pkg/ntp"
@@ -31,10 +33,19 @@ import (
 )
 
 type flags struct {
-	agentLog                     string
-	databaseLog                  string
-	systemMetricsCSV             string
-	systemMetricsCSVInterpolated string
+	agentLog                       string
+	databaseLog                    string
+	systemMetricsCSV               string
+	systemMetricsCSVInterpolated   string
+	systemIOMetricsCSV             string
+	systemIOMetricsCSVInterpolated string
+	metricsInterval                time.Duration
+	databaseMetricsCSV             string
+	processMetricsCSV              string
+	diskUsageCSV                   string
+	diskUsageInterval              time.Duration
+	gcMetricsCSV                   string
+	trackDescendants               bool
 
 	javaExec   string
 	etcdExec   string
@@ -42,11 +53,28 @@ type flags struct {
 	cetcdExec  string
 	consulExec string
 
+	// databaseExecs are 'database ID=executable binary path' pairs,
+	// to run different versions of the same database
+	databaseExecs []string
+
+	iptablesExec string
+	tcExec       string
+
 	zkWorkDir     string
 	zkDataDir     string
 	zkConfig      string
+	zkGCLog       string
 	etcdDataDir   string
 	consulDataDir string
+	consulConfig  string
+	customDataDir string
+	snapshotDir   string
+
+	diagnosticsDir string
+	extraConfig    string
+
+	cgroupMount  string
+	cgroupParent string
 
 	grpcPort         string
 	diskDevice       string
@@ -75,6 +103,15 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.databaseLog, "database-log", filepath.Join(homeDir(), "database.log"), "Database log path.")
 	Command.PersistentFlags().StringVar(&globalFlags.systemMetricsCSV, "system-metrics-csv", filepath.Join(homeDir(), "server-system-metrics.csv"), "Raw system metrics data path.")
 	Command.PersistentFlags().StringVar(&globalFlags.systemMetricsCSVInterpolated, "system-metrics-csv-interpolated", filepath.Join(homeDir(), "server-system-metrics-interpolated.csv"), "Interpolated system metrics data path.")
+	Command.PersistentFlags().StringVar(&globalFlags.systemIOMetricsCSV, "system-io-metrics-csv", filepath.Join(homeDir(), "server-system-io-metrics.csv"), "Raw storage I/O metrics of the database process data path.")
+	Command.PersistentFlags().StringVar(&globalFlags.systemIOMetricsCSVInterpolated, "system-io-metrics-csv-interpolated", filepath.Join(homeDir(), "server-system-io-metrics-interpolated.csv"), "Interpolated storage I/O metrics of the database process data path.")
+	Command.PersistentFlags().DurationVar(&globalFlags.metricsInterval, "metrics-interval", time.Second, "System metrics sample interval (minimum 100ms).")
+	Command.PersistentFlags().StringVar(&globalFlags.databaseMetricsCSV, "database-metrics-csv", filepath.Join(homeDir(), "server-database-metrics.csv"), "Database metrics data path (e.g. etcd '/metrics', Zookeeper 'mntr').")
+	Command.PersistentFlags().StringVar(&globalFlags.processMetricsCSV, "process-metrics-csv", filepath.Join(homeDir(), "server-process-metrics.csv"), "Proxy and descendant process metrics data path (descendant metrics are the totals over all descendants).")
+	Command.PersistentFlags().BoolVar(&globalFlags.trackDescendants, "track-descendants", false, "'true' to collect metrics of all descendant processes of the database and proxy.")
+	Command.PersistentFlags().StringVar(&globalFlags.diskUsageCSV, "disk-usage-csv", filepath.Join(homeDir(), "server-disk-usage.csv"), "Data directory size by category (e.g. WAL, snapshot) data path.")
+	Command.PersistentFlags().DurationVar(&globalFlags.diskUsageInterval, "disk-usage-interval", 5*time.Second, "Interval to measure the data directory size.")
+	Command.PersistentFlags().StringVar(&globalFlags.gcMetricsCSV, "gc-metrics-csv", filepath.Join(homeDir(), "server-gc-metrics.csv"), "Per-second JVM GC pause data path (Zookeeper only).")
 
 	Command.PersistentFlags().StringVar(&globalFlags.javaExec, "java-exec", "/usr/bin/java", "Java executable binary path (needed for Zookeeper).")
 	Command.PersistentFlags().StringVar(&globalFlags.etcdExec, "etcd-exec", filepath.Join(os.Getenv("GOPATH"), "bin/etcd"), "etcd executable binary path.")
@@ -82,11 +119,24 @@ func init() {
 	Command.PersistentFlags().StringVar(&globalFlags.cetcdExec, "cetcd-exec", filepath.Join(os.Getenv("GOPATH"), "bin/cetcd"), "cetcd executable binary path .")
 	Command.PersistentFlags().StringVar(&globalFlags.consulExec, "consul-exec", filepath.Join(os.Getenv("GOPATH"), "bin/consul"), "Consul executable binary path.")
 
+	Command.PersistentFlags().StringSliceVar(&globalFlags.databaseExecs, "database-exec", nil, "Executable binary paths per database ID (e.g. 'etcd__v3_2=/opt/etcd-v3.2/etcd,etcd__v3_3=/opt/etcd-v3.3/etcd'), which override the paths above.")
+
+	Command.PersistentFlags().StringVar(&globalFlags.iptablesExec, "iptables-exec", "/sbin/iptables", "iptables executable binary path (needed for network partition).")
+	Command.PersistentFlags().StringVar(&globalFlags.tcExec, "tc-exec", "/sbin/tc", "tc executable binary path (needed for network delay and loss).")
+
 	Command.PersistentFlags().StringVar(&globalFlags.zkWorkDir, "zookeeper-work-dir", filepath.Join(homeDir(), "zookeeper"), "Zookeeper working directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.zkDataDir, "zookeeper-data-dir", filepath.Join(homeDir(), "zookeeper/zookeeper.data"), "Zookeeper data directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.zkConfig, "zookeeper-config", filepath.Join(homeDir(), "zookeeper/zookeeper.config"), "Zookeeper configuration file path.")
+	Command.PersistentFlags().StringVar(&globalFlags.zkGCLog, "zookeeper-gc-log", filepath.Join(homeDir(), "zookeeper-gc.log"), "Zookeeper JVM GC log path.")
 	Command.PersistentFlags().StringVar(&globalFlags.etcdDataDir, "etcd-data-dir", filepath.Join(homeDir(), "etcd.data"), "etcd data directory.")
 	Command.PersistentFlags().StringVar(&globalFlags.consulDataDir, "consul-data-dir", filepath.Join(homeDir(), "consul.data"), "Consul data directory.")
+	Command.PersistentFlags().StringVar(&globalFlags.consulConfig, "consul-config", filepath.Join(homeDir(), "consul.json"), "Consul configuration file path.")
+	Command.PersistentFlags().StringVar(&globalFlags.customDataDir, "custom-data-dir", filepath.Join(homeDir(), "custom.data"), "Data directory of the custom database command.")
+	Command.PersistentFlags().StringVar(&globalFlags.snapshotDir, "snapshot-dir", filepath.Join(homeDir(), "database.snapshot"), "Directory to save database snapshots.")
+	Command.PersistentFlags().StringVar(&globalFlags.diagnosticsDir, "diagnostics-dir", filepath.Join(homeDir(), "database.diagnostics"), "Directory to save database profiles and thread dumps.")
+	Command.PersistentFlags().StringVar(&globalFlags.extraConfig, "extra-config", filepath.Join(homeDir(), "database-extra.config"), "File path to write the configuration template of extra options.")
+	Command.PersistentFlags().StringVar(&globalFlags.cgroupMount, "cgroup-mount", "/sys/fs/cgroup", "cgroup v2 mount point (needed for resource limits).")
+	Command.PersistentFlags().StringVar(&globalFlags.cgroupParent, "cgroup-parent", "dbtester", "cgroup to create the database cgroups under, relative to '--cgroup-mount'.")
 
 	Command.PersistentFlags().StringVar(&globalFlags.grpcPort, "agent-port", ":3500", "Port to server agent gRPC server.")
 	Command.PersistentFlags().StringVar(&globalFlags.diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
@@ -98,7 +148,17 @@ func init() {
 var Command = &cobra.Command{
 	Use:   "agent",
 	Short: "Database 'agent' in remote servers.",
-	RunE:  commandFunc,
+	Long: `Database 'agent' in remote servers.
+
+Each agent runs one database member. To run several members on one host,
+give them "host:clientPort:peerPort" in 'peer_ips', and start one agent per
+member on consecutive ports from 'agent_port_to_connect', each with its own
+home directory for data, logs and metrics. For example:
+
+	HOME=/var/lib/dbtester/1 dbtester agent --agent-port :3500
+	HOME=/var/lib/dbtester/2 dbtester agent --agent-port :3501 --zookeeper-work-dir /var/lib/dbtester/1/zookeeper
+`,
+	RunE: commandFunc,
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
@@ -139,3 +199,15 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	lg.Info("agent started", zap.String("grpc-server-port", globalFlags.grpcPort), zap.String("agent-log", globalFlags.agentLog))
 	return grpcServer.Serve(ln)
 }
+
+// databaseExec returns the executable binary path of the database ID
+// in '--database-exec', or 'defaultExec' if not given.
+func (fs *flags) databaseExec(id dbtesterpb.DatabaseID, defaultExec string) string {
+	for _, kv := range fs.databaseExecs {
+		ss := strings.SplitN(kv, "=", 2)
+		if len(ss) == 2 && ss[0] == id.String() {
+			return ss[1]
+		}
+	}
+	return defaultExec
+}
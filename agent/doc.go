@@ -13,4 +13,8 @@
 // limitations under the License.
 
 // Package agent is a database agent in remote servers.
+//
+// Databases run as child processes of the agent, except "etcd__embed",
+// which runs the etcd server vendored in dbtester in the agent process
+// (see 'github.com/coreos/etcd/embed').
 package agent
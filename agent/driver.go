@@ -0,0 +1,97 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// driver implements the agent side of a database family: process start,
+// data directory, readiness, metrics, membership changes and snapshots.
+// Database IDs map to drivers by family (e.g. "etcd" for "etcd__v3_3"),
+// and drivers switch on the version, if needed. A driver registered with
+// the database ID (e.g. "etcd__embed") overrides the one of its family.
+type driver interface {
+	// start starts the database process, but not its proxy.
+	start(fs *flags, t *transporterServer) error
+	// proxy returns the name of the proxy in front of the database
+	// (e.g. "zetcd"), or empty if there is none.
+	proxy() string
+	// startProxy starts the proxy, after the database has started.
+	startProxy(fs *flags, t *transporterServer) error
+	// version returns the version of the database binary (e.g. "3.3.1").
+	version(fs *flags, id dbtesterpb.DatabaseID) (string, error)
+	// dataDir returns the data directory of the database.
+	dataDir(fs flags) string
+	// gcLogs returns true if the database writes JVM GC logs.
+	gcLogs() bool
+
+	// probe returns the function that probes the readiness of the member.
+	probe(req dbtesterpb.Request, self dbtesterpb.Peer) func() probeResult
+	// setDatabaseMetrics sets the metrics to scrape from the member.
+	setDatabaseMetrics(c *databaseMetricsCollector, req dbtesterpb.Request, self dbtesterpb.Peer)
+	// diskUsageCategories returns the categories of the data directory files.
+	diskUsageCategories() []diskUsageCategory
+	// diagnostics returns the captures of the running member.
+	diagnostics(t *transporterServer, self dbtesterpb.Peer, cpuProfileSeconds int64) []diagnosticsCapture
+
+	// beforeJoin and afterJoin run before and after starting the member
+	// with the wiped data directory, with the members that stay.
+	beforeJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error
+	afterJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error
+	// leave removes the running member from the cluster, and makes
+	// the database exit.
+	leave(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error
+
+	// saveSnapshot saves the snapshot of the member under 'fs.snapshotDir'.
+	saveSnapshot(t *transporterServer, self dbtesterpb.Peer) (fpath string, size int64, err error)
+	// recoverMember wipes the data directory of the stopped member, and
+	// recovers it in the cluster. Each driver documents how it uses
+	// the snapshot at 't.snapshotPath'.
+	recoverMember(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]driver)
+)
+
+// registerDriver registers the driver of the database family, or ID.
+// It panics if the family or ID is registered twice.
+func registerDriver(family string, d driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if _, ok := drivers[family]; ok {
+		panic(fmt.Sprintf("driver %q is registered twice", family))
+	}
+	drivers[family] = d
+}
+
+// getDriver returns the driver of the database ID.
+func getDriver(id dbtesterpb.DatabaseID) (driver, error) {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	if d, ok := drivers[id.String()]; ok {
+		return d, nil
+	}
+	d, ok := drivers[dbtesterpb.DatabaseFamily(id.String())]
+	if !ok {
+		return nil, fmt.Errorf("database ID %q is not supported", id)
+	}
+	return d, nil
+}
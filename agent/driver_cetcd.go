@@ -0,0 +1,26 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+func init() { registerDriver("cetcd", cetcdDriver{}) }
+
+// cetcdDriver is the driver of cetcd, which proxies etcd behind it.
+// The database process is etcd, which is probed, scraped and
+// snapshotted instead of the proxy.
+type cetcdDriver struct{ etcdDriver }
+
+func (cetcdDriver) proxy() string { return "cetcd" }
+
+func (cetcdDriver) startProxy(fs *flags, t *transporterServer) error { return startCetcd(fs, t) }
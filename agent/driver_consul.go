@@ -0,0 +1,114 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func init() { registerDriver("consul", consulDriver{}) }
+
+// consulDriver is the driver of Consul.
+type consulDriver struct{}
+
+func (consulDriver) start(fs *flags, t *transporterServer) error { return startConsul(fs, t) }
+
+func (consulDriver) proxy() string { return "" }
+
+func (consulDriver) startProxy(fs *flags, t *transporterServer) error { return nil }
+
+func (consulDriver) version(fs *flags, id dbtesterpb.DatabaseID) (string, error) {
+	return consulVersion(fs.databaseExec(id, fs.consulExec))
+}
+
+func (consulDriver) dataDir(fs flags) string { return fs.consulDataDir }
+
+func (consulDriver) gcLogs() bool { return false }
+
+func (consulDriver) probe(req dbtesterpb.Request, self dbtesterpb.Peer) func() probeResult {
+	return func() probeResult {
+		leader, err := consulLeader(self.ClientAddr())
+		return probeResult{ready: err == nil, leader: err == nil && leader != ""}
+	}
+}
+
+func (consulDriver) setDatabaseMetrics(c *databaseMetricsCollector, req dbtesterpb.Request, self dbtesterpb.Peer) {
+	ep := fmt.Sprintf("http://%s/v1/agent/metrics", self.ClientAddr())
+	c.metrics = consulMetrics
+	c.suffix = true
+	c.scrape = func() (map[string]float64, error) { return scrapeConsulMetrics(ep) }
+}
+
+func (consulDriver) diskUsageCategories() []diskUsageCategory { return consulDiskUsageCategories }
+
+func (consulDriver) diagnostics(t *transporterServer, self dbtesterpb.Peer, cpuProfileSeconds int64) []diagnosticsCapture {
+	return pprofCaptures(fmt.Sprintf("http://%s/debug/pprof", self.ClientAddr()), cpuProfileSeconds)
+}
+
+func (consulDriver) beforeJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return nil
+}
+
+func (consulDriver) afterJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return nil
+}
+
+func (consulDriver) leave(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	// 'consul leave' gracefully leaves the cluster and shuts down the agent
+	out, err := exec.Command(t.fs.consulExec, "leave", "-http-addr="+self.ClientAddr()).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("consul leave failed (%v, %q)", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (consulDriver) saveSnapshot(t *transporterServer, self dbtesterpb.Peer) (string, int64, error) {
+	fpath := filepath.Join(t.fs.snapshotDir, "consul.snapshot")
+	if err := t.runConsulSnapshot("save", self.ClientAddr(), fpath); err != nil {
+		return "", 0, err
+	}
+	fi, err := os.Stat(fpath)
+	if err != nil {
+		return "", 0, err
+	}
+	return fpath, fi.Size(), nil
+}
+
+// recoverMember rejoins the wiped member, and restores the snapshot.
+// 'consul snapshot restore' is cluster-wide: the leader replaces the
+// state of all members with the snapshot, not only of this member, so
+// the recovery time includes the restore on the whole cluster.
+func (consulDriver) recoverMember(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	if err := t.removeDataDir(); err != nil {
+		return err
+	}
+
+	// 'consul snapshot restore' requires the cluster leader,
+	// so rejoin first, and restore once the leader is known
+	t.joinExisting = true
+	if err := t.startDatabase(); err != nil {
+		return err
+	}
+	if err := waitConsulLeader(self.ClientAddr(), snapshotTimeout); err != nil {
+		return err
+	}
+	return t.runConsulSnapshot("restore", self.ClientAddr(), t.snapshotPath)
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func init() { registerDriver("custom", customDriver{}) }
+
+// errCustomNotSupported is returned for the operations that need
+// to know the server beyond its client protocol.
+var errCustomNotSupported = errors.New("not supported by custom database")
+
+// customDriver is the driver of any server that speaks the client protocol
+// of etcd, Zookeeper or Consul, started with the command in the request.
+// Readiness and metrics are those of the protocol.
+type customDriver struct{}
+
+// protocolDriver returns the driver of the client protocol in the request.
+func (customDriver) protocolDriver(req dbtesterpb.Request) (driver, error) {
+	if req.Flag_Custom == nil {
+		return nil, fmt.Errorf("database ID %q has no protocol", req.DatabaseID)
+	}
+	switch p := req.Flag_Custom.Protocol; p {
+	case "etcd", "zookeeper", "consul":
+		driversMu.RLock()
+		defer driversMu.RUnlock()
+		return drivers[p], nil
+	default:
+		return nil, fmt.Errorf("protocol %q is not supported", p)
+	}
+}
+
+func (customDriver) start(fs *flags, t *transporterServer) error { return startCustom(fs, t) }
+
+func (customDriver) proxy() string { return "" }
+
+func (customDriver) startProxy(fs *flags, t *transporterServer) error { return nil }
+
+func (customDriver) version(fs *flags, id dbtesterpb.DatabaseID) (string, error) {
+	return "", errors.New("custom database has no version")
+}
+
+func (customDriver) dataDir(fs flags) string { return fs.customDataDir }
+
+func (customDriver) gcLogs() bool { return false }
+
+func (d customDriver) probe(req dbtesterpb.Request, self dbtesterpb.Peer) func() probeResult {
+	pd, err := d.protocolDriver(req)
+	if err != nil {
+		return func() probeResult { return probeResult{} }
+	}
+	return pd.probe(req, self)
+}
+
+func (d customDriver) setDatabaseMetrics(c *databaseMetricsCollector, req dbtesterpb.Request, self dbtesterpb.Peer) {
+	pd, err := d.protocolDriver(req)
+	if err != nil {
+		c.scrape = func() (map[string]float64, error) { return nil, err }
+		return
+	}
+	// compatible servers may not serve the metrics of the protocol,
+	// which only fails the scrapes
+	pd.setDatabaseMetrics(c, req, self)
+}
+
+func (customDriver) diskUsageCategories() []diskUsageCategory { return nil }
+
+func (customDriver) diagnostics(t *transporterServer, self dbtesterpb.Peer, cpuProfileSeconds int64) []diagnosticsCapture {
+	return nil
+}
+
+func (customDriver) beforeJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return errCustomNotSupported
+}
+
+func (customDriver) afterJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return errCustomNotSupported
+}
+
+func (customDriver) leave(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return errCustomNotSupported
+}
+
+func (customDriver) saveSnapshot(t *transporterServer, self dbtesterpb.Peer) (string, int64, error) {
+	return "", 0, errCustomNotSupported
+}
+
+func (customDriver) recoverMember(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return errCustomNotSupported
+}
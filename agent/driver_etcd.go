@@ -0,0 +1,85 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func init() { registerDriver("etcd", etcdDriver{}) }
+
+// etcdDriver is the driver of etcd v3.
+type etcdDriver struct{}
+
+func (etcdDriver) start(fs *flags, t *transporterServer) error { return startEtcd(fs, t) }
+
+func (etcdDriver) proxy() string { return "" }
+
+func (etcdDriver) startProxy(fs *flags, t *transporterServer) error { return nil }
+
+func (etcdDriver) version(fs *flags, id dbtesterpb.DatabaseID) (string, error) {
+	return etcdVersion(fs.databaseExec(id, fs.etcdExec))
+}
+
+func (etcdDriver) dataDir(fs flags) string { return fs.etcdDataDir }
+
+func (etcdDriver) gcLogs() bool { return false }
+
+func (etcdDriver) probe(req dbtesterpb.Request, self dbtesterpb.Peer) func() probeResult {
+	ep := etcdClientURL(req.DatabaseID, self)
+	return func() probeResult { return probeEtcd(ep) }
+}
+
+func (etcdDriver) setDatabaseMetrics(c *databaseMetricsCollector, req dbtesterpb.Request, self dbtesterpb.Peer) {
+	ep := etcdClientURL(req.DatabaseID, self) + "/metrics"
+	c.metrics = etcdMetrics
+	c.scrape = func() (map[string]float64, error) { return scrapePrometheus(ep) }
+}
+
+func (etcdDriver) diskUsageCategories() []diskUsageCategory { return etcdDiskUsageCategories }
+
+func (etcdDriver) diagnostics(t *transporterServer, self dbtesterpb.Peer, cpuProfileSeconds int64) []diagnosticsCapture {
+	return pprofCaptures(etcdClientURL(t.req.DatabaseID, self)+"/debug/pprof", cpuProfileSeconds)
+}
+
+func (etcdDriver) beforeJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return t.addEtcdMember(others, "http://"+self.PeerAddr())
+}
+
+func (etcdDriver) afterJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return nil
+}
+
+func (etcdDriver) leave(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	// removed etcd member shuts itself down
+	return t.removeEtcdMember(others, "http://"+self.PeerAddr())
+}
+
+func (etcdDriver) saveSnapshot(t *transporterServer, self dbtesterpb.Peer) (string, int64, error) {
+	fpath := filepath.Join(t.fs.snapshotDir, "etcd.snapshot.db")
+	size, err := saveEtcdSnapshot(etcdClientURL(t.req.DatabaseID, self), fpath)
+	return fpath, size, err
+}
+
+// recoverMember is not supported. 'etcdctl snapshot restore' bootstraps
+// a new raft log with only the membership, so a member restored into
+// the running cluster would apply the entries of the leader again on top
+// of the snapshot, which already has them, and diverge from the others.
+func (etcdDriver) recoverMember(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return fmt.Errorf("database %q does not support recovering a member from snapshot %q", t.req.DatabaseID, t.snapshotPath)
+}
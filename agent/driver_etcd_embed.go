@@ -0,0 +1,35 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/version"
+)
+
+func init() { registerDriver(dbtesterpb.DatabaseID_etcd__embed.String(), etcdEmbedDriver{}) }
+
+// etcdEmbedDriver is the driver of etcd that runs in the agent process,
+// with the etcd server vendored in dbtester. The member serves the same
+// client and peer URLs, so that it is probed, scraped and snapshotted
+// the same as etcd processes.
+type etcdEmbedDriver struct{ etcdDriver }
+
+func (etcdEmbedDriver) start(fs *flags, t *transporterServer) error { return startEmbeddedEtcd(fs, t) }
+
+func (etcdEmbedDriver) version(fs *flags, id dbtesterpb.DatabaseID) (string, error) {
+	return version.Version, nil
+}
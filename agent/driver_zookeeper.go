@@ -0,0 +1,131 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func init() { registerDriver("zookeeper", zookeeperDriver{}) }
+
+// zookeeperDriver is the driver of Zookeeper.
+type zookeeperDriver struct{}
+
+func (zookeeperDriver) start(fs *flags, t *transporterServer) error { return startZookeeper(fs, t) }
+
+func (zookeeperDriver) proxy() string { return "" }
+
+func (zookeeperDriver) startProxy(fs *flags, t *transporterServer) error { return nil }
+
+func (zookeeperDriver) version(fs *flags, id dbtesterpb.DatabaseID) (string, error) {
+	return zookeeperVersion(fs.zkWorkDir)
+}
+
+func (zookeeperDriver) dataDir(fs flags) string { return fs.zkDataDir }
+
+func (zookeeperDriver) gcLogs() bool { return true }
+
+func (zookeeperDriver) probe(req dbtesterpb.Request, self dbtesterpb.Peer) func() probeResult {
+	ep := self.ClientAddr()
+	return func() probeResult { return probeZookeeper(ep) }
+}
+
+func (zookeeperDriver) setDatabaseMetrics(c *databaseMetricsCollector, req dbtesterpb.Request, self dbtesterpb.Peer) {
+	ep := self.ClientAddr()
+	c.metrics = zookeeperMetrics
+	c.scrape = func() (map[string]float64, error) { return scrapeZookeeperMntr(ep) }
+}
+
+func (zookeeperDriver) diskUsageCategories() []diskUsageCategory {
+	return zookeeperDiskUsageCategories
+}
+
+func (zookeeperDriver) diagnostics(t *transporterServer, self dbtesterpb.Peer, cpuProfileSeconds int64) []diagnosticsCapture {
+	pid := fmt.Sprint(t.pid)
+	return []diagnosticsCapture{
+		{"jstack.txt", func(fpath string) error { return runToFile(fpath, t.fs.jdkTool("jstack"), "-l", pid) }},
+		{"jstat-gcutil.txt", func(fpath string) error { return runToFile(fpath, t.fs.jdkTool("jstat"), "-gcutil", pid) }},
+	}
+}
+
+func (zookeeperDriver) beforeJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	return nil
+}
+
+func (zookeeperDriver) afterJoin(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	spec := fmt.Sprintf("server.%d=%s:%d:%d:participant;%d", t.req.Flag_Zookeeper.MyID, self.Host, self.PeerPort, self.ZookeeperElectionPort(), self.ClientPort)
+	return t.reconfigZookeeper(others, "-add", spec)
+}
+
+func (zookeeperDriver) leave(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	if err := t.reconfigZookeeper(others, "-remove", fmt.Sprintf("%d", t.req.Flag_Zookeeper.MyID)); err != nil {
+		return err
+	}
+	return t.signalDatabase(syscall.SIGTERM)
+}
+
+// saveSnapshot copies the files that Zookeeper needs to rebuild the
+// current state, into the 'version-2' directory under the snapshot
+// directory. Zookeeper has no snapshot API, and only takes snapshots
+// every 'snapCount' transactions, so copy the latest snapshot (if any)
+// and the transaction logs written since.
+func (zookeeperDriver) saveSnapshot(t *transporterServer, self dbtesterpb.Peer) (string, int64, error) {
+	srcs, err := zookeeperSnapshotFiles(filepath.Join(t.fs.zkDataDir, "version-2"))
+	if err != nil {
+		return "", 0, err
+	}
+	dir := filepath.Join(t.fs.snapshotDir, "version-2")
+	if err = os.MkdirAll(dir, 0777); err != nil {
+		return "", 0, err
+	}
+	var size int64
+	for _, src := range srcs {
+		n, err := copyFile(src, filepath.Join(dir, filepath.Base(src)))
+		if err != nil {
+			return "", 0, err
+		}
+		size += n
+	}
+	return dir, size, nil
+}
+
+// recoverMember starts the wiped member from the saved snapshot and
+// transaction logs, and the member syncs the rest from the leader.
+func (zookeeperDriver) recoverMember(t *transporterServer, others []dbtesterpb.Peer, self dbtesterpb.Peer) error {
+	if err := t.removeDataDir(); err != nil {
+		return err
+	}
+
+	dir := filepath.Join(t.fs.zkDataDir, "version-2")
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	fs, err := ioutil.ReadDir(t.snapshotPath)
+	if err != nil {
+		return err
+	}
+	for _, fi := range fs {
+		if _, err = copyFile(filepath.Join(t.snapshotPath, fi.Name()), filepath.Join(dir, fi.Name())); err != nil {
+			return err
+		}
+	}
+	return t.startDatabase()
+}
@@ -0,0 +1,86 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// LocalPaths are the metrics of the member that 'NewLocalServer' saves,
+// for 'analyze'.
+type LocalPaths struct {
+	SystemMetricsCSVInterpolated string
+	ProcessMetricsCSV            string
+	DiskUsageCSV                 string
+	GCMetricsCSV                 string
+}
+
+// NewLocalServer returns a server for 'dbtester local', which runs one
+// member of the cluster on this host. The data, logs and metrics of the
+// member are kept under 'dir', and the other flags are shared by all
+// members (e.g. '--etcd-exec', '--zookeeper-work-dir').
+func NewLocalServer(dir string) (dbtesterpb.TransporterServer, LocalPaths, error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, LocalPaths{}, err
+	}
+	fs := globalFlags.withDir(dir)
+
+	lcfg := zap.NewProductionConfig()
+	lcfg.OutputPaths = []string{fs.agentLog}
+	lcfg.ErrorOutputPaths = []string{fs.agentLog}
+	lg, err := lcfg.Build()
+	if err != nil {
+		return nil, LocalPaths{}, err
+	}
+
+	ps := LocalPaths{
+		SystemMetricsCSVInterpolated: fs.systemMetricsCSVInterpolated,
+		ProcessMetricsCSV:            fs.processMetricsCSV,
+		DiskUsageCSV:                 fs.diskUsageCSV,
+		GCMetricsCSV:                 fs.gcMetricsCSV,
+	}
+	return newServer(lg, &fs), ps, nil
+}
+
+// withDir returns the flags with the paths of the member under 'dir'.
+func (fs flags) withDir(dir string) flags {
+	fs.agentLog = filepath.Join(dir, "agent.log")
+	fs.databaseLog = filepath.Join(dir, "database.log")
+	fs.systemMetricsCSV = filepath.Join(dir, "server-system-metrics.csv")
+	fs.systemMetricsCSVInterpolated = filepath.Join(dir, "server-system-metrics-interpolated.csv")
+	fs.systemIOMetricsCSV = filepath.Join(dir, "server-system-io-metrics.csv")
+	fs.systemIOMetricsCSVInterpolated = filepath.Join(dir, "server-system-io-metrics-interpolated.csv")
+	fs.databaseMetricsCSV = filepath.Join(dir, "server-database-metrics.csv")
+	fs.processMetricsCSV = filepath.Join(dir, "server-process-metrics.csv")
+	fs.diskUsageCSV = filepath.Join(dir, "server-disk-usage.csv")
+	fs.gcMetricsCSV = filepath.Join(dir, "server-gc-metrics.csv")
+	fs.zkDataDir = filepath.Join(dir, "zookeeper.data")
+	fs.zkConfig = filepath.Join(dir, "zookeeper.config")
+	fs.zkGCLog = filepath.Join(dir, "zookeeper-gc.log")
+	fs.etcdDataDir = filepath.Join(dir, "etcd.data")
+	fs.consulDataDir = filepath.Join(dir, "consul.data")
+	fs.consulConfig = filepath.Join(dir, "consul.json")
+	fs.customDataDir = filepath.Join(dir, "custom.data")
+	fs.snapshotDir = filepath.Join(dir, "database.snapshot")
+	fs.diagnosticsDir = filepath.Join(dir, "database.diagnostics")
+	fs.extraConfig = filepath.Join(dir, "database-extra.config")
+	fs.clientNumPath = filepath.Join(dir, "client-num")
+	return fs
+}
@@ -19,20 +19,31 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+	"github.com/etcd-io/dbtester/pkg/cgroup"
 	"github.com/etcd-io/dbtester/pkg/fileinspect"
+	"github.com/etcd-io/dbtester/pkg/procfs"
 
-	"github.com/gyuho/linux-inspect/inspect"
+	"github.com/coreos/etcd/embed"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
 )
 
 // implements dbtesterpb.TransporterServer
 type transporterServer struct {
-	lg  *zap.Logger
+	lg *zap.Logger
+	fs *flags
+
+	// mu serializes the operations of 'Transfer', since gRPC serves
+	// requests concurrently (e.g. faults during heartbeats), and they
+	// change the request and the database process state below
+	// (e.g. 'cmd', 'pid', 'paused'). 'Stop' holds it only around
+	// its state changes (see 'stop').
+	mu  sync.Mutex
 	req dbtesterpb.Request
 
 	databaseLogFile      *os.File
@@ -41,17 +52,52 @@ type transporterServer struct {
 
 	// cmd is the main process that's running the database
 	cmd *exec.Cmd
+	// embedded is the etcd server in the agent process, instead of 'cmd'
+	embedded *embed.Etcd
 	// cmdWait channel is closed
 	// after database process is closed
 	cmdWait chan struct{}
 
 	pid int64
+	// databaseVersion is the version of the database binary, if known
+	databaseVersion string
+	// started is the time when the database process was started
+	started time.Time
+
+	// paused is true when the database process is stopped with SIGSTOP
+	paused bool
+
+	// standby is true when this member is not in the initial cluster,
+	// and joinExisting is true when the database joins a running cluster
+	standby      bool
+	joinExisting bool
+
+	// netFault is the network fault applied by this agent, if any
+	netFault *networkFault
+
+	// snapshotPath is the latest snapshot saved by this agent
+	snapshotPath string
 
 	proxyCmd     *exec.Cmd
 	proxyCmdWait chan struct{}
 	proxyPid     int64
 
-	metricsCSV *inspect.CSV
+	// metricsMu protects metricsCSV, whose PID changes on restart
+	metricsMu  sync.Mutex
+	metricsCSV *procfs.CSV
+	// processMetrics tracks the proxy and descendant processes, if any
+	processMetrics *processMetrics
+
+	// databaseMetrics scrapes the metrics that the database exposes
+	databaseMetrics *databaseMetricsCollector
+	// diskUsage measures the data directory by category
+	diskUsage *diskUsageCollector
+
+	// cgroup limits the resources of the database process, if requested,
+	// and resourceLimits is the limits read back from cgroupFiles
+	cgroup         *cgroup.Group
+	cgroupFiles    []string
+	resourceLimits string
 
 	// trigger log uploads to cloud storage
 	// this should be triggered before we shut down
@@ -65,12 +111,17 @@ type transporterServer struct {
 
 // NewServer returns a new server that implements gRPC interface.
 func NewServer(lg *zap.Logger) dbtesterpb.TransporterServer {
+	return newServer(lg, &globalFlags)
+}
+
+func newServer(lg *zap.Logger, fs *flags) *transporterServer {
 	notifier := make(chan os.Signal, 1)
 	signal.Notify(notifier, syscall.SIGINT, syscall.SIGTERM)
 
 	return &transporterServer{
 		lg:            lg,
-		clientNumPath: globalFlags.clientNumPath,
+		fs:            fs,
+		clientNumPath: fs.clientNumPath,
 		uploadSig:     make(chan struct{}, 1),
 		csvReady:      make(chan struct{}),
 		notifier:      notifier,
@@ -87,64 +138,39 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		)
 	}
 
+	if req.Operation == dbtesterpb.Operation_Stop {
+		return t.stop(req)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	if req.Operation == dbtesterpb.Operation_Start {
-		f, err := openToAppend(globalFlags.databaseLog)
+		f, err := openToAppend(t.fs.databaseLog)
 		if err != nil {
 			return nil, err
 		}
 		t.databaseLogFile = f
-		t.lg.Info("created database log file", zap.String("path", globalFlags.databaseLog))
+		t.lg.Info("created database log file", zap.String("path", t.fs.databaseLog))
 
-		if req.DatabaseID == dbtesterpb.DatabaseID_zetcd__beta || req.DatabaseID == dbtesterpb.DatabaseID_cetcd__beta {
-			proxyLog := globalFlags.databaseLog + "-" + t.req.DatabaseID.String()
+		dr, err := getDriver(req.DatabaseID)
+		if err != nil {
+			return nil, err
+		}
+		if name := dr.proxy(); name != "" {
+			proxyLog := t.fs.databaseLog + "-" + req.DatabaseID.String()
 			pf, err := openToAppend(proxyLog)
 			if err != nil {
 				return nil, err
 			}
 			t.proxyDatabaseLogfile = pf
-			t.lg.Info("created database proxy log file", zap.String("path", proxyLog))
-		}
-
-		switch req.DatabaseID {
-		case dbtesterpb.DatabaseID_etcd__other,
-			dbtesterpb.DatabaseID_etcd__tip,
-			dbtesterpb.DatabaseID_etcd__v3_2,
-			dbtesterpb.DatabaseID_etcd__v3_3:
-			t.lg.Info(
-				"requested on etcd",
-				zap.String("executable-binary-path", globalFlags.etcdExec),
-				zap.String("data-directory", globalFlags.etcdDataDir),
-			)
-
-		case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-			t.lg.Info(
-				"requested on Zookeeper",
-				zap.String("working-directory", globalFlags.zkWorkDir),
-				zap.String("data-directory", globalFlags.zkDataDir),
-				zap.String("configuration-file", globalFlags.zkConfig),
-			)
-
-		case dbtesterpb.DatabaseID_consul__v1_0_2:
-			t.lg.Info(
-				"requested on Consul",
-				zap.String("executable-binary-path", globalFlags.consulExec),
-				zap.String("data-directory", globalFlags.consulDataDir),
-			)
-
-		case dbtesterpb.DatabaseID_zetcd__beta:
-			t.lg.Info(
-				"requested on zetcd",
-				zap.String("executable-binary-path", globalFlags.zetcdExec),
-				zap.String("data-directory", globalFlags.etcdDataDir),
-			)
-
-		case dbtesterpb.DatabaseID_cetcd__beta:
-			t.lg.Info(
-				"requested on cetcd",
-				zap.String("executable-binary-path", globalFlags.cetcdExec),
-				zap.String("data-directory", globalFlags.etcdDataDir),
-			)
+			t.lg.Info("created database proxy log file", zap.String("proxy", name), zap.String("path", proxyLog))
 		}
+		t.lg.Info(
+			"requested on database",
+			zap.String("database", req.DatabaseID.String()),
+			zap.String("data-directory", dr.dataDir(*t.fs)),
+		)
 
 		// re-use configurations for next requests
 		t.req = *req
@@ -153,138 +179,122 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 		t.req.CurrentClientNumber = req.CurrentClientNumber
 	}
 
-	var diskSpaceUsageBytes int64
+	resp := &dbtesterpb.Response{Success: true}
 	switch req.Operation {
 	case dbtesterpb.Operation_Start:
-		switch t.req.DatabaseID {
-		case dbtesterpb.DatabaseID_etcd__other,
-			dbtesterpb.DatabaseID_etcd__tip,
-			dbtesterpb.DatabaseID_etcd__v3_2,
-			dbtesterpb.DatabaseID_etcd__v3_3,
-			dbtesterpb.DatabaseID_zetcd__beta,
-			dbtesterpb.DatabaseID_cetcd__beta:
-			if err := startEtcd(&globalFlags, t); err != nil {
-				return nil, err
-			}
-			switch t.req.DatabaseID {
-			case dbtesterpb.DatabaseID_zetcd__beta:
-				if err := startZetcd(&globalFlags, t); err != nil {
-					return nil, err
-				}
-				go func() {
-					defer close(t.proxyCmdWait)
-					if err := t.proxyCmd.Wait(); err != nil {
-						t.lg.Warn("zetcd t.proxyCmd.Wait() returned error", zap.Error(err))
-						return
-					}
-					t.lg.Info("exiting zetcd", zap.String("executable-path", t.proxyCmd.Path))
-				}()
-
-			case dbtesterpb.DatabaseID_cetcd__beta:
-				if err := startCetcd(&globalFlags, t); err != nil {
-					return nil, err
-				}
-				go func() {
-					defer close(t.proxyCmdWait)
-					if err := t.proxyCmd.Wait(); err != nil {
-						t.lg.Warn("cetcd t.proxyCmd.Wait() returned error", zap.Error(err))
-						return
-					}
-					t.lg.Info("exiting cetcd", zap.String("executable-path", t.proxyCmd.Path))
-				}()
-			}
-
-		case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-			if err := startZookeeper(&globalFlags, t); err != nil {
-				return nil, err
-			}
+		if err := t.removeDataDir(); err != nil {
+			return nil, err
+		}
+		if err := os.RemoveAll(t.fs.diagnosticsDir); err != nil {
+			return nil, err
+		}
+		if err := removeGCLogs(*t.fs); err != nil {
+			return nil, err
+		}
+		if err := t.detectVersion(); err != nil {
+			return nil, err
+		}
+		resp.DatabaseVersion = t.databaseVersion
 
-		case dbtesterpb.DatabaseID_consul__v1_0_2:
-			if err := startConsul(&globalFlags, t); err != nil {
-				return nil, err
-			}
+		// members out of the initial cluster wait for 'Join'
+		if !inCluster(t.req) {
+			t.lg.Info("waiting for join", zap.Uint32("ip-index", t.req.IPIndex))
+			t.standby = true
+			break
+		}
 
-		default:
-			return nil, fmt.Errorf("unknown database %q", t.req.DatabaseID)
+		if err := t.startDatabase(); err != nil {
+			return nil, err
+		}
+		if err := t.startProxy(); err != nil {
+			return nil, err
 		}
 
-		go func() {
-			defer close(t.cmdWait)
-			if err := t.cmd.Wait(); err != nil {
-				t.lg.Warn("t.cmd.Wait() returned error", zap.Error(err))
-				return
-			}
-			t.lg.Info("exiting", zap.String("executable-path", t.cmd.Path))
-		}()
-		if err := startMetrics(&globalFlags, t); err != nil {
+		if err := startMetrics(t.fs, t); err != nil {
+			return nil, err
+		}
+		if err := startDatabaseMetrics(t.fs, t); err != nil {
+			return nil, err
+		}
+		if err := startDiskUsage(t.fs, t); err != nil {
 			return nil, err
 		}
 
-	case dbtesterpb.Operation_Stop:
-		if t.cmd == nil {
-			return nil, fmt.Errorf("nil command")
+		rd, err := t.waitReady()
+		if err != nil {
+			return nil, err
 		}
+		resp.ReadyMillisecond = int64(rd.ready / time.Millisecond)
+		resp.LeaderMillisecond = int64(rd.leader / time.Millisecond)
+		resp.ResourceLimits = t.resourceLimits
 
-		// to collect more monitoring data
-		t.lg.Info("waiting a few more seconds before stopping", zap.String("executable-path", t.cmd.Path))
-		time.Sleep(3 * time.Second)
+	case dbtesterpb.Operation_Kill:
+		if err := t.killDatabase(); err != nil {
+			return nil, err
+		}
 
-		// TODO: https://github.com/etcd-io/dbtester/issues/330
-		t.lg.Info("sending", zap.String("syscall", syscall.SIGINT.String()), zap.Int64("pid", t.pid), zap.String("executable-path", t.cmd.Path))
-		if err := t.cmd.Process.Signal(syscall.SIGINT); err != nil {
-			t.lg.Warn("syscall.SIGINT failed", zap.Error(err))
+	case dbtesterpb.Operation_Restart:
+		if err := t.restartDatabase(); err != nil {
+			return nil, err
+		}
 
-			time.Sleep(3 * time.Second)
-			t.lg.Info("sending", zap.String("syscall", syscall.SIGTERM.String()), zap.Int64("pid", t.pid), zap.String("executable-path", t.cmd.Path))
-			if err := syscall.Kill(int(t.pid), syscall.SIGTERM); err != nil {
-				t.lg.Warn("syscall.Kill failed", zap.Error(err))
-			}
+	case dbtesterpb.Operation_Pause:
+		if err := t.pauseDatabase(); err != nil {
+			return nil, err
 		}
 
-		time.Sleep(time.Second)
-		<-t.cmdWait
+	case dbtesterpb.Operation_Resume:
+		if err := t.resumeDatabase(); err != nil {
+			return nil, err
+		}
 
-		if t.databaseLogFile != nil {
-			t.databaseLogFile.Sync()
-			t.databaseLogFile.Close()
+	case dbtesterpb.Operation_ApplyNetworkFault:
+		if err := t.applyNetworkFault(req.NetworkFault); err != nil {
+			return nil, err
 		}
-		t.lg.Info("stopped", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
 
-		if t.proxyCmd != nil {
-			t.lg.Info("sending", zap.String("syscall", syscall.SIGINT.String()), zap.Int64("pid", t.proxyPid), zap.String("executable-path", t.proxyCmd.Path))
-			if err := t.proxyCmd.Process.Signal(syscall.SIGINT); err != nil {
-				t.lg.Warn("syscall.SIGINT failed", zap.Error(err))
+	case dbtesterpb.Operation_RemoveNetworkFault:
+		if err := t.removeNetworkFault(); err != nil {
+			return nil, err
+		}
 
-				time.Sleep(3 * time.Second)
-				t.lg.Info("sending", zap.String("syscall", syscall.SIGTERM.String()), zap.Int64("pid", t.proxyPid), zap.String("executable-path", t.proxyCmd.Path))
-				if err := syscall.Kill(int(t.proxyPid), syscall.SIGTERM); err != nil {
-					t.lg.Warn("syscall.Kill failed", zap.Error(err))
-				}
-			}
+	case dbtesterpb.Operation_Join:
+		t.req.ClusterIPIndexes = req.ClusterIPIndexes
+		if err := t.joinCluster(); err != nil {
+			return nil, err
+		}
 
-			<-t.proxyCmdWait
-			t.lg.Info("stopped", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.proxyPid))
+	case dbtesterpb.Operation_Leave:
+		t.req.ClusterIPIndexes = req.ClusterIPIndexes
+		if err := t.leaveCluster(); err != nil {
+			return nil, err
+		}
 
-			if t.proxyDatabaseLogfile != nil {
-				t.proxyDatabaseLogfile.Sync()
-				t.proxyDatabaseLogfile.Close()
-			}
+	case dbtesterpb.Operation_Upgrade:
+		if err := t.upgradeDatabase(req); err != nil {
+			return nil, err
 		}
 
-		t.uploadSig <- struct{}{}
-		<-t.csvReady
+	case dbtesterpb.Operation_SnapshotSave:
+		now := time.Now()
+		size, err := t.saveSnapshot()
+		if err != nil {
+			return nil, err
+		}
+		resp.SnapshotSizeBytes = size
+		resp.SnapshotMillisecond = int64(time.Since(now) / time.Millisecond)
 
-		if t.req.TriggerLogUpload {
-			if err := uploadLog(&globalFlags, t); err != nil {
-				return nil, err
-			}
+	case dbtesterpb.Operation_SnapshotRecover:
+		now := time.Now()
+		if err := t.recoverMember(); err != nil {
+			return nil, err
 		}
+		resp.RecoverMillisecond = int64(time.Since(now) / time.Millisecond)
 
-		dbs, err := measureDatabasSize(globalFlags, req.DatabaseID)
-		if err != nil {
+	case dbtesterpb.Operation_CaptureDiagnostics:
+		if _, err := t.captureDiagnostics(req.CPUProfileSeconds); err != nil {
 			return nil, err
 		}
-		diskSpaceUsageBytes = dbs
 
 	case dbtesterpb.Operation_Heartbeat:
 		t.lg.Info("overwriting clients number", zap.Int64("number", t.req.CurrentClientNumber), zap.String("number-path", t.clientNumPath))
@@ -297,26 +307,114 @@ func (t *transporterServer) Transfer(ctx context.Context, req *dbtesterpb.Reques
 	}
 
 	t.lg.Info("Transfer success!")
-	return &dbtesterpb.Response{Success: true, DiskSpaceUsageBytes: diskSpaceUsageBytes}, nil
+	return resp, nil
 }
 
-func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (int64, error) {
-	switch rdb {
-	case dbtesterpb.DatabaseID_etcd__other,
-		dbtesterpb.DatabaseID_etcd__tip,
-		dbtesterpb.DatabaseID_etcd__v3_2,
-		dbtesterpb.DatabaseID_etcd__v3_3,
-		dbtesterpb.DatabaseID_cetcd__beta,
-		dbtesterpb.DatabaseID_zetcd__beta:
-		return fileinspect.Size(flg.etcdDataDir)
+// stop stops the database, saves the metrics, and uploads the logs.
+// It holds 't.mu' only around the state changes, so that heartbeats
+// are not blocked while it waits for more monitoring data, or uploads.
+func (t *transporterServer) stop(req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	t.mu.Lock()
+	started := t.databaseStarted()
+	if !started && !t.standby {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("nil command")
+	}
+	if err := t.removeNetworkFault(); err != nil {
+		t.lg.Warn("failed to remove network fault before stop", zap.Error(err))
+	}
+	id := t.req.DatabaseID
+	t.mu.Unlock()
 
-	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-		return fileinspect.Size(flg.zkDataDir)
+	if started {
+		// to collect more monitoring data
+		t.lg.Info("waiting a few more seconds before stopping", zap.String("database", id.String()))
+		time.Sleep(3 * time.Second)
+	}
 
-	case dbtesterpb.DatabaseID_consul__v1_0_2:
-		return fileinspect.Size(flg.consulDataDir)
+	t.mu.Lock()
+	if started {
+		if err := stopDatabaseMetrics(t.fs, t); err != nil {
+			t.lg.Warn("failed to save database metrics", zap.Error(err))
+		}
+		t.stopDatabase()
+		if err := stopDiskUsage(t.fs, t); err != nil {
+			t.lg.Warn("failed to save disk usage", zap.Error(err))
+		}
+		if err := saveGCMetrics(t.fs, t); err != nil {
+			t.lg.Warn("failed to save GC metrics", zap.Error(err))
+		}
+		if err := t.removeResourceLimits(); err != nil {
+			t.lg.Warn("failed to remove cgroup", zap.Error(err))
+		}
+	}
 
-	default:
-		return 0, fmt.Errorf("uknown %q", rdb)
+	if t.databaseLogFile != nil {
+		t.databaseLogFile.Sync()
+		t.databaseLogFile.Close()
+	}
+	t.lg.Info("stopped", zap.String("database", id.String()), zap.Int64("pid", t.pid))
+
+	if t.proxyCmd != nil {
+		t.stopProxy()
+		if t.proxyDatabaseLogfile != nil {
+			t.proxyDatabaseLogfile.Sync()
+			t.proxyDatabaseLogfile.Close()
+		}
+	}
+	// metrics are not collected on the member that has never joined
+	collected := t.metricsCSV != nil
+	upload := t.req.TriggerLogUpload && started
+	t.mu.Unlock()
+
+	if collected {
+		t.uploadSig <- struct{}{}
+		<-t.csvReady
+	}
+
+	if upload {
+		if err := uploadLog(t.fs, t); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &dbtesterpb.Response{Success: true}
+	if started {
+		dbs, err := measureDatabasSize(*t.fs, req.DatabaseID)
+		if err != nil {
+			return nil, err
+		}
+		resp.DiskSpaceUsageBytes = dbs.ApparentBytes
+		resp.DiskSpaceAllocatedBytes = dbs.AllocatedBytes
+	}
+
+	t.lg.Info("Transfer success!")
+	return resp, nil
+}
+
+func measureDatabasSize(flg flags, rdb dbtesterpb.DatabaseID) (fileinspect.Usage, error) {
+	dataDir, err := databaseDataDir(flg, rdb)
+	if err != nil {
+		return fileinspect.Usage{}, err
+	}
+	return fileinspect.DiskUsage(dataDir)
+}
+
+// databaseDataDir returns the data directory of the database.
+func databaseDataDir(flg flags, rdb dbtesterpb.DatabaseID) (string, error) {
+	dr, err := getDriver(rdb)
+	if err != nil {
+		return "", err
+	}
+	return dr.dataDir(flg), nil
+}
+
+// removeDataDir removes the data directory of the database.
+func (t *transporterServer) removeDataDir() error {
+	dataDir, err := databaseDataDir(*t.fs, t.req.DatabaseID)
+	if err != nil {
+		return err
 	}
+	t.lg.Info("removing data directory", zap.String("data-directory", dataDir))
+	return os.RemoveAll(dataDir)
 }
@@ -0,0 +1,364 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// databaseMetric selects a metric that the database exposes.
+type databaseMetric struct {
+	column string
+	// name is the metric name. Consul metrics are matched by suffix,
+	// since runtime metrics are prefixed with the host name.
+	name string
+	// count is set for histograms, to report the delta of 'name'
+	// divided by the delta of 'count' (e.g. average fsync duration).
+	count string
+	// delta reports the increase since the previous scrape,
+	// for counters.
+	delta bool
+	// scale multiplies the value (e.g. seconds to milliseconds).
+	scale float64
+}
+
+// etcdMetrics are from the Prometheus '/metrics' endpoint.
+var etcdMetrics = []databaseMetric{
+	{column: "WAL-FSYNC-AVG-MS", name: "etcd_disk_wal_fsync_duration_seconds_sum", count: "etcd_disk_wal_fsync_duration_seconds_count", scale: 1000},
+	{column: "BACKEND-COMMIT-AVG-MS", name: "etcd_disk_backend_commit_duration_seconds_sum", count: "etcd_disk_backend_commit_duration_seconds_count", scale: 1000},
+	{column: "PROPOSALS-COMMITTED-DELTA", name: "etcd_server_proposals_committed_total", delta: true},
+	{column: "PROPOSALS-APPLIED-DELTA", name: "etcd_server_proposals_applied_total", delta: true},
+	{column: "PROPOSALS-FAILED-DELTA", name: "etcd_server_proposals_failed_total", delta: true},
+	{column: "PROPOSALS-PENDING", name: "etcd_server_proposals_pending"},
+	{column: "LEADER-CHANGES-DELTA", name: "etcd_server_leader_changes_seen_total", delta: true},
+	{column: "HAS-LEADER", name: "etcd_server_has_leader"},
+	{column: "MVCC-DB-TOTAL-SIZE-BYTES", name: "etcd_debugging_mvcc_db_total_size_in_bytes"},
+	{column: "GC-PAUSE-MS-DELTA", name: "go_gc_duration_seconds_sum", delta: true, scale: 1000},
+	{column: "GOROUTINES", name: "go_goroutines"},
+}
+
+// zookeeperMetrics are from the 'mntr' four letter word.
+var zookeeperMetrics = []databaseMetric{
+	{column: "AVG-LATENCY-MS", name: "zk_avg_latency"},
+	{column: "MAX-LATENCY-MS", name: "zk_max_latency"},
+	{column: "OUTSTANDING-REQUESTS", name: "zk_outstanding_requests"},
+	{column: "PACKETS-RECEIVED-DELTA", name: "zk_packets_received", delta: true},
+	{column: "PACKETS-SENT-DELTA", name: "zk_packets_sent", delta: true},
+	{column: "ALIVE-CONNECTIONS", name: "zk_num_alive_connections"},
+	{column: "ZNODE-COUNT", name: "zk_znode_count"},
+	{column: "APPROXIMATE-DATA-SIZE-BYTES", name: "zk_approximate_data_size"},
+	{column: "PENDING-SYNCS", name: "zk_pending_syncs"},
+	{column: "OPEN-FILE-DESCRIPTORS", name: "zk_open_file_descriptor_count"},
+}
+
+// consulMetrics are from '/v1/agent/metrics', which aggregates
+// samples and counters in the current interval (10 seconds by default).
+var consulMetrics = []databaseMetric{
+	{column: "RAFT-COMMIT-AVG-MS", name: "consul.raft.commitTime.mean"},
+	{column: "RAFT-DISPATCH-LOG-AVG-MS", name: "consul.raft.leader.dispatchLog.mean"},
+	{column: "RAFT-FSM-APPLY-AVG-MS", name: "consul.raft.fsm.apply.mean"},
+	{column: "RAFT-APPLY-COUNT", name: "consul.raft.apply.count"},
+	{column: "KVS-APPLY-AVG-MS", name: "consul.kvs.apply.mean"},
+	{column: "GC-PAUSE-MS-DELTA", name: "runtime.total_gc_pause_ns", delta: true, scale: 1e-6},
+	{column: "GOROUTINES", name: "runtime.num_goroutines"},
+	{column: "ALLOC-BYTES", name: "runtime.alloc_bytes"},
+}
+
+// databaseMetricsCollector scrapes the metrics of the database every second.
+type databaseMetricsCollector struct {
+	metrics []databaseMetric
+	scrape  func() (map[string]float64, error)
+	// suffix is true to match metric names by suffix.
+	suffix bool
+
+	prev map[string]float64
+	rows []databaseMetricsRow
+
+	stopc chan struct{}
+	donec chan struct{}
+}
+
+type databaseMetricsRow struct {
+	unixSecond int64
+	values     []float64
+}
+
+// startDatabaseMetrics starts scraping the metrics of the database.
+func startDatabaseMetrics(fs *flags, t *transporterServer) error {
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return err
+	}
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	c := &databaseMetricsCollector{stopc: make(chan struct{}), donec: make(chan struct{})}
+	dr.setDatabaseMetrics(c, t.req, self)
+
+	if err = os.RemoveAll(fs.databaseMetricsCSV); err != nil {
+		return err
+	}
+
+	t.lg.Info("starting collecting database metrics", zap.String("database", t.req.DatabaseID.String()), zap.String("path", fs.databaseMetricsCSV))
+	t.databaseMetrics = c
+	go func() {
+		defer close(c.donec)
+		for {
+			select {
+			case <-time.After(time.Second):
+			case <-c.stopc:
+				return
+			}
+			if err := c.add(); err != nil {
+				t.lg.Debug("failed to scrape database metrics", zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
+
+// stopDatabaseMetrics stops scraping, and saves the metrics to CSV.
+func stopDatabaseMetrics(fs *flags, t *transporterServer) error {
+	c := t.databaseMetrics
+	if c == nil {
+		return nil
+	}
+	close(c.stopc)
+	<-c.donec
+	t.databaseMetrics = nil
+
+	if err := c.save(fs.databaseMetricsCSV); err != nil {
+		return err
+	}
+	t.lg.Info("saved database metrics", zap.String("path", fs.databaseMetricsCSV), zap.Int("rows", len(c.rows)))
+	return nil
+}
+
+// add scrapes the metrics, and adds a row. The row is skipped
+// if the database does not respond (e.g. paused, or restarting).
+func (c *databaseMetricsCollector) add() error {
+	now := time.Now().Unix()
+	cur, err := c.scrape()
+	if err != nil {
+		return err
+	}
+	c.addRow(now, cur)
+	return nil
+}
+
+// addRow adds the row of the scraped metrics at the second, unless
+// there is already one. Deltas are from the previous row, and counters
+// that decreased (e.g. restarted database) count from zero.
+func (c *databaseMetricsCollector) addRow(now int64, cur map[string]float64) {
+	if n := len(c.rows); n > 0 && c.rows[n-1].unixSecond == now {
+		return
+	}
+
+	row := databaseMetricsRow{unixSecond: now, values: make([]float64, len(c.metrics))}
+	for i, m := range c.metrics {
+		v, ok := c.lookup(cur, m.name)
+		if !ok {
+			continue
+		}
+		if m.delta || m.count != "" {
+			pv, ok := c.lookup(c.prev, m.name)
+			if !ok {
+				continue
+			}
+			d := v - pv
+			if d < 0 { // counter reset on restart
+				d = v
+			}
+			v = d
+		}
+		if m.count != "" {
+			cv, _ := c.lookup(cur, m.count)
+			pcv, _ := c.lookup(c.prev, m.count)
+			dc := cv - pcv
+			if dc < 0 {
+				dc = cv
+			}
+			if dc == 0 {
+				v = 0
+			} else {
+				v /= dc
+			}
+		}
+		if m.scale != 0 {
+			v *= m.scale
+		}
+		row.values[i] = v
+	}
+	c.prev = cur
+	c.rows = append(c.rows, row)
+}
+
+func (c *databaseMetricsCollector) lookup(vs map[string]float64, name string) (float64, bool) {
+	if v, ok := vs[name]; ok {
+		return v, true
+	}
+	if !c.suffix {
+		return 0, false
+	}
+	for k, v := range vs {
+		if strings.HasSuffix(k, "."+name) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func (c *databaseMetricsCollector) save(fpath string) error {
+	cols := make([]dataframe.Column, len(c.metrics)+1)
+	cols[0] = dataframe.NewColumn("UNIX-SECOND")
+	for i, m := range c.metrics {
+		cols[i+1] = dataframe.NewColumn(m.column)
+	}
+	for _, row := range c.rows {
+		cols[0].PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", row.unixSecond)))
+		for i, v := range row.values {
+			cols[i+1].PushBack(dataframe.NewStringValue(strconv.FormatFloat(v, 'f', -1, 64)))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(fpath)
+}
+
+var metricsClient = &http.Client{Timeout: time.Second}
+
+// scrapePrometheus parses the Prometheus text format. Metrics with
+// labels are keyed by the name with labels (e.g. 'name{a="b"}').
+func scrapePrometheus(ep string) (map[string]float64, error) {
+	resp, err := metricsClient.Get(ep)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q from %q", resp.Status, ep)
+	}
+
+	vs := make(map[string]float64)
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// value follows the last space, after labels that may have spaces
+		i := strings.LastIndex(line, " ")
+		if i < 0 {
+			continue
+		}
+		v, err := strconv.ParseFloat(line[i+1:], 64)
+		if err != nil {
+			continue
+		}
+		vs[strings.TrimSpace(line[:i])] = v
+	}
+	return vs, sc.Err()
+}
+
+// scrapeZookeeperMntr parses the tab-separated 'mntr' output.
+func scrapeZookeeperMntr(ep string) (map[string]float64, error) {
+	out, err := zookeeperFourLetterWord(ep, "mntr", time.Second)
+	if err != nil {
+		return nil, err
+	}
+	vs := make(map[string]float64)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		v, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		vs[fields[0]] = v
+	}
+	if len(vs) == 0 {
+		return nil, fmt.Errorf("no metric in mntr output %q", out)
+	}
+	return vs, nil
+}
+
+// consulMetricsSummary is the response of '/v1/agent/metrics'.
+type consulMetricsSummary struct {
+	Gauges []struct {
+		Name  string
+		Value float64
+	}
+	Counters []consulSampledValue
+	Samples  []consulSampledValue
+}
+
+type consulSampledValue struct {
+	Name  string
+	Count float64
+	Sum   float64
+	Mean  float64
+}
+
+// scrapeConsulMetrics flattens Consul metrics: gauges by name,
+// and counters and samples by name with '.count', '.sum', '.mean'.
+func scrapeConsulMetrics(ep string) (map[string]float64, error) {
+	resp, err := metricsClient.Get(ep)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q from %q (%q)", resp.Status, ep, strings.TrimSpace(string(b)))
+	}
+
+	var sum consulMetricsSummary
+	if err = json.Unmarshal(b, &sum); err != nil {
+		return nil, err
+	}
+	vs := make(map[string]float64)
+	for _, g := range sum.Gauges {
+		vs[g.Name] = g.Value
+	}
+	for _, s := range append(sum.Counters, sum.Samples...) {
+		vs[s.Name+".count"] = s.Count
+		vs[s.Name+".sum"] = s.Sum
+		vs[s.Name+".mean"] = s.Mean
+	}
+	return vs, nil
+}
@@ -0,0 +1,307 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func Test_scrapePrometheus(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+
+		metrics map[string]float64
+		err     bool
+	}{
+		{
+			status: http.StatusOK,
+			body: `# HELP etcd_server_has_leader Whether or not a leader exists.
+# TYPE etcd_server_has_leader gauge
+etcd_server_has_leader 1
+etcd_disk_wal_fsync_duration_seconds_sum 0.5
+etcd_disk_wal_fsync_duration_seconds_count 4
+grpc_server_handled_total{grpc_code="OK",grpc_method="Range"} 1.5e+06
+process_start_time_seconds{label="with space"} 2
+
+invalid_value abc
+no_value
+`,
+			metrics: map[string]float64{
+				"etcd_server_has_leader":                                        1,
+				"etcd_disk_wal_fsync_duration_seconds_sum":                      0.5,
+				"etcd_disk_wal_fsync_duration_seconds_count":                    4,
+				`grpc_server_handled_total{grpc_code="OK",grpc_method="Range"}`: 1500000,
+				`process_start_time_seconds{label="with space"}`:                2,
+			},
+		},
+		{
+			status:  http.StatusOK,
+			body:    "",
+			metrics: map[string]float64{},
+		},
+		{
+			status: http.StatusServiceUnavailable,
+			body:   "etcd_server_has_leader 0\n",
+			err:    true,
+		},
+	}
+	for i, tt := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			fmt.Fprint(w, tt.body)
+		}))
+		metrics, err := scrapePrometheus(ts.URL + "/metrics")
+		ts.Close()
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+		if !tt.err && !reflect.DeepEqual(metrics, tt.metrics) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.metrics, metrics)
+		}
+	}
+}
+
+func Test_scrapeZookeeperMntr(t *testing.T) {
+	tests := []struct {
+		out string
+
+		metrics map[string]float64
+		err     bool
+	}{
+		{
+			out: "zk_version\t3.5.3-beta-8ce24f9e675cbefffb8f21a47e06b42864475a60, built on 04/03/2017 16:19 GMT\n" +
+				"zk_avg_latency\t2\n" +
+				"zk_max_latency\t127\n" +
+				"zk_packets_received\t1000000\n" +
+				"zk_server_state\tleader\n" +
+				"zk_znode_count\t5\n",
+			metrics: map[string]float64{
+				"zk_avg_latency":      2,
+				"zk_max_latency":      127,
+				"zk_packets_received": 1000000,
+				"zk_znode_count":      5,
+			},
+		},
+		{
+			// 'mntr' is not in the four letter word whitelist
+			out: "mntr is not executed because it is not in the whitelist.\n",
+			err: true,
+		},
+		{
+			out: "",
+			err: true,
+		},
+	}
+	for i, tt := range tests {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go func(out string) {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			cmd := make([]byte, 4)
+			if _, err = conn.Read(cmd); err != nil || string(cmd) != "mntr" {
+				return
+			}
+			fmt.Fprint(conn, out)
+		}(tt.out)
+		metrics, err := scrapeZookeeperMntr(ln.Addr().String())
+		ln.Close()
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+		if !tt.err && !reflect.DeepEqual(metrics, tt.metrics) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.metrics, metrics)
+		}
+	}
+}
+
+func Test_scrapeConsulMetrics(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+
+		metrics map[string]float64
+		err     bool
+	}{
+		{
+			status: http.StatusOK,
+			body: `{
+  "Timestamp": "2017-02-10 18:55:40 +0000 UTC",
+  "Gauges": [{"Name": "host.runtime.num_goroutines", "Value": 100, "Labels": {}}],
+  "Points": [],
+  "Counters": [{"Name": "consul.raft.apply", "Count": 10, "Sum": 20, "Min": 1, "Max": 3, "Mean": 2, "Stddev": 0.5, "Labels": {}}],
+  "Samples": [{"Name": "consul.raft.commitTime", "Count": 4, "Sum": 6, "Min": 1, "Max": 2, "Mean": 1.5, "Stddev": 0.5, "Labels": {}}]
+}`,
+			metrics: map[string]float64{
+				"host.runtime.num_goroutines":  100,
+				"consul.raft.apply.count":      10,
+				"consul.raft.apply.sum":        20,
+				"consul.raft.apply.mean":       2,
+				"consul.raft.commitTime.count": 4,
+				"consul.raft.commitTime.sum":   6,
+				"consul.raft.commitTime.mean":  1.5,
+			},
+		},
+		{
+			status:  http.StatusOK,
+			body:    `{"Gauges": [], "Counters": [], "Samples": []}`,
+			metrics: map[string]float64{},
+		},
+		{
+			status: http.StatusOK,
+			body:   `not json`,
+			err:    true,
+		},
+		{
+			status: http.StatusForbidden,
+			body:   "Permission denied",
+			err:    true,
+		},
+	}
+	for i, tt := range tests {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			fmt.Fprint(w, tt.body)
+		}))
+		metrics, err := scrapeConsulMetrics(ts.URL + "/v1/agent/metrics")
+		ts.Close()
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+		if !tt.err && !reflect.DeepEqual(metrics, tt.metrics) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.metrics, metrics)
+		}
+	}
+}
+
+func Test_databaseMetricsCollector_addRow(t *testing.T) {
+	metrics := []databaseMetric{
+		{column: "GAUGE", name: "gauge"},
+		{column: "COUNTER-DELTA", name: "counter", delta: true},
+		{column: "AVG-MS", name: "duration_sum", count: "duration_count", scale: 1000},
+	}
+	type scrape struct {
+		unixSecond int64
+		metrics    map[string]float64
+	}
+	tests := []struct {
+		suffix  bool
+		scrapes []scrape
+
+		rows []databaseMetricsRow
+	}{
+		{
+			// no delta at the first scrape
+			scrapes: []scrape{
+				{1, map[string]float64{"gauge": 5, "counter": 10, "duration_sum": 1, "duration_count": 4}},
+				{2, map[string]float64{"gauge": 7, "counter": 15, "duration_sum": 3, "duration_count": 8}},
+			},
+			rows: []databaseMetricsRow{
+				{unixSecond: 1, values: []float64{5, 0, 0}},
+				{unixSecond: 2, values: []float64{7, 5, 500}},
+			},
+		},
+		{
+			// counter reset on restart counts from zero
+			scrapes: []scrape{
+				{1, map[string]float64{"counter": 100, "duration_sum": 10, "duration_count": 40}},
+				{2, map[string]float64{"counter": 30, "duration_sum": 0.25, "duration_count": 1}},
+			},
+			rows: []databaseMetricsRow{
+				{unixSecond: 1, values: []float64{0, 0, 0}},
+				{unixSecond: 2, values: []float64{0, 30, 250}},
+			},
+		},
+		{
+			// no new sample has zero average, not NaN
+			scrapes: []scrape{
+				{1, map[string]float64{"duration_sum": 1, "duration_count": 4}},
+				{2, map[string]float64{"duration_sum": 1, "duration_count": 4}},
+				{3, map[string]float64{"duration_sum": 0, "duration_count": 0}},
+			},
+			rows: []databaseMetricsRow{
+				{unixSecond: 1, values: []float64{0, 0, 0}},
+				{unixSecond: 2, values: []float64{0, 0, 0}},
+				{unixSecond: 3, values: []float64{0, 0, 0}},
+			},
+		},
+		{
+			// same second is skipped, and missing metrics are zero
+			scrapes: []scrape{
+				{1, map[string]float64{"gauge": 1}},
+				{1, map[string]float64{"gauge": 2, "counter": 10}},
+				{2, map[string]float64{"counter": 10}},
+			},
+			rows: []databaseMetricsRow{
+				{unixSecond: 1, values: []float64{1, 0, 0}},
+				{unixSecond: 2, values: []float64{0, 0, 0}},
+			},
+		},
+		{
+			// Consul runtime metrics are prefixed with the host name
+			suffix: true,
+			scrapes: []scrape{
+				{1, map[string]float64{"host.gauge": 3, "host.counter": 1}},
+				{2, map[string]float64{"host.gauge": 4, "host.counter": 2}},
+			},
+			rows: []databaseMetricsRow{
+				{unixSecond: 1, values: []float64{3, 0, 0}},
+				{unixSecond: 2, values: []float64{4, 1, 0}},
+			},
+		},
+	}
+	for i, tt := range tests {
+		c := &databaseMetricsCollector{metrics: metrics, suffix: tt.suffix}
+		for _, sc := range tt.scrapes {
+			c.addRow(sc.unixSecond, sc.metrics)
+		}
+		if !reflect.DeepEqual(c.rows, tt.rows) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.rows, c.rows)
+		}
+	}
+}
+
+func Test_databaseMetricsCollector_add(t *testing.T) {
+	c := &databaseMetricsCollector{
+		metrics: []databaseMetric{{column: "GAUGE", name: "gauge"}},
+		scrape:  func() (map[string]float64, error) { return nil, fmt.Errorf("connection refused") },
+	}
+	// unresponsive database (e.g. paused) adds no row
+	if err := c.add(); err == nil {
+		t.Fatal("expected error")
+	}
+	if len(c.rows) != 0 {
+		t.Fatalf("expected no row, got %v", c.rows)
+	}
+
+	c.scrape = func() (map[string]float64, error) { return map[string]float64{"gauge": 1}, nil }
+	if err := c.add(); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.rows) != 1 || !reflect.DeepEqual(c.rows[0].values, []float64{1}) {
+		t.Fatalf("unexpected rows %v", c.rows)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// diagnosticsTimeout is the maximum duration to capture each diagnostics,
+// in addition to the CPU profile duration.
+const diagnosticsTimeout = 30 * time.Second
+
+// captureDiagnostics captures the diagnostics of the running database
+// under the diagnostics directory, prefixed with the unix second:
+// CPU and heap profiles and goroutine stacks of Go databases via
+// '/debug/pprof', and thread dumps and GC statistics of Zookeeper via
+// 'jstack' and 'jstat'. It returns the paths of the captured files.
+// zetcd and cetcd are captured from etcd behind them.
+func (t *transporterServer) captureDiagnostics(cpuProfileSeconds int64) ([]string, error) {
+	if !t.databaseStarted() || t.databaseExited() {
+		return nil, fmt.Errorf("database %q is not running", t.req.DatabaseID)
+	}
+	if err := os.MkdirAll(t.fs.diagnosticsDir, 0777); err != nil {
+		return nil, err
+	}
+
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return nil, err
+	}
+	prefix := filepath.Join(t.fs.diagnosticsDir, fmt.Sprintf("%d-", time.Now().Unix()))
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return nil, err
+	}
+	captures := dr.diagnostics(t, self, cpuProfileSeconds)
+
+	var (
+		fpaths []string
+		errs   []string
+	)
+	for _, c := range captures {
+		fpath := prefix + c.name
+		if err := c.capture(fpath); err != nil {
+			t.lg.Warn("failed to capture diagnostics", zap.String("path", fpath), zap.Error(err))
+			errs = append(errs, err.Error())
+			os.RemoveAll(fpath)
+			continue
+		}
+		t.lg.Info("captured diagnostics", zap.String("path", fpath))
+		fpaths = append(fpaths, fpath)
+	}
+	if len(fpaths) == 0 {
+		return nil, fmt.Errorf("failed to capture diagnostics (%s)", strings.Join(errs, ", "))
+	}
+	return fpaths, nil
+}
+
+// diagnosticsCapture captures a diagnostics into the file of 'name'.
+type diagnosticsCapture struct {
+	name    string
+	capture func(fpath string) error
+}
+
+// pprofCaptures returns the captures from the pprof endpoint.
+func pprofCaptures(ep string, cpuProfileSeconds int64) []diagnosticsCapture {
+	var captures []diagnosticsCapture
+	if cpuProfileSeconds > 0 {
+		took := time.Duration(cpuProfileSeconds) * time.Second
+		url := fmt.Sprintf("%s/profile?seconds=%d", ep, cpuProfileSeconds)
+		captures = append(captures, diagnosticsCapture{"cpu.pprof", func(fpath string) error { return getToFile(fpath, url, took) }})
+	}
+	return append(captures,
+		diagnosticsCapture{"heap.pprof", func(fpath string) error { return getToFile(fpath, ep+"/heap", 0) }},
+		diagnosticsCapture{"goroutine.txt", func(fpath string) error { return getToFile(fpath, ep+"/goroutine?debug=2", 0) }},
+	)
+}
+
+// getToFile writes the response body of the URL to the file.
+func getToFile(fpath, url string, took time.Duration) error {
+	cli := &http.Client{Timeout: took + diagnosticsTimeout}
+	resp, err := cli.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%q returned %q", url, resp.Status)
+	}
+
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
+
+// runToFile writes the output of the command to the file.
+func runToFile(fpath, execPath string, args ...string) error {
+	f, err := openToOverwrite(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	cmd := exec.Command(execPath, args...)
+	cmd.Stdout = f
+	cmd.Stderr = f
+	errc := make(chan error, 1)
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+	go func() { errc <- cmd.Wait() }()
+	select {
+	case err = <-errc:
+	case <-time.After(diagnosticsTimeout):
+		cmd.Process.Kill()
+		err = fmt.Errorf("%q took longer than %v", cmd.Path, diagnosticsTimeout)
+	}
+	return err
+}
+
+// jdkTool returns the path of JDK tool next to '--java-exec'.
+func (fs *flags) jdkTool(name string) string {
+	return filepath.Join(filepath.Dir(fs.javaExec), name)
+}
@@ -0,0 +1,132 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// diagnosticsStubDriver captures the CPU profile seconds of the request,
+// and a diagnostics that always fails.
+type diagnosticsStubDriver struct{ customDriver }
+
+func (diagnosticsStubDriver) diagnostics(t *transporterServer, self dbtesterpb.Peer, cpuProfileSeconds int64) []diagnosticsCapture {
+	return []diagnosticsCapture{
+		{fmt.Sprintf("cpu-%d.txt", cpuProfileSeconds), func(fpath string) error { return toFile(fmt.Sprint(cpuProfileSeconds), fpath) }},
+		{"failed.txt", func(fpath string) error {
+			toFile("partial", fpath)
+			return errors.New("stub failure")
+		}},
+	}
+}
+
+// TestTransferCaptureDiagnostics runs a schedule of diagnostics against
+// the stub captures, and checks that the captured files are under the
+// diagnostics directory of the member, which 'uploadLog' uploads.
+func TestTransferCaptureDiagnostics(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dbtester-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	driversMu.Lock()
+	orig := drivers["custom"]
+	drivers["custom"] = diagnosticsStubDriver{}
+	driversMu.Unlock()
+	defer func() {
+		driversMu.Lock()
+		drivers["custom"] = orig
+		driversMu.Unlock()
+	}()
+
+	fs := globalFlags.withDir(dir)
+	srv := newServer(zap.NewNop(), &fs)
+	if srv.databaseLogFile, err = openToAppend(fs.databaseLog); err != nil {
+		t.Fatal(err)
+	}
+	defer srv.databaseLogFile.Close()
+	srv.req = dbtesterpb.Request{
+		DatabaseID:    dbtesterpb.DatabaseID_custom,
+		PeerIPsString: "127.0.0.1",
+		DatabaseFlags: dbtesterpb.DatabaseFlags{Flag_Custom: &dbtesterpb.Flag_Custom{Protocol: "etcd", Command: "sleep 60"}},
+	}
+	if err = srv.startDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+		srv.stopDatabase()
+	}()
+
+	schedule := []int64{0, 5, 30}
+	for _, sec := range schedule {
+		req := &dbtesterpb.Request{Operation: dbtesterpb.Operation_CaptureDiagnostics, CPUProfileSeconds: sec}
+		if _, err = srv.Transfer(context.Background(), req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !strings.HasPrefix(fs.diagnosticsDir, dir+string(filepath.Separator)) {
+		t.Fatalf("diagnostics directory %q is not under %q", fs.diagnosticsDir, dir)
+	}
+	fis, err := ioutil.ReadDir(fs.diagnosticsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, fi := range fis {
+		names = append(names, fi.Name())
+	}
+	sort.Strings(names)
+	if len(names) != len(schedule) {
+		t.Fatalf("expected %d captured files, got %q", len(schedule), names)
+	}
+	re := regexp.MustCompile(`^[0-9]+-cpu-([0-9]+)\.txt$`)
+	captured := make(map[string]bool)
+	for i, name := range names {
+		m := re.FindStringSubmatch(name)
+		if m == nil {
+			t.Fatalf("unexpected captured file %q", name)
+		}
+		b, err := ioutil.ReadFile(filepath.Join(fs.diagnosticsDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != m[1] {
+			t.Fatalf("#%d: expected %q in %q, got %q", i, m[1], name, b)
+		}
+		captured[m[1]] = true
+	}
+	for _, sec := range schedule {
+		if !captured[fmt.Sprint(sec)] {
+			t.Fatalf("no captured file for %d CPU profile seconds in %q", sec, names)
+		}
+	}
+}
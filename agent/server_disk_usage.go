@@ -0,0 +1,185 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/etcd-io/dbtester/pkg/fileinspect"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// diskUsageCategory matches the files of a category,
+// by the slash-separated path relative to the data directory.
+type diskUsageCategory struct {
+	name  string
+	match func(rel string) bool
+}
+
+var etcdDiskUsageCategories = []diskUsageCategory{
+	{"WAL", func(rel string) bool { return strings.HasPrefix(rel, "member/wal/") }},
+	{"SNAP", func(rel string) bool {
+		return strings.HasPrefix(rel, "member/snap/") && strings.HasSuffix(rel, ".snap")
+	}},
+	{"DB", func(rel string) bool { return rel == "member/snap/db" }},
+}
+
+var zookeeperDiskUsageCategories = []diskUsageCategory{
+	{"LOG", func(rel string) bool { return strings.HasPrefix(rel, "version-2/log.") }},
+	{"SNAPSHOT", func(rel string) bool { return strings.HasPrefix(rel, "version-2/snapshot.") }},
+}
+
+var consulDiskUsageCategories = []diskUsageCategory{
+	{"RAFT-LOG", func(rel string) bool { return rel == "raft/raft.db" }},
+	{"SNAPSHOT", func(rel string) bool { return strings.HasPrefix(rel, "raft/snapshots/") }},
+}
+
+// diskUsageCollector measures the data directory by category
+// every interval. Files in no category are counted as 'OTHER'.
+type diskUsageCollector struct {
+	dataDir    string
+	categories []diskUsageCategory
+
+	stopc chan struct{}
+	donec chan struct{}
+	rows  []diskUsageRow
+}
+
+type diskUsageRow struct {
+	unixSecond int64
+	// usages are in the order of categories, followed by 'OTHER'
+	usages []fileinspect.Usage
+}
+
+// startDiskUsage starts measuring the data directory.
+func startDiskUsage(fs *flags, t *transporterServer) error {
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	dataDir := dr.dataDir(*fs)
+
+	c := &diskUsageCollector{dataDir: dataDir, categories: dr.diskUsageCategories(), stopc: make(chan struct{}), donec: make(chan struct{})}
+	if err = os.RemoveAll(fs.diskUsageCSV); err != nil {
+		return err
+	}
+
+	t.lg.Info(
+		"starting measuring disk usage",
+		zap.String("data-directory", dataDir),
+		zap.Duration("interval", fs.diskUsageInterval),
+		zap.String("path", fs.diskUsageCSV),
+	)
+	t.diskUsage = c
+	go func() {
+		defer close(c.donec)
+		for {
+			if err := c.add(); err != nil {
+				t.lg.Debug("failed to measure disk usage", zap.Error(err))
+			}
+			select {
+			case <-time.After(fs.diskUsageInterval):
+			case <-c.stopc:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// stopDiskUsage measures the data directory once more,
+// stops measuring, and saves the disk usage to CSV.
+func stopDiskUsage(fs *flags, t *transporterServer) error {
+	c := t.diskUsage
+	if c == nil {
+		return nil
+	}
+	close(c.stopc)
+	<-c.donec
+	t.diskUsage = nil
+
+	if err := c.add(); err != nil {
+		t.lg.Warn("failed to measure disk usage", zap.Error(err))
+	}
+	if err := c.save(fs.diskUsageCSV); err != nil {
+		return err
+	}
+	t.lg.Info("saved disk usage", zap.String("path", fs.diskUsageCSV), zap.Int("rows", len(c.rows)))
+	return nil
+}
+
+// add measures the data directory, and adds a row.
+func (c *diskUsageCollector) add() error {
+	um, err := fileinspect.DiskUsageBy(c.dataDir, func(rel string) string {
+		for _, ct := range c.categories {
+			if ct.match(rel) {
+				return ct.name
+			}
+		}
+		return "OTHER"
+	})
+	if err != nil {
+		return err
+	}
+	row := diskUsageRow{unixSecond: time.Now().Unix(), usages: make([]fileinspect.Usage, 0, len(c.categories)+1)}
+	for _, ct := range c.categories {
+		row.usages = append(row.usages, um[ct.name])
+	}
+	row.usages = append(row.usages, um["OTHER"])
+	c.rows = append(c.rows, row)
+	return nil
+}
+
+// save writes 'UNIX-SECOND' and the apparent and allocated bytes
+// of each category, 'OTHER', and 'TOTAL'.
+func (c *diskUsageCollector) save(fpath string) error {
+	names := make([]string, 0, len(c.categories)+2)
+	for _, ct := range c.categories {
+		names = append(names, ct.name)
+	}
+	names = append(names, "OTHER", "TOTAL")
+
+	cols := []dataframe.Column{dataframe.NewColumn("UNIX-SECOND")}
+	for _, name := range names {
+		cols = append(cols,
+			dataframe.NewColumn(name+"-APPARENT-BYTES"),
+			dataframe.NewColumn(name+"-ALLOCATED-BYTES"),
+		)
+	}
+	for _, row := range c.rows {
+		var total fileinspect.Usage
+		for _, u := range row.usages {
+			total.Add(u)
+		}
+		cols[0].PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", row.unixSecond)))
+		for i, u := range append(row.usages, total) {
+			cols[2*i+1].PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", u.ApparentBytes)))
+			cols[2*i+2].PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", u.AllocatedBytes)))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(fpath)
+}
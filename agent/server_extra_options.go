@@ -0,0 +1,155 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// extraTemplateData is the data to render extra options and
+// custom database commands with.
+type extraTemplateData struct {
+	MemberIndex int
+	// MemberID is 'MemberIndex' + 1, for servers with 1-based IDs.
+	MemberID int
+	IP       string
+	PeerIPs  []string
+	// PeerAddrs and ClientAddrs are "host:port" of all members.
+	PeerAddrs   []string
+	ClientAddrs []string
+	ClientPort  int64
+	PeerPort    int64
+	DataDir     string
+	ConfigFile  string
+}
+
+// extraOptions are the rendered extra options of the member.
+type extraOptions struct {
+	flags      []string
+	proxyFlags []string
+	// env is nil without extra environment, to inherit the agent's
+	env []string
+	// config is the rendered configuration template, written to
+	// '--extra-config' if not empty
+	config string
+}
+
+// renderExtraOptions renders the extra options in the request,
+// and writes the configuration file if any.
+func (t *transporterServer) renderExtraOptions(fs *flags) (extraOptions, error) {
+	var ex extraOptions
+	eo := t.req.ExtraOptions
+	if eo == nil {
+		return ex, nil
+	}
+
+	data, err := t.extraTemplateData(fs)
+	if err != nil {
+		return ex, err
+	}
+
+	if eo.ConfigTemplate != "" {
+		if ex.config, err = renderTemplate("config", eo.ConfigTemplate, data); err != nil {
+			return ex, err
+		}
+		t.lg.Info("writing extra config file", zap.String("path", fs.extraConfig))
+		if err = toFile(ex.config, fs.extraConfig); err != nil {
+			return ex, err
+		}
+	}
+	if ex.flags, err = renderTemplates("flag", eo.Flags, data); err != nil {
+		return ex, err
+	}
+	if ex.proxyFlags, err = renderTemplates("proxy-flag", eo.ProxyFlags, data); err != nil {
+		return ex, err
+	}
+	if len(eo.Env) > 0 {
+		// the last value of duplicate keys takes effect
+		ex.env = append(os.Environ(), eo.Env...)
+	}
+	return ex, nil
+}
+
+// extraTemplateData returns the template data of the member.
+func (t *transporterServer) extraTemplateData(fs *flags) (extraTemplateData, error) {
+	dataDir, err := databaseDataDir(*fs, t.req.DatabaseID)
+	if err != nil {
+		return extraTemplateData{}, err
+	}
+	peers, err := requestPeers(t.req)
+	if err != nil {
+		return extraTemplateData{}, err
+	}
+	peerAddrs := make([]string, len(peers))
+	clientAddrs := make([]string, len(peers))
+	for i, p := range peers {
+		peerAddrs[i] = p.PeerAddr()
+		clientAddrs[i] = p.ClientAddr()
+	}
+	peerIPs := dbtesterpb.PeerHosts(peers)
+	return extraTemplateData{
+		MemberIndex: int(t.req.IPIndex),
+		MemberID:    int(t.req.IPIndex) + 1,
+		IP:          peerIPs[t.req.IPIndex],
+		PeerIPs:     peerIPs,
+		PeerAddrs:   peerAddrs,
+		ClientAddrs: clientAddrs,
+		ClientPort:  peers[t.req.IPIndex].ClientPort,
+		PeerPort:    peers[t.req.IPIndex].PeerPort,
+		DataDir:     dataDir,
+		ConfigFile:  fs.extraConfig,
+	}, nil
+}
+
+func renderTemplates(name string, texts []string, data extraTemplateData) ([]string, error) {
+	rs := make([]string, 0, len(texts))
+	for i, text := range texts {
+		r, err := renderTemplate(fmt.Sprintf("%s-%d", name, i), text, data)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+// templateFuncs are the functions in templates
+// (e.g. '{{join .PeerAddrs ","}}').
+var templateFuncs = template.FuncMap{"join": strings.Join}
+
+func renderTemplate(name, text string, data extraTemplateData) (string, error) {
+	tpl, err := template.New(name).Option("missingkey=error").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s %q (%v)", name, text, err)
+	}
+	buf := new(bytes.Buffer)
+	if err = tpl.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("failed to render %s %q (%v)", name, text, err)
+	}
+	return buf.String(), nil
+}
+
+// shellQuote quotes the argument for 'sh -c'.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,263 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// startDatabase starts the database process, but not its proxy,
+// and closes 't.cmdWait' when the process exits.
+func (t *transporterServer) startDatabase() error {
+	t.started = time.Now()
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	if err = dr.start(t.fs, t); err != nil {
+		return err
+	}
+	if _, ok := dr.(etcdEmbedDriver); ok {
+		// the server in the agent process closes 't.cmdWait' on its own
+		return nil
+	}
+	t.embedded = nil
+	if err = t.applyResourceLimits(); err != nil {
+		t.cmd.Process.Kill()
+		t.cmd.Wait()
+		return err
+	}
+
+	go func(cmd *exec.Cmd, cmdWait chan struct{}) {
+		defer close(cmdWait)
+		if err := cmd.Wait(); err != nil {
+			t.lg.Warn("t.cmd.Wait() returned error", zap.Error(err))
+			return
+		}
+		t.lg.Info("exiting", zap.String("executable-path", cmd.Path))
+	}(t.cmd, t.cmdWait)
+	return nil
+}
+
+// startProxy starts the proxy in front of the database, if any,
+// and closes 't.proxyCmdWait' when the process exits.
+func (t *transporterServer) startProxy() error {
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	name := dr.proxy()
+	if name == "" {
+		return nil
+	}
+	if err = dr.startProxy(t.fs, t); err != nil {
+		return err
+	}
+
+	go func(cmd *exec.Cmd, cmdWait chan struct{}) {
+		defer close(cmdWait)
+		if err := cmd.Wait(); err != nil {
+			t.lg.Warn("t.proxyCmd.Wait() returned error", zap.String("proxy", name), zap.Error(err))
+			return
+		}
+		t.lg.Info("exiting proxy", zap.String("proxy", name), zap.String("executable-path", cmd.Path))
+	}(t.proxyCmd, t.proxyCmdWait)
+	return nil
+}
+
+// stopProxy gracefully stops the proxy process, if running.
+func (t *transporterServer) stopProxy() {
+	if t.proxyCmd == nil {
+		return
+	}
+	select {
+	case <-t.proxyCmdWait:
+		t.lg.Info("proxy already exited", zap.Int64("pid", t.proxyPid))
+		return
+	default:
+	}
+
+	t.lg.Info("sending", zap.String("syscall", syscall.SIGINT.String()), zap.Int64("pid", t.proxyPid), zap.String("executable-path", t.proxyCmd.Path))
+	if err := t.proxyCmd.Process.Signal(syscall.SIGINT); err != nil {
+		t.lg.Warn("syscall.SIGINT failed", zap.Error(err))
+
+		time.Sleep(3 * time.Second)
+		t.lg.Info("sending", zap.String("syscall", syscall.SIGTERM.String()), zap.Int64("pid", t.proxyPid), zap.String("executable-path", t.proxyCmd.Path))
+		if err := syscall.Kill(int(t.proxyPid), syscall.SIGTERM); err != nil {
+			t.lg.Warn("syscall.Kill failed", zap.Error(err))
+		}
+	}
+
+	<-t.proxyCmdWait
+	t.lg.Info("stopped", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.proxyPid))
+}
+
+// databaseExited returns true if the database process has exited.
+func (t *transporterServer) databaseExited() bool {
+	select {
+	case <-t.cmdWait:
+		return true
+	default:
+		return false
+	}
+}
+
+// databaseStarted returns true if the database has been started,
+// as a process or in the agent process.
+func (t *transporterServer) databaseStarted() bool {
+	return t.cmd != nil || t.embedded != nil
+}
+
+func (t *transporterServer) signalDatabase(sig syscall.Signal) error {
+	if !t.databaseStarted() {
+		return fmt.Errorf("nil command")
+	}
+	if t.databaseExited() {
+		return fmt.Errorf("database %q (pid %d) has already exited", t.req.DatabaseID, t.pid)
+	}
+	if t.embedded != nil {
+		return t.signalEmbeddedEtcd(sig)
+	}
+	t.lg.Info("sending", zap.String("syscall", sig.String()), zap.Int64("pid", t.pid), zap.String("executable-path", t.cmd.Path))
+	return syscall.Kill(int(t.pid), sig)
+}
+
+// killDatabase kills the database process without any graceful shutdown.
+func (t *transporterServer) killDatabase() error {
+	if err := t.signalDatabase(syscall.SIGKILL); err != nil {
+		return err
+	}
+	<-t.cmdWait
+	t.paused = false
+	t.lg.Info("killed", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+	return nil
+}
+
+// restartDatabase restarts the database process with its existing data
+// directory. The running process is shut down first, if any. The proxy
+// in front of the database (e.g. zetcd) is restarted as well, so that
+// it does not keep serving from the connections to the old process.
+func (t *transporterServer) restartDatabase() error {
+	if !t.databaseStarted() {
+		return fmt.Errorf("nil command")
+	}
+	if !t.databaseExited() {
+		if t.paused {
+			if err := t.resumeDatabase(); err != nil {
+				return err
+			}
+		}
+		if err := t.signalDatabase(syscall.SIGTERM); err != nil {
+			return err
+		}
+		<-t.cmdWait
+	}
+	t.stopProxy()
+
+	if err := t.startDatabase(); err != nil {
+		return err
+	}
+	if err := t.startProxy(); err != nil {
+		return err
+	}
+	t.lg.Info("restarted", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+	return updateMetricsPID(t)
+}
+
+// pauseDatabase freezes the database process with SIGSTOP.
+func (t *transporterServer) pauseDatabase() error {
+	if err := t.signalDatabase(syscall.SIGSTOP); err != nil {
+		return err
+	}
+	t.paused = true
+	return nil
+}
+
+// resumeDatabase resumes the paused database process with SIGCONT.
+func (t *transporterServer) resumeDatabase() error {
+	if err := t.signalDatabase(syscall.SIGCONT); err != nil {
+		return err
+	}
+	t.paused = false
+	return nil
+}
+
+// stopDatabase gracefully stops the database process, if running.
+func (t *transporterServer) stopDatabase() {
+	if t.databaseExited() {
+		t.lg.Info("already exited", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+		return
+	}
+	if t.paused {
+		if err := t.resumeDatabase(); err != nil {
+			t.lg.Warn("failed to resume before stop", zap.Error(err))
+		}
+	}
+
+	// TODO: https://github.com/etcd-io/dbtester/issues/330
+	if err := t.signalDatabase(syscall.SIGINT); err != nil {
+		t.lg.Warn("syscall.SIGINT failed", zap.Error(err))
+
+		time.Sleep(3 * time.Second)
+		if err := t.signalDatabase(syscall.SIGTERM); err != nil {
+			t.lg.Warn("syscall.Kill failed", zap.Error(err))
+		}
+	}
+
+	time.Sleep(time.Second)
+	<-t.cmdWait
+}
+
+// upgradeDatabase stops the database, and restarts it with the binary
+// and flags of 'UpgradeDatabaseID', keeping the data directory.
+func (t *transporterServer) upgradeDatabase(req *dbtesterpb.Request) error {
+	if !t.databaseStarted() {
+		return fmt.Errorf("nil command")
+	}
+	from, to := t.req.DatabaseID, req.UpgradeDatabaseID
+	if dbtesterpb.DatabaseFamily(from.String()) != dbtesterpb.DatabaseFamily(to.String()) {
+		return fmt.Errorf("cannot upgrade %q to %q", from, to)
+	}
+	if !t.databaseExited() {
+		if t.paused {
+			if err := t.resumeDatabase(); err != nil {
+				return err
+			}
+		}
+		if err := t.signalDatabase(syscall.SIGTERM); err != nil {
+			return err
+		}
+		<-t.cmdWait
+	}
+
+	t.req.DatabaseID = to
+	t.req.DatabaseVersion = req.DatabaseVersion
+	t.req.DatabaseFlags = req.DatabaseFlags
+	if err := t.detectVersion(); err != nil {
+		return err
+	}
+	if err := t.startDatabase(); err != nil {
+		return err
+	}
+	t.lg.Info("upgraded", zap.String("from", from.String()), zap.String("to", to.String()), zap.String("version", t.databaseVersion), zap.Int64("pid", t.pid))
+	return updateMetricsPID(t)
+}
@@ -0,0 +1,142 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// TestTransferConcurrentFaults sends fault operations during heartbeats,
+// which 'go test -race' reports if they race on the process state.
+func TestTransferConcurrentFaults(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dbtester-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs := globalFlags.withDir(dir)
+	srv := newServer(zap.NewNop(), &fs)
+	if srv.databaseLogFile, err = openToAppend(fs.databaseLog); err != nil {
+		t.Fatal(err)
+	}
+	defer srv.databaseLogFile.Close()
+	srv.req = dbtesterpb.Request{
+		DatabaseID:    dbtesterpb.DatabaseID_custom,
+		PeerIPsString: "127.0.0.1",
+		DatabaseFlags: dbtesterpb.DatabaseFlags{Flag_Custom: &dbtesterpb.Flag_Custom{Protocol: "etcd", Command: "sleep 60"}},
+	}
+	if err = srv.startDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	ops := []dbtesterpb.Operation{
+		dbtesterpb.Operation_Pause,
+		dbtesterpb.Operation_Resume,
+		dbtesterpb.Operation_Restart,
+		dbtesterpb.Operation_Kill,
+		dbtesterpb.Operation_Restart,
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(n int64) {
+			defer wg.Done()
+			req := &dbtesterpb.Request{Operation: dbtesterpb.Operation_Heartbeat, CurrentClientNumber: n}
+			if _, err := srv.Transfer(context.Background(), req); err != nil {
+				t.Error(err)
+			}
+		}(int64(i))
+	}
+	for _, op := range ops {
+		wg.Add(1)
+		go func(op dbtesterpb.Operation) {
+			defer wg.Done()
+			// faults may fail depending on the order (e.g. pause after kill)
+			srv.Transfer(context.Background(), &dbtesterpb.Request{Operation: op})
+		}(op)
+	}
+	wg.Wait()
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if srv.paused && !srv.databaseExited() {
+		if err = srv.resumeDatabase(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv.stopDatabase()
+}
+
+// TestTransferHeartbeatDuringStop sends a heartbeat while 'Stop' waits
+// for more monitoring data, which must not wait for the stop.
+func TestTransferHeartbeatDuringStop(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dbtester-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fs := globalFlags.withDir(dir)
+	srv := newServer(zap.NewNop(), &fs)
+	if srv.databaseLogFile, err = openToAppend(fs.databaseLog); err != nil {
+		t.Fatal(err)
+	}
+	srv.req = dbtesterpb.Request{
+		DatabaseID:    dbtesterpb.DatabaseID_custom,
+		PeerIPsString: "127.0.0.1",
+		DatabaseFlags: dbtesterpb.DatabaseFlags{Flag_Custom: &dbtesterpb.Flag_Custom{Protocol: "etcd", Command: "sleep 60"}},
+	}
+	if err = srv.startDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	stopc := make(chan error, 1)
+	go func() {
+		_, err := srv.Transfer(context.Background(), &dbtesterpb.Request{Operation: dbtesterpb.Operation_Stop, DatabaseID: dbtesterpb.DatabaseID_custom})
+		stopc <- err
+	}()
+	time.Sleep(500 * time.Millisecond)
+
+	heartbeatc := make(chan error, 1)
+	go func() {
+		_, err := srv.Transfer(context.Background(), &dbtesterpb.Request{Operation: dbtesterpb.Operation_Heartbeat, CurrentClientNumber: 1})
+		heartbeatc <- err
+	}()
+	select {
+	case err = <-heartbeatc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case err = <-stopc:
+		t.Fatalf("stop finished before heartbeat (%v)", err)
+	}
+
+	if err = <-stopc; err != nil {
+		t.Fatal(err)
+	}
+	if !srv.databaseExited() {
+		t.Fatal("database is still running after stop")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/etcd-io/dbtester/pkg/gclog"
+
+	"go.uber.org/zap"
+)
+
+// gcLogs returns the Zookeeper GC log, and the ones kept on restart.
+func gcLogs(fs flags) ([]string, error) {
+	fpaths, err := filepath.Glob(fs.zkGCLog + ".*")
+	if err != nil {
+		return nil, err
+	}
+	if exist(fs.zkGCLog) {
+		fpaths = append(fpaths, fs.zkGCLog)
+	}
+	return fpaths, nil
+}
+
+// removeGCLogs removes the GC logs and metrics of previous runs.
+func removeGCLogs(fs flags) error {
+	fpaths, err := gcLogs(fs)
+	if err != nil {
+		return err
+	}
+	for _, fpath := range append(fpaths, fs.gcMetricsCSV) {
+		if err = os.RemoveAll(fpath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveGCMetrics parses the Zookeeper GC logs into per-second GC pauses.
+// It must be called after the database has stopped, so that the JVM
+// has flushed the GC log.
+func saveGCMetrics(fs *flags, t *transporterServer) error {
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	if !dr.gcLogs() {
+		return nil
+	}
+	fpaths, err := gcLogs(*fs)
+	if err != nil {
+		return err
+	}
+
+	var pauses []gclog.Pause
+	for _, fpath := range fpaths {
+		f, err := os.Open(fpath)
+		if err != nil {
+			return err
+		}
+		ps, err := gclog.Parse(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+		pauses = append(pauses, ps...)
+	}
+	sort.SliceStable(pauses, func(i, j int) bool { return pauses[i].Time.Before(pauses[j].Time) })
+
+	secs := gclog.PerSecond(pauses)
+	if err = gclog.SaveCSV(fs.gcMetricsCSV, secs); err != nil {
+		return err
+	}
+	t.lg.Info("saved GC metrics",
+		zap.Strings("gc-logs", fpaths),
+		zap.String("path", fs.gcMetricsCSV),
+		zap.Int("pauses", len(pauses)),
+		zap.Int("rows", len(secs)),
+	)
+	return nil
+}
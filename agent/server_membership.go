@@ -0,0 +1,276 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// clusterIPIndexes returns the indexes of cluster members in 'PeerIPsString'.
+func clusterIPIndexes(req dbtesterpb.Request) []int {
+	if len(req.ClusterIPIndexes) == 0 {
+		n := len(strings.Split(req.PeerIPsString, dbtesterpb.PeerSeparator))
+		idxs := make([]int, n)
+		for i := range idxs {
+			idxs[i] = i
+		}
+		return idxs
+	}
+	idxs := make([]int, len(req.ClusterIPIndexes))
+	for i, idx := range req.ClusterIPIndexes {
+		idxs[i] = int(idx)
+	}
+	return idxs
+}
+
+// inCluster returns true if the member of the request is in the cluster.
+func inCluster(req dbtesterpb.Request) bool {
+	for _, idx := range clusterIPIndexes(req) {
+		if idx == int(req.IPIndex) {
+			return true
+		}
+	}
+	return false
+}
+
+// requestPeers returns the peers in 'PeerIPsString'.
+func requestPeers(req dbtesterpb.Request) ([]dbtesterpb.Peer, error) {
+	peers, err := dbtesterpb.ParsePeers(req.DatabaseID, req.PeerIPsString)
+	if err != nil {
+		return nil, err
+	}
+	if int(req.IPIndex) >= len(peers) {
+		return nil, fmt.Errorf("IP index %d out of range %v", req.IPIndex, peers)
+	}
+	return peers, nil
+}
+
+// selfPeer returns this member in 'PeerIPsString'.
+func selfPeer(req dbtesterpb.Request) (dbtesterpb.Peer, error) {
+	peers, err := requestPeers(req)
+	if err != nil {
+		return dbtesterpb.Peer{}, err
+	}
+	return peers[req.IPIndex], nil
+}
+
+// otherMembers returns the cluster members other than this member.
+func otherMembers(req dbtesterpb.Request) ([]dbtesterpb.Peer, error) {
+	peers, err := requestPeers(req)
+	if err != nil {
+		return nil, err
+	}
+	var others []dbtesterpb.Peer
+	for _, idx := range clusterIPIndexes(req) {
+		if idx != int(req.IPIndex) {
+			others = append(others, peers[idx])
+		}
+	}
+	return others, nil
+}
+
+// etcdClientURL returns the client URL of etcd on the peer,
+// which is behind the proxy for zetcd and cetcd.
+func etcdClientURL(id dbtesterpb.DatabaseID, p dbtesterpb.Peer) string {
+	return "http://" + p.Addr(p.EtcdClientPort(id))
+}
+
+// joinCluster adds this member to the running cluster,
+// and starts the database with an empty data directory.
+func (t *transporterServer) joinCluster() error {
+	if t.databaseStarted() && !t.databaseExited() {
+		return fmt.Errorf("database %q (pid %d) is already running", t.req.DatabaseID, t.pid)
+	}
+	if !inCluster(t.req) {
+		return fmt.Errorf("IP index %d is not in cluster %v", t.req.IPIndex, t.req.ClusterIPIndexes)
+	}
+	others, err := otherMembers(t.req)
+	if err != nil {
+		return err
+	}
+	if len(others) == 0 {
+		return fmt.Errorf("no member to join")
+	}
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return err
+	}
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	if err = t.removeDataDir(); err != nil {
+		return err
+	}
+	if err = dr.beforeJoin(t, others, self); err != nil {
+		return err
+	}
+
+	t.joinExisting = true
+	if err = t.startDatabase(); err != nil {
+		return err
+	}
+
+	if err = dr.afterJoin(t, others, self); err != nil {
+		return err
+	}
+	t.standby = false
+	t.lg.Info("joined", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+
+	if t.metricsCSV == nil {
+		if err = startMetrics(t.fs, t); err != nil {
+			return err
+		}
+		if err = startDatabaseMetrics(t.fs, t); err != nil {
+			return err
+		}
+		return startDiskUsage(t.fs, t)
+	}
+	return updateMetricsPID(t)
+}
+
+// leaveCluster removes this member from the cluster, and stops the database.
+func (t *transporterServer) leaveCluster() error {
+	if !t.databaseStarted() || t.databaseExited() {
+		return fmt.Errorf("database %q is not running", t.req.DatabaseID)
+	}
+	if inCluster(t.req) {
+		return fmt.Errorf("IP index %d is still in cluster %v", t.req.IPIndex, t.req.ClusterIPIndexes)
+	}
+	others, err := otherMembers(t.req)
+	if err != nil {
+		return err
+	}
+	if len(others) == 0 {
+		return fmt.Errorf("no member to remain")
+	}
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return err
+	}
+	if t.paused {
+		if err := t.resumeDatabase(); err != nil {
+			return err
+		}
+	}
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	if err = dr.leave(t, others, self); err != nil {
+		return err
+	}
+
+	select {
+	case <-t.cmdWait:
+	case <-time.After(10 * time.Second):
+		t.lg.Warn("database did not exit after leaving; terminating", zap.Int64("pid", t.pid))
+		if err := t.signalDatabase(syscall.SIGTERM); err != nil {
+			return err
+		}
+		<-t.cmdWait
+	}
+	t.lg.Info("left", zap.String("database", t.req.DatabaseID.String()), zap.Int64("pid", t.pid))
+	return nil
+}
+
+func newEtcdMemberClient(id dbtesterpb.DatabaseID, members []dbtesterpb.Peer) (*clientv3.Client, error) {
+	eps := make([]string, len(members))
+	for i := range members {
+		eps[i] = etcdClientURL(id, members[i])
+	}
+	return clientv3.New(clientv3.Config{Endpoints: eps, DialTimeout: 5 * time.Second})
+}
+
+func (t *transporterServer) addEtcdMember(members []dbtesterpb.Peer, peerURL string) error {
+	cli, err := newEtcdMemberClient(t.req.DatabaseID, members)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	resp, err := cli.MemberAdd(ctx, []string{peerURL})
+	cancel()
+	if err != nil {
+		return err
+	}
+	t.lg.Info("added etcd member", zap.String("peer-url", peerURL), zap.String("member-id", fmt.Sprintf("%x", resp.Member.ID)))
+	return nil
+}
+
+func (t *transporterServer) removeEtcdMember(members []dbtesterpb.Peer, peerURL string) error {
+	cli, err := newEtcdMemberClient(t.req.DatabaseID, members)
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	resp, err := cli.MemberList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, m := range resp.Members {
+		for _, u := range m.PeerURLs {
+			if u != peerURL {
+				continue
+			}
+			if _, err = cli.MemberRemove(ctx, m.ID); err != nil {
+				return err
+			}
+			t.lg.Info("removed etcd member", zap.String("peer-url", peerURL), zap.String("member-id", fmt.Sprintf("%x", m.ID)))
+			return nil
+		}
+	}
+	return fmt.Errorf("no etcd member with peer URL %q", peerURL)
+}
+
+// reconfigZookeeper runs Zookeeper 3.5 dynamic reconfiguration
+// against one of the given members, with the command line client.
+func (t *transporterServer) reconfigZookeeper(members []dbtesterpb.Peer, op, spec string) error {
+	var servers []string
+	for _, p := range members {
+		servers = append(servers, p.ClientAddr())
+	}
+	cp, err := zookeeperClassPath(t.fs.zkWorkDir, zookeeperCLIMain)
+	if err != nil {
+		return err
+	}
+	args := append(strings.Fields(cp), "-server", strings.Join(servers, ","), "reconfig", op, spec)
+	cmd := exec.Command(t.fs.javaExec, args...)
+	cmd.Dir = t.fs.zkWorkDir
+
+	t.lg.Info("running Zookeeper reconfig", zap.String("command", cmd.Path+" "+strings.Join(args, " ")))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("Zookeeper reconfig %s %q failed (%v, %q)", op, spec, err, strings.TrimSpace(string(out)))
+	}
+	t.lg.Info("Zookeeper reconfig done", zap.String("output", strings.TrimSpace(string(out))))
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"os/exec"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// networkFault is the network fault applied by an agent.
+// Every rule matches on both source and destination IPs, so that
+// members sharing one host with loopback aliases do not affect
+// each other.
+type networkFault struct {
+	// iptablesRules are the rule specifications inserted with '-I'.
+	iptablesRules [][]string
+
+	// netemDevice is the device with the 'tc netem' qdisc, if any.
+	netemDevice string
+	// netemPrio is the filter priority and netemBand is the
+	// prio qdisc band that the netem qdisc is attached to.
+	netemPrio int
+	netemBand int
+}
+
+// netemRootHandle is the handle of the root prio qdisc, which is shared
+// by all members on the same host. Its priomap sends all traffic to the
+// first band, and only the filtered packets go through netem.
+const netemRootHandle = "1:"
+
+// maxNetemMembers is the number of members that can apply netem at once.
+// Each member attaches its netem qdisc to its own band of the shared prio
+// qdisc, which has up to 16 bands, and the first band has no fault.
+const maxNetemMembers = 15
+
+// applyNetworkFault applies the network fault from this member to the
+// target peers. It replaces any network fault applied before, and rolls
+// back the rules and qdisc that it has added if any step fails.
+func (t *transporterServer) applyNetworkFault(nf *dbtesterpb.NetworkFault) (err error) {
+	if nf == nil {
+		return fmt.Errorf("no network fault in request")
+	}
+	if err := t.removeNetworkFault(); err != nil {
+		return err
+	}
+
+	var netem []string
+	if nf.DelayMillisecond > 0 {
+		netem = append(netem, "delay", fmt.Sprintf("%dms", nf.DelayMillisecond))
+		if nf.JitterMillisecond > 0 {
+			netem = append(netem, fmt.Sprintf("%dms", nf.JitterMillisecond))
+		}
+	}
+	if nf.LossPercent > 0 {
+		netem = append(netem, "loss", fmt.Sprintf("%g%%", nf.LossPercent))
+	}
+	if len(netem) > 0 && t.req.IPIndex >= maxNetemMembers {
+		return fmt.Errorf("netem supports up to %d members, but got IP index %d", maxNetemMembers, t.req.IPIndex)
+	}
+
+	peers, err := requestPeers(t.req)
+	if err != nil {
+		return err
+	}
+	peerIPs := dbtesterpb.PeerHosts(peers)
+	self := peerIPs[t.req.IPIndex]
+
+	var targets []string
+	for _, idx := range nf.TargetIPIndexes {
+		if int(idx) >= len(peerIPs) {
+			return fmt.Errorf("target IP index %d out of range %v", idx, peerIPs)
+		}
+		if idx == t.req.IPIndex {
+			continue
+		}
+		// rules match on IPs, not ports
+		if peerIPs[idx] == self {
+			return fmt.Errorf("target IP index %d shares host %q with this member; use different loopback addresses (e.g. 127.0.0.2) instead", idx, self)
+		}
+		targets = append(targets, peerIPs[idx])
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no target peer for network fault (%+v)", *nf)
+	}
+
+	t.netFault = &networkFault{}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if rerr := t.removeNetworkFault(); rerr != nil {
+			t.lg.Warn("failed to roll back network fault", zap.Error(rerr))
+		}
+	}()
+
+	if nf.Partition {
+		for _, target := range targets {
+			for _, rule := range [][]string{
+				{"INPUT", "-s", target, "-d", self, "-j", "DROP"},
+				{"OUTPUT", "-s", self, "-d", target, "-j", "DROP"},
+			} {
+				if err := t.runNetworkCommand(t.fs.iptablesExec, append([]string{"-I"}, rule...)...); err != nil {
+					return err
+				}
+				t.netFault.iptablesRules = append(t.netFault.iptablesRules, rule)
+			}
+		}
+	}
+
+	if len(netem) == 0 {
+		return nil
+	}
+
+	dev, err := interfaceByIP(self)
+	if err != nil {
+		return err
+	}
+
+	// the first band is for the traffic without any fault
+	band := int(t.req.IPIndex) + 2
+	prio := int(t.req.IPIndex) + 1
+
+	// root qdisc may have been added by another member on the same host
+	if err = t.runNetworkCommand(t.fs.tcExec, "qdisc", "add", "dev", dev, "root", "handle", netemRootHandle,
+		"prio", "bands", "16", "priomap", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0", "0"); err != nil {
+		t.lg.Warn("failed to add root qdisc; re-using existing one", zap.String("device", dev), zap.Error(err))
+	}
+	args := []string{"qdisc", "add", "dev", dev, "parent", fmt.Sprintf("1:%d", band), "handle", fmt.Sprintf("%d:", band*10), "netem"}
+	if err = t.runNetworkCommand(t.fs.tcExec, append(args, netem...)...); err != nil {
+		return err
+	}
+	// removes the filters added below, even if some of them fail
+	t.netFault.netemDevice, t.netFault.netemPrio, t.netFault.netemBand = dev, prio, band
+
+	for _, target := range targets {
+		if err = t.runNetworkCommand(t.fs.tcExec, "filter", "add", "dev", dev, "parent", netemRootHandle, "protocol", "ip", "prio", fmt.Sprint(prio),
+			"u32", "match", "ip", "src", self+"/32", "match", "ip", "dst", target+"/32", "flowid", fmt.Sprintf("1:%d", band)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeNetworkFault removes the network fault applied by this agent.
+// The root prio qdisc is left in place, since other members on the same
+// host may still use it.
+func (t *transporterServer) removeNetworkFault() error {
+	if t.netFault == nil {
+		return nil
+	}
+	var errs []string
+	for _, rule := range t.netFault.iptablesRules {
+		if err := t.runNetworkCommand(t.fs.iptablesExec, append([]string{"-D"}, rule...)...); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if dev := t.netFault.netemDevice; dev != "" {
+		if err := t.runNetworkCommand(t.fs.tcExec, "filter", "del", "dev", dev, "parent", netemRootHandle, "protocol", "ip", "prio", fmt.Sprint(t.netFault.netemPrio)); err != nil {
+			errs = append(errs, err.Error())
+		}
+		if err := t.runNetworkCommand(t.fs.tcExec, "qdisc", "del", "dev", dev, "parent", fmt.Sprintf("1:%d", t.netFault.netemBand)); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	t.netFault = nil
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to remove network fault (%s)", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func (t *transporterServer) runNetworkCommand(name string, args ...string) error {
+	t.lg.Info("running network command", zap.String("command", name+" "+strings.Join(args, " ")))
+	out, err := exec.Command(name, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s failed (%v, %q)", name, strings.Join(args, " "), err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// interfaceByIP returns the name of the network interface with the IP.
+// Loopback aliases without an address of their own (e.g. 127.0.0.2)
+// resolve to the interface whose network contains the IP.
+func interfaceByIP(ip string) (string, error) {
+	pip := net.ParseIP(ip)
+	if pip == nil {
+		return "", fmt.Errorf("invalid IP %q", ip)
+	}
+	ifs, err := net.Interfaces()
+	if err != nil {
+		return "", err
+	}
+	contained := ""
+	for _, ifc := range ifs {
+		addrs, err := ifc.Addrs()
+		if err != nil {
+			return "", err
+		}
+		for _, addr := range addrs {
+			ipn, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+			if ipn.IP.Equal(pip) {
+				return ifc.Name, nil
+			}
+			if contained == "" && ipn.Contains(pip) {
+				contained = ifc.Name
+			}
+		}
+	}
+	if contained == "" {
+		return "", fmt.Errorf("no network interface with IP %q", ip)
+	}
+	return contained, nil
+}
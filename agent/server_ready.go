@@ -0,0 +1,155 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// readyTimeout is the maximum duration to wait for the database
+// to be ready. It must be shorter than the request timeout of control.
+const readyTimeout = 90 * time.Second
+
+// readiness is the result of the readiness probe of a member.
+type readiness struct {
+	// ready is the duration from the process start
+	// until the database serves client requests.
+	ready time.Duration
+	// leader is the duration from the process start
+	// until the member knows the cluster leader.
+	leader time.Duration
+}
+
+// probeResult is the result of one probe.
+type probeResult struct {
+	ready  bool
+	leader bool
+}
+
+// waitReady probes the database started at 't.started', until
+// it serves client requests and the cluster has a leader.
+func (t *transporterServer) waitReady() (readiness, error) {
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return readiness{}, err
+	}
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return readiness{}, err
+	}
+	// zetcd and cetcd proxies are not probed, only their etcd
+	probe := dr.probe(t.req, self)
+
+	var rd readiness
+	for time.Since(t.started) < readyTimeout {
+		if t.databaseExited() {
+			return rd, fmt.Errorf("database %q (pid %d) exited before ready", t.req.DatabaseID, t.pid)
+		}
+		pr := probe()
+		if pr.ready && rd.ready == 0 {
+			rd.ready = time.Since(t.started)
+			t.lg.Info("database is ready", zap.String("database", t.req.DatabaseID.String()), zap.Duration("took", rd.ready))
+		}
+		if pr.leader && rd.leader == 0 {
+			rd.leader = time.Since(t.started)
+			t.lg.Info("database has leader", zap.String("database", t.req.DatabaseID.String()), zap.Duration("took", rd.leader))
+		}
+		if rd.ready > 0 && rd.leader > 0 {
+			return rd, nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return rd, fmt.Errorf("database %q is not ready in %v (%+v)", t.req.DatabaseID, readyTimeout, rd)
+}
+
+// probeEtcd calls Status on the member, which succeeds once it serves
+// client requests, and reports the leader once elected.
+func probeEtcd(ep string) probeResult {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}, DialTimeout: time.Second})
+	if err != nil {
+		return probeResult{}
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	resp, err := cli.Status(ctx, ep)
+	cancel()
+	if err != nil {
+		return probeResult{}
+	}
+	return probeResult{ready: true, leader: resp.Leader != 0}
+}
+
+// probeZookeeper sends 'ruok' for readiness, and 'srvr' whose mode is
+// leader or follower only after the leader election.
+func probeZookeeper(ep string) probeResult {
+	out, err := zookeeperFourLetterWord(ep, "ruok", time.Second)
+	if err != nil || out != "imok" {
+		return probeResult{}
+	}
+	out, err = zookeeperFourLetterWord(ep, "srvr", time.Second)
+	if err != nil {
+		return probeResult{ready: true}
+	}
+	return probeResult{ready: true, leader: strings.Contains(out, "Mode: leader") || strings.Contains(out, "Mode: follower")}
+}
+
+func zookeeperFourLetterWord(ep, cmd string, timeout time.Duration) (string, error) {
+	conn, err := net.DialTimeout("tcp", ep, timeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(timeout))
+	if _, err = conn.Write([]byte(cmd)); err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadAll(conn)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// consulLeader returns the address of the leader that the Consul agent
+// at 'addr' knows, or an empty string if there is no leader yet.
+func consulLeader(addr string) (string, error) {
+	cli := &http.Client{Timeout: time.Second}
+	resp, err := cli.Get(fmt.Sprintf("http://%s/v1/status/leader", addr))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %q (%q)", resp.Status, strings.TrimSpace(string(b)))
+	}
+	// no leader is '""'
+	return strings.Trim(strings.TrimSpace(string(b)), `"`), nil
+}
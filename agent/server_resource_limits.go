@@ -0,0 +1,105 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/etcd-io/dbtester/pkg/cgroup"
+
+	"go.uber.org/zap"
+)
+
+// sysBlockDir is where to find the device number of '--disk-device'.
+const sysBlockDir = "/sys/class/block"
+
+// applyResourceLimits moves the started database process into the cgroup
+// of the requested limits, creating the cgroup on first start. Restarted
+// processes are moved into the same cgroup. It records the limits read
+// back from the cgroup, to report in response to 'Start'.
+func (t *transporterServer) applyResourceLimits() error {
+	rl := t.req.ResourceLimits
+	if rl == nil {
+		return nil
+	}
+
+	if t.cgroup == nil {
+		l := cgroup.Limits{
+			CPUs:        rl.CPUs,
+			MemoryBytes: rl.MemoryBytes,
+			IOWeight:    rl.IOWeight,
+			IOReadBPS:   rl.IOReadBytesPerSecond,
+			IOWriteBPS:  rl.IOWriteBytesPerSecond,
+			IOReadIOPS:  rl.IOReadIOPS,
+			IOWriteIOPS: rl.IOWriteIOPS,
+		}
+		if l.IOReadBPS > 0 || l.IOWriteBPS > 0 || l.IOReadIOPS > 0 || l.IOWriteIOPS > 0 {
+			dev, err := cgroup.BlockDevice(sysBlockDir, t.fs.diskDevice)
+			if err != nil {
+				return fmt.Errorf("failed to find device number of %q (%v)", t.fs.diskDevice, err)
+			}
+			l.IODevice = dev
+		}
+		fs := l.Files()
+		if len(fs) == 0 {
+			return nil
+		}
+
+		// members on the same host have their own cgroups
+		name := filepath.Join(t.fs.cgroupParent, fmt.Sprintf("%s-%d", t.req.DatabaseID, t.req.IPIndex))
+		g, err := cgroup.New(t.fs.cgroupMount, name, l)
+		if err != nil {
+			return err
+		}
+		t.cgroup = g
+		t.cgroupFiles = make([]string, len(fs))
+		for i := range fs {
+			t.cgroupFiles[i] = fs[i].Name
+		}
+	}
+
+	if err := t.cgroup.AddProcess(t.pid); err != nil {
+		return err
+	}
+	fs, err := t.cgroup.Read(t.cgroupFiles...)
+	if err != nil {
+		return err
+	}
+	ss := make([]string, len(fs))
+	for i := range fs {
+		ss[i] = fs[i].String()
+	}
+	t.resourceLimits = strings.Join(ss, ", ")
+	t.lg.Info("applied resource limits",
+		zap.String("cgroup", t.cgroup.Path),
+		zap.Int64("pid", t.pid),
+		zap.String("limits", t.resourceLimits),
+	)
+	return nil
+}
+
+// removeResourceLimits removes the cgroup after the database has stopped.
+func (t *transporterServer) removeResourceLimits() error {
+	if t.cgroup == nil {
+		return nil
+	}
+	if err := t.cgroup.Remove(); err != nil {
+		return err
+	}
+	t.cgroup = nil
+	return nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// snapshotTimeout is the maximum duration to save a snapshot,
+// or to wait for the restored member to be ready.
+const snapshotTimeout = 5 * time.Minute
+
+// saveSnapshot saves the snapshot of the running database
+// under the snapshot directory, and returns its size in bytes.
+func (t *transporterServer) saveSnapshot() (int64, error) {
+	if !t.databaseStarted() || t.databaseExited() {
+		return 0, fmt.Errorf("database %q is not running", t.req.DatabaseID)
+	}
+
+	// only keep the latest snapshot
+	if err := os.RemoveAll(t.fs.snapshotDir); err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(t.fs.snapshotDir, 0777); err != nil {
+		return 0, err
+	}
+
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return 0, err
+	}
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return 0, err
+	}
+	fpath, size, err := dr.saveSnapshot(t, self)
+	if err != nil {
+		return 0, err
+	}
+
+	t.snapshotPath = fpath
+	t.lg.Info("saved snapshot", zap.String("database", t.req.DatabaseID.String()), zap.String("path", fpath), zap.Int64("size", size))
+	return size, nil
+}
+
+// recoverMember stops the database, wipes its data directory, and
+// recovers this member in the running cluster (see 'recoverMember'
+// of each driver for how the snapshot saved by 'saveSnapshot' is used).
+func (t *transporterServer) recoverMember() error {
+	if !t.databaseStarted() {
+		return fmt.Errorf("nil command")
+	}
+	if t.snapshotPath == "" {
+		return fmt.Errorf("no snapshot saved for %q", t.req.DatabaseID)
+	}
+	others, err := otherMembers(t.req)
+	if err != nil {
+		return err
+	}
+	if len(others) == 0 {
+		return fmt.Errorf("no member to recover with")
+	}
+	self, err := selfPeer(t.req)
+	if err != nil {
+		return err
+	}
+
+	if !t.databaseExited() {
+		if t.paused {
+			if err := t.resumeDatabase(); err != nil {
+				return err
+			}
+		}
+		if err := t.signalDatabase(syscall.SIGTERM); err != nil {
+			return err
+		}
+		<-t.cmdWait
+	}
+
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	if err = dr.recoverMember(t, others, self); err != nil {
+		return err
+	}
+
+	t.lg.Info("recovered member", zap.String("database", t.req.DatabaseID.String()), zap.String("path", t.snapshotPath), zap.Int64("pid", t.pid))
+	return updateMetricsPID(t)
+}
+
+func saveEtcdSnapshot(ep, fpath string) (int64, error) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}, DialTimeout: 5 * time.Second})
+	if err != nil {
+		return 0, err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotTimeout)
+	defer cancel()
+	rd, err := cli.Snapshot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer rd.Close()
+
+	f, err := os.Create(fpath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	n, err := io.Copy(f, rd)
+	if err != nil {
+		return 0, err
+	}
+	return n, f.Sync()
+}
+
+// zookeeperSnapshotFiles returns the latest snapshot in the Zookeeper
+// 'version-2' directory, if any, and the transaction logs that have
+// the transactions after it. Like Zookeeper, it selects the logs that
+// start after the snapshot zxid, and the last log that starts before.
+func zookeeperSnapshotFiles(dir string) ([]string, error) {
+	snaps, err := zookeeperFilesByZxid(dir, "snapshot")
+	if err != nil {
+		return nil, err
+	}
+	logs, err := zookeeperFilesByZxid(dir, "log")
+	if err != nil {
+		return nil, err
+	}
+	if len(snaps) == 0 && len(logs) == 0 {
+		return nil, fmt.Errorf("no Zookeeper snapshot or transaction log in %q", dir)
+	}
+
+	var fs []string
+	snapZxid := uint64(0)
+	if len(snaps) > 0 {
+		latest := snaps[len(snaps)-1]
+		fs, snapZxid = append(fs, latest.path), latest.zxid
+	}
+	start := 0
+	for i, l := range logs {
+		if l.zxid <= snapZxid {
+			start = i
+		}
+	}
+	for _, l := range logs[start:] {
+		fs = append(fs, l.path)
+	}
+	return fs, nil
+}
+
+type zookeeperFile struct {
+	path string
+	zxid uint64
+}
+
+// zookeeperFilesByZxid returns the files with the prefix (e.g. "log" for
+// "log.100000001"), sorted by the zxid in the hex suffix.
+func zookeeperFilesByZxid(dir, prefix string) ([]zookeeperFile, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, prefix+".*"))
+	if err != nil {
+		return nil, err
+	}
+	var fs []zookeeperFile
+	for _, fpath := range matches {
+		zxid, err := strconv.ParseUint(strings.TrimPrefix(filepath.Ext(fpath), "."), 16, 64)
+		if err != nil {
+			continue
+		}
+		fs = append(fs, zookeeperFile{path: fpath, zxid: zxid})
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].zxid < fs[j].zxid })
+	return fs, nil
+}
+
+func (t *transporterServer) runConsulSnapshot(op, addr, fpath string) error {
+	execPath := t.fs.databaseExec(t.req.DatabaseID, t.fs.consulExec)
+	args := []string{"snapshot", op, "-http-addr=" + addr, fpath}
+	t.lg.Info("running Consul snapshot", zap.String("command", execPath+" "+strings.Join(args, " ")))
+	out, err := exec.Command(execPath, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("consul snapshot %s failed (%v, %q)", op, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// waitConsulLeader waits until the Consul agent at 'addr' knows the leader.
+func waitConsulLeader(addr string, timeout time.Duration) error {
+	for now := time.Now(); time.Since(now) < timeout; time.Sleep(100 * time.Millisecond) {
+		if leader, err := consulLeader(addr); err == nil && leader != "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("no Consul leader from %q in %v", addr, timeout)
+}
+
+func copyFile(src, dst string) (int64, error) {
+	r, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	w, err := os.Create(dst)
+	if err != nil {
+		return 0, err
+	}
+	defer w.Close()
+	n, err := io.Copy(w, r)
+	if err != nil {
+		return 0, err
+	}
+	return n, w.Sync()
+}
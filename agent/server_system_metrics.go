@@ -19,14 +19,15 @@ import (
 	"os"
 	"time"
 
-	"github.com/gyuho/linux-inspect/inspect"
-	"github.com/gyuho/linux-inspect/top"
+	"github.com/etcd-io/dbtester/pkg/procfs"
+
+	"github.com/gyuho/dataframe"
 	"go.uber.org/zap"
 )
 
 // startMetrics starts collecting metrics.
 func startMetrics(fs *flags, t *transporterServer) (err error) {
-	if fs == nil || t == nil || t.cmd == nil {
+	if fs == nil || t == nil || !t.databaseStarted() {
 		return fmt.Errorf("cannot find process to track (%+v, %+v)", fs, t)
 	}
 
@@ -36,60 +37,69 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 		zap.String("disk-device", fs.diskDevice),
 		zap.String("network-device", fs.networkInterface),
 		zap.Int64("pid", t.pid),
+		zap.Duration("interval", fs.metricsInterval),
 	)
 	if err = os.RemoveAll(fs.systemMetricsCSV); err != nil {
 		return err
 	}
+	if err = os.RemoveAll(fs.systemIOMetricsCSV); err != nil {
+		return err
+	}
 	if err = toFile(fmt.Sprintf("%d", t.req.CurrentClientNumber), t.clientNumPath); err != nil {
 		return err
 	}
 
-	tcfg := &top.Config{
-		Exec:           top.DefaultExecPath,
-		IntervalSecond: 1,
-		PID:            t.pid,
-	}
-	t.metricsCSV, err = inspect.NewCSV(
+	t.metricsCSV, err = procfs.NewCSV(
 		fs.systemMetricsCSV,
 		t.pid,
 		fs.diskDevice,
 		fs.networkInterface,
 		t.clientNumPath,
-		tcfg,
+		fs.metricsInterval,
 	)
-	if err := t.metricsCSV.Add(); err != nil {
+	if err != nil {
+		return err
+	}
+	t.metricsCSV.IOFilePath = fs.systemIOMetricsCSV
+	if err = t.metricsCSV.Add(); err != nil {
 		return err
 	}
 
+	// proxy and descendant processes are tracked in a separate CSV,
+	// since 'procfs.CSV' only tracks one process
+	if t.proxyPid != 0 || fs.trackDescendants {
+		if err = os.RemoveAll(fs.processMetricsCSV); err != nil {
+			return err
+		}
+		t.processMetrics = newProcessMetrics(t.pid, t.proxyPid, fs.trackDescendants)
+		t.lg.Info(
+			"starting collecting process metrics",
+			zap.Int64("pid", t.pid),
+			zap.Int64("proxy-pid", t.proxyPid),
+			zap.Bool("track-descendants", fs.trackDescendants),
+			zap.String("path", fs.processMetricsCSV),
+		)
+	}
+
 	go func() {
 		for {
 			select {
-			case <-time.After(time.Second):
-				if err := t.metricsCSV.Add(); err != nil {
-					t.lg.Warn("inspect.CSV.Add error", zap.Error(err))
-					continue
-				}
-
-			case <-t.uploadSig:
-				t.lg.Info("upload requested, saving CSV", zap.String("path", t.metricsCSV.FilePath))
-				if err := t.metricsCSV.Save(); err != nil {
-					t.lg.Warn("failed to save CSV", zap.Error(err))
-				} else {
-					t.lg.Info("saved CSV", zap.String("path", t.metricsCSV.FilePath))
+			case <-time.After(fs.metricsInterval):
+				t.metricsMu.Lock()
+				err := t.metricsCSV.Add()
+				if t.processMetrics != nil {
+					if perr := t.processMetrics.add(); perr != nil {
+						t.lg.Warn("failed to add process metrics", zap.Error(perr))
+					}
 				}
-
-				interpolated, err := t.metricsCSV.Interpolate()
+				t.metricsMu.Unlock()
 				if err != nil {
-					t.lg.Fatal("failed to inspect.CSV.Interpolate", zap.Error(err))
-				}
-				interpolated.FilePath = fs.systemMetricsCSVInterpolated
-
-				if err := interpolated.Save(); err != nil {
-					t.lg.Warn("failed to save CSV", zap.Error(err))
-				} else {
-					t.lg.Info("saved CSV", zap.String("path", interpolated.FilePath))
+					t.lg.Warn("procfs.CSV.Add error", zap.Error(err))
+					continue
 				}
 
+			case <-t.uploadSig:
+				saveMetrics(fs, t)
 				close(t.csvReady)
 				return
 
@@ -101,3 +111,241 @@ func startMetrics(fs *flags, t *transporterServer) (err error) {
 	}()
 	return nil
 }
+
+// saveMetrics saves the system and process metrics CSVs,
+// after the collector stops sampling.
+func saveMetrics(fs *flags, t *transporterServer) {
+	t.metricsMu.Lock()
+	defer t.metricsMu.Unlock()
+
+	t.lg.Info("upload requested, saving CSV", zap.String("path", t.metricsCSV.FilePath))
+	if err := t.metricsCSV.Save(); err != nil {
+		t.lg.Warn("failed to save CSV", zap.Error(err))
+	} else {
+		t.lg.Info("saved CSV", zap.String("path", t.metricsCSV.FilePath))
+	}
+
+	interpolated, err := t.metricsCSV.Interpolate()
+	if err != nil {
+		t.lg.Fatal("failed to procfs.CSV.Interpolate", zap.Error(err))
+	}
+	interpolated.FilePath = fs.systemMetricsCSVInterpolated
+	interpolated.IOFilePath = fs.systemIOMetricsCSVInterpolated
+
+	if err := interpolated.Save(); err != nil {
+		t.lg.Warn("failed to save CSV", zap.Error(err))
+	} else {
+		t.lg.Info("saved CSV", zap.String("path", interpolated.FilePath))
+	}
+
+	if t.processMetrics != nil {
+		if err := t.processMetrics.save(fs.processMetricsCSV); err != nil {
+			t.lg.Warn("failed to save process metrics CSV", zap.Error(err))
+		} else {
+			t.lg.Info("saved CSV", zap.String("path", fs.processMetricsCSV))
+		}
+	}
+}
+
+// updateMetricsPID points the system metrics collector
+// to the new database and proxy processes after restart.
+func updateMetricsPID(t *transporterServer) (err error) {
+	t.metricsMu.Lock()
+	defer t.metricsMu.Unlock()
+
+	if t.metricsCSV == nil {
+		return nil
+	}
+	t.lg.Info("tracking new process", zap.Int64("old-pid", t.metricsCSV.PID()), zap.Int64("new-pid", t.pid), zap.Int64("proxy-pid", t.proxyPid))
+	t.metricsCSV.SetPID(t.pid)
+	if t.processMetrics != nil {
+		t.processMetrics.pid = t.pid
+		t.processMetrics.proxyPid = t.proxyPid
+	}
+	return nil
+}
+
+// processMetrics tracks the proxy process (e.g. zetcd, cetcd),
+// and optionally all descendants of the database and proxy processes
+// (e.g. processes forked by the JVM), every sample interval.
+// Rows are keyed by the sample time in nanoseconds, since the interval
+// can be shorter than a second. 'PROXY-*' columns are of the one proxy
+// process, and 'DESCENDANTS-TOTAL-*' columns are the totals over all
+// descendant processes in the sample.
+type processMetrics struct {
+	pid         int64
+	proxyPid    int64
+	descendants bool
+
+	// prevTicks is the CPU time of each process in the previous sample,
+	// in clock ticks.
+	prevTicks map[int64]uint64
+	prevTime  time.Time
+
+	rows []processMetricsRow
+}
+
+// processMetricsRow is the sum of the tracked processes per role.
+type processMetricsRow struct {
+	unixNanosecond int64
+	unixSecond     int64
+
+	proxy          processUsage
+	descendantsNum int
+	descendants    processUsage
+}
+
+type processUsage struct {
+	cpu     float64 // percent of one CPU, like 'top'
+	vmrss   int64   // bytes
+	threads int64
+}
+
+// processMetricsColumns are the columns of process metrics CSV.
+var processMetricsColumns = []string{
+	"UNIX-NANOSECOND",
+	"UNIX-SECOND",
+	"PROXY-PID",
+	"PROXY-CPU-NUM",
+	"PROXY-VMRSS-NUM",
+	"PROXY-THREADS",
+	"DESCENDANTS-NUM",
+	"DESCENDANTS-TOTAL-CPU-NUM",
+	"DESCENDANTS-TOTAL-VMRSS-NUM",
+	"DESCENDANTS-TOTAL-THREADS",
+}
+
+func newProcessMetrics(pid, proxyPid int64, descendants bool) *processMetrics {
+	return &processMetrics{
+		pid:         pid,
+		proxyPid:    proxyPid,
+		descendants: descendants,
+		prevTicks:   make(map[int64]uint64),
+	}
+}
+
+// add samples the tracked processes. Processes that have exited are
+// skipped. CPU usage is zero for the processes seen for the first time.
+func (pm *processMetrics) add() error {
+	now := time.Now()
+	stats, err := readProcessStats()
+	if err != nil {
+		return err
+	}
+
+	row := processMetricsRow{unixNanosecond: now.UnixNano(), unixSecond: now.Unix()}
+	ticks := make(map[int64]uint64)
+	usage := func(pid int64) (processUsage, bool) {
+		st, ok := stats[pid]
+		if !ok {
+			return processUsage{}, false
+		}
+		cur := st.UTime + st.STime
+		ticks[pid] = cur
+		u := processUsage{vmrss: st.RSSBytes, threads: st.Threads}
+		if prev, ok := pm.prevTicks[pid]; ok && !pm.prevTime.IsZero() && cur >= prev {
+			u.cpu = float64(cur-prev) / procfs.ClockTicks / now.Sub(pm.prevTime).Seconds() * 100
+		}
+		return u, true
+	}
+
+	if pm.proxyPid != 0 {
+		row.proxy, _ = usage(pm.proxyPid)
+	}
+	if pm.descendants {
+		for _, pid := range descendantPIDs(stats, pm.pid, pm.proxyPid) {
+			u, ok := usage(pid)
+			if !ok {
+				continue
+			}
+			row.descendantsNum++
+			row.descendants.cpu += u.cpu
+			row.descendants.vmrss += u.vmrss
+			row.descendants.threads += u.threads
+		}
+	}
+
+	pm.prevTicks, pm.prevTime = ticks, now
+	pm.rows = append(pm.rows, row)
+	return nil
+}
+
+func (pm *processMetrics) save(fpath string) error {
+	cols := make([]dataframe.Column, len(processMetricsColumns))
+	for i := range processMetricsColumns {
+		cols[i] = dataframe.NewColumn(processMetricsColumns[i])
+	}
+	for _, row := range pm.rows {
+		for i, v := range []string{
+			fmt.Sprintf("%d", row.unixNanosecond),
+			fmt.Sprintf("%d", row.unixSecond),
+			fmt.Sprintf("%d", pm.proxyPid),
+			fmt.Sprintf("%.2f", row.proxy.cpu),
+			fmt.Sprintf("%d", row.proxy.vmrss),
+			fmt.Sprintf("%d", row.proxy.threads),
+			fmt.Sprintf("%d", row.descendantsNum),
+			fmt.Sprintf("%.2f", row.descendants.cpu),
+			fmt.Sprintf("%d", row.descendants.vmrss),
+			fmt.Sprintf("%d", row.descendants.threads),
+		} {
+			cols[i].PushBack(dataframe.NewStringValue(v))
+		}
+	}
+
+	fr := dataframe.New()
+	for _, col := range cols {
+		if err := fr.AddColumn(col); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(fpath)
+}
+
+// readProcessStats reads '/proc/$PID/stat' of all processes.
+func readProcessStats() (map[int64]procfs.Stat, error) {
+	pids, err := procfs.ListPIDs(procfs.DefaultRoot)
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[int64]procfs.Stat, len(pids))
+	for _, pid := range pids {
+		st, err := procfs.ReadStat(procfs.DefaultRoot, pid)
+		if err != nil {
+			continue // exited
+		}
+		stats[pid] = st
+	}
+	return stats, nil
+}
+
+// descendantPIDs returns all descendants of the root processes,
+// excluding the roots.
+func descendantPIDs(stats map[int64]procfs.Stat, roots ...int64) []int64 {
+	children := make(map[int64][]int64)
+	for pid, st := range stats {
+		children[st.PPID] = append(children[st.PPID], pid)
+	}
+	isRoot := make(map[int64]bool)
+	var queue []int64
+	for _, r := range roots {
+		if r == 0 { // e.g. no proxy; everything descends from pid 0
+			continue
+		}
+		isRoot[r] = true
+		queue = append(queue, r)
+	}
+
+	var pids []int64
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+		for _, c := range children[pid] {
+			if isRoot[c] {
+				continue
+			}
+			pids = append(pids, c)
+			queue = append(queue, c)
+		}
+	}
+	return pids
+}
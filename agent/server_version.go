@@ -0,0 +1,167 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/etcd-io/dbtester/pkg/gclog"
+
+	"go.uber.org/zap"
+)
+
+// detectVersion detects the version of the database binary into
+// 't.databaseVersion', and checks it against the requested version.
+func (t *transporterServer) detectVersion() error {
+	dr, err := getDriver(t.req.DatabaseID)
+	if err != nil {
+		return err
+	}
+	ver, err := dr.version(t.fs, t.req.DatabaseID)
+	if err != nil {
+		if t.req.DatabaseVersion != "" {
+			return fmt.Errorf("cannot check %q version %q (%v)", t.req.DatabaseID, t.req.DatabaseVersion, err)
+		}
+		t.lg.Warn("failed to detect database version", zap.String("database", t.req.DatabaseID.String()), zap.Error(err))
+		ver = ""
+	}
+	if t.req.DatabaseVersion != "" && !matchVersion(ver, t.req.DatabaseVersion) {
+		return fmt.Errorf("%q binary is version %q, not %q", t.req.DatabaseID, ver, t.req.DatabaseVersion)
+	}
+	t.databaseVersion = ver
+	t.lg.Info("detected database version", zap.String("database", t.req.DatabaseID.String()), zap.String("version", ver))
+	return nil
+}
+
+// etcdVersion returns the version that 'etcd --version' prints
+// (e.g. "3.3.1" from "etcd Version: 3.3.1").
+func etcdVersion(execPath string) (string, error) {
+	out, err := exec.Command(execPath, "--version").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%q --version failed (%v, %q)", execPath, err, strings.TrimSpace(string(out)))
+	}
+	return parseVersionLine(string(out), "etcd Version:")
+}
+
+// consulVersion returns the version that 'consul version' prints
+// (e.g. "1.0.2" from "Consul v1.0.2").
+func consulVersion(execPath string) (string, error) {
+	out, err := exec.Command(execPath, "version").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%q version failed (%v, %q)", execPath, err, strings.TrimSpace(string(out)))
+	}
+	return parseVersionLine(string(out), "Consul")
+}
+
+// javaMajorVersion returns the major version that 'java -version'
+// prints (e.g. 8 from 'java version "1.8.0_121"').
+func javaMajorVersion(execPath string) (int, error) {
+	out, err := exec.Command(execPath, "-version").CombinedOutput()
+	if err != nil {
+		return 0, fmt.Errorf("%q -version failed (%v, %q)", execPath, err, strings.TrimSpace(string(out)))
+	}
+	return gclog.JavaMajorVersion(string(out))
+}
+
+// zookeeperVersion returns 'Implementation-Version' in the manifest
+// of the Zookeeper jar, or the version in the jar name if none
+// (e.g. "3.5.3-beta" from "zookeeper-3.5.3-beta.jar").
+func zookeeperVersion(workDir string) (string, error) {
+	jars, err := zookeeperJars(workDir)
+	if err != nil {
+		return "", err
+	}
+	jar := jars[0]
+
+	zr, err := zip.OpenReader(filepath.Join(workDir, jar))
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		if f.Name != "META-INF/MANIFEST.MF" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		b, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return "", err
+		}
+		if ver, err := parseVersionLine(string(b), "Implementation-Version:"); err == nil {
+			// drop the build information after the version, if any
+			if i := strings.IndexAny(ver, " ,"); i > 0 {
+				ver = ver[:i]
+			}
+			return ver, nil
+		}
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(filepath.Base(jar), "zookeeper-"), ".jar"), nil
+}
+
+// parseVersionLine returns the version after the prefix in the output,
+// without the leading "v".
+func parseVersionLine(out, prefix string) (string, error) {
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+		ver := strings.TrimSpace(strings.TrimPrefix(line, prefix))
+		if ver == "" {
+			continue
+		}
+		return strings.TrimPrefix(ver, "v"), nil
+	}
+	return "", fmt.Errorf("no %q in %q", prefix, strings.TrimSpace(out))
+}
+
+// matchVersion returns true if the version starts with the expected one,
+// ignoring the leading "v" (e.g. "3.4.2" matches "v3.4", but not "3.40").
+func matchVersion(ver, expected string) bool {
+	ver, expected = strings.TrimPrefix(ver, "v"), strings.TrimPrefix(expected, "v")
+	if !strings.HasPrefix(ver, expected) {
+		return false
+	}
+	rest := strings.TrimPrefix(ver, expected)
+	return rest == "" || rest[0] < '0' || rest[0] > '9'
+}
+
+// versionAtLeast returns true if the version is "major.minor" or later.
+// It returns false if the version is unknown.
+func versionAtLeast(ver string, major, minor int) bool {
+	ss := strings.SplitN(strings.TrimPrefix(ver, "v"), ".", 3)
+	if len(ss) < 2 {
+		return false
+	}
+	maj, err := strconv.Atoi(ss[0])
+	if err != nil {
+		return false
+	}
+	min, err := strconv.Atoi(strings.TrimRightFunc(ss[1], func(r rune) bool { return r < '0' || r > '9' }))
+	if err != nil {
+		return false
+	}
+	return maj > major || (maj == major && min >= minor)
+}
@@ -20,7 +20,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/etcd-io/dbtester/dbtesterpb"
 	"github.com/etcd-io/dbtester/pkg/remotestorage"
 
 	"go.uber.org/zap"
@@ -61,8 +60,7 @@ func uploadLog(fs *flags, t *transporterServer) error {
 	}
 
 	{
-		if t.req.DatabaseID == dbtesterpb.DatabaseID_zetcd__beta ||
-			t.req.DatabaseID == dbtesterpb.DatabaseID_cetcd__beta {
+		if t.proxyDatabaseLogfile != nil {
 			dpath := fs.databaseLog + "-" + t.req.DatabaseID.String()
 			srcDatabaseLogPath2 := dpath
 			dstDatabaseLogPath2 := filepath.Base(dpath)
@@ -127,6 +125,157 @@ func uploadLog(fs *flags, t *transporterServer) error {
 		}
 	}
 
+	for _, srcIOMetricsPath := range []string{fs.systemIOMetricsCSV, fs.systemIOMetricsCSVInterpolated} {
+		if !exist(srcIOMetricsPath) {
+			continue
+		}
+		dstIOMetricsPath := filepath.Base(srcIOMetricsPath)
+		if !strings.HasPrefix(filepath.Base(srcIOMetricsPath), t.req.DatabaseTag) {
+			dstIOMetricsPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(srcIOMetricsPath))
+		}
+		dstIOMetricsPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstIOMetricsPath)
+		t.lg.Info("uploading storage I/O metrics", zap.String("source", srcIOMetricsPath), zap.String("destination", dstIOMetricsPath))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcIOMetricsPath, dstIOMetricsPath); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if t.processMetrics != nil && exist(fs.processMetricsCSV) {
+		srcProcessMetricsPath := fs.processMetricsCSV
+		dstProcessMetricsPath := filepath.Base(fs.processMetricsCSV)
+		if !strings.HasPrefix(filepath.Base(fs.processMetricsCSV), t.req.DatabaseTag) {
+			dstProcessMetricsPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.processMetricsCSV))
+		}
+		dstProcessMetricsPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstProcessMetricsPath)
+		t.lg.Info("uploading process metrics", zap.String("source", srcProcessMetricsPath), zap.String("destination", dstProcessMetricsPath))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcProcessMetricsPath, dstProcessMetricsPath); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if exist(fs.databaseMetricsCSV) {
+		srcDatabaseMetricsPath := fs.databaseMetricsCSV
+		dstDatabaseMetricsPath := filepath.Base(fs.databaseMetricsCSV)
+		if !strings.HasPrefix(filepath.Base(fs.databaseMetricsCSV), t.req.DatabaseTag) {
+			dstDatabaseMetricsPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.databaseMetricsCSV))
+		}
+		dstDatabaseMetricsPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDatabaseMetricsPath)
+		t.lg.Info("uploading database metrics", zap.String("source", srcDatabaseMetricsPath), zap.String("destination", dstDatabaseMetricsPath))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDatabaseMetricsPath, dstDatabaseMetricsPath); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if exist(fs.diskUsageCSV) {
+		srcDiskUsagePath := fs.diskUsageCSV
+		dstDiskUsagePath := filepath.Base(fs.diskUsageCSV)
+		if !strings.HasPrefix(filepath.Base(fs.diskUsageCSV), t.req.DatabaseTag) {
+			dstDiskUsagePath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.diskUsageCSV))
+		}
+		dstDiskUsagePath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDiskUsagePath)
+		t.lg.Info("uploading disk usage", zap.String("source", srcDiskUsagePath), zap.String("destination", dstDiskUsagePath))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDiskUsagePath, dstDiskUsagePath); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if exist(fs.gcMetricsCSV) {
+		srcGCMetricsPath := fs.gcMetricsCSV
+		dstGCMetricsPath := filepath.Base(fs.gcMetricsCSV)
+		if !strings.HasPrefix(filepath.Base(fs.gcMetricsCSV), t.req.DatabaseTag) {
+			dstGCMetricsPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.gcMetricsCSV))
+		}
+		dstGCMetricsPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstGCMetricsPath)
+		t.lg.Info("uploading GC metrics", zap.String("source", srcGCMetricsPath), zap.String("destination", dstGCMetricsPath))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcGCMetricsPath, dstGCMetricsPath); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	gcLogPaths, err := gcLogs(*fs)
+	if err != nil {
+		return err
+	}
+	for _, srcGCLogPath := range gcLogPaths {
+		dstGCLogPath := filepath.Base(srcGCLogPath)
+		if !strings.HasPrefix(filepath.Base(srcGCLogPath), t.req.DatabaseTag) {
+			dstGCLogPath = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(srcGCLogPath))
+		}
+		dstGCLogPath = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstGCLogPath)
+		t.lg.Info("uploading GC log", zap.String("source", srcGCLogPath), zap.String("destination", dstGCLogPath))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadFile(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcGCLogPath, dstGCLogPath); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
+
+	if exist(fs.diagnosticsDir) {
+		srcDiagnosticsDir := fs.diagnosticsDir
+		dstDiagnosticsDir := filepath.Base(fs.diagnosticsDir)
+		if !strings.HasPrefix(filepath.Base(fs.diagnosticsDir), t.req.DatabaseTag) {
+			dstDiagnosticsDir = fmt.Sprintf("%s-%d-%s", t.req.DatabaseTag, t.req.IPIndex+1, filepath.Base(fs.diagnosticsDir))
+		}
+		dstDiagnosticsDir = filepath.Join(t.req.ConfigClientMachineInitial.GoogleCloudStorageSubDirectory, dstDiagnosticsDir)
+		t.lg.Info("uploading diagnostics", zap.String("source", srcDiagnosticsDir), zap.String("destination", dstDiagnosticsDir))
+		for k := 0; k < 30; k++ {
+			if uerr = u.UploadDir(t.req.ConfigClientMachineInitial.GoogleCloudStorageBucketName, srcDiagnosticsDir, dstDiagnosticsDir); uerr != nil {
+				t.lg.Warn("upload error; retrying...", zap.Error(uerr))
+				time.Sleep(2 * time.Second)
+				continue
+			}
+			break
+		}
+		if uerr != nil {
+			return uerr
+		}
+	}
 	{
 		srcAgentLogPath := fs.agentLog
 		dstAgentLogPath := filepath.Base(fs.agentLog)
@@ -16,6 +16,8 @@ package analyze
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 
 	"github.com/gyuho/dataframe"
 )
@@ -28,6 +30,17 @@ type analyzeData struct {
 	maxUnixSecond int64
 	sys           []testData
 
+	// procNum is the number of process metrics CSV files
+	// (proxy and descendant processes) aggregated into sysAgg.
+	procNum int
+
+	// diskUsage is the data directory size of each member, if any.
+	diskUsage                []diskUsageSeries
+	diskUsageByKeyNumberPath string
+
+	// gcMetrics is the per-second JVM GC pauses of each member, if any.
+	gcMetrics []map[int64]gcSecond
+
 	// aggregated frame within [min,maxUnixSecond] from sys
 	sysAgg               dataframe.Frame
 	benchMetricsFilePath string
@@ -146,3 +159,232 @@ func (data *analyzeData) aggSystemMetrics() error {
 
 	return nil
 }
+
+// processMetricsColumnsToRead maps the process metrics CSV column
+// to the column in sysAgg, suffixed with the index.
+var processMetricsColumnsToRead = []struct {
+	column string
+	header string
+	// scale converts the value (e.g. bytes to mb)
+	scale float64
+}{
+	{"PROXY-CPU-NUM", "PROXY-CPU", 1},
+	{"PROXY-VMRSS-NUM", "PROXY-VMRSS-MB", 0.000001},
+	{"DESCENDANTS-TOTAL-CPU-NUM", "DESCENDANTS-TOTAL-CPU", 1},
+	{"DESCENDANTS-TOTAL-VMRSS-NUM", "DESCENDANTS-TOTAL-VMRSS-MB", 0.000001},
+}
+
+// aggProcessMetrics adds proxy and descendant process metrics from
+// 3+ nodes to sysAgg, aligned by its unix second. It must be called
+// after 'aggSystemMetrics'. The process metrics are sampled separately,
+// so samples within the same second (interval shorter than a second)
+// are averaged, and seconds without a sample take the previous one.
+func (data *analyzeData) aggProcessMetrics(fpaths ...string) error {
+	uc, err := data.sysAgg.Column("UNIX-SECOND")
+	if err != nil {
+		return err
+	}
+	for i, fpath := range fpaths {
+		fr, err := dataframe.NewFromCSV(nil, fpath)
+		if err != nil {
+			return err
+		}
+		pc, err := fr.Column("UNIX-SECOND")
+		if err != nil {
+			return err
+		}
+		for _, pm := range processMetricsColumnsToRead {
+			col, err := fr.Column(pm.column)
+			if err != nil {
+				return err
+			}
+			sec2sum := make(map[int64]float64, col.Count())
+			sec2cnt := make(map[int64]int, col.Count())
+			for rowIdx := 0; rowIdx < col.Count(); rowIdx++ {
+				sv, err := pc.Value(rowIdx)
+				if err != nil {
+					return err
+				}
+				ss, _ := sv.String()
+				ts, err := strconv.ParseInt(ss, 10, 64)
+				if err != nil {
+					return err
+				}
+				v, err := col.Value(rowIdx)
+				if err != nil {
+					return err
+				}
+				fv, _ := v.Float64()
+				sec2sum[ts] += fv * pm.scale
+				sec2cnt[ts]++
+			}
+
+			nc := dataframe.NewColumn(fmt.Sprintf("%s-%d", pm.header, i+1))
+			var prev float64
+			for rowIdx := 0; rowIdx < uc.Count(); rowIdx++ {
+				sv, err := uc.Value(rowIdx)
+				if err != nil {
+					return err
+				}
+				ss, _ := sv.String()
+				ts, err := strconv.ParseInt(ss, 10, 64)
+				if err != nil {
+					return err
+				}
+				if n, ok := sec2cnt[ts]; ok {
+					prev = sec2sum[ts] / float64(n)
+				}
+				nc.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", prev)))
+			}
+			if err = data.sysAgg.AddColumn(nc); err != nil {
+				return err
+			}
+		}
+	}
+	data.procNum = len(fpaths)
+	return nil
+}
+
+// diskUsageSeries is the data directory size of a member,
+// sorted by unix second.
+type diskUsageSeries struct {
+	unixSeconds []int64
+	apparent    []float64
+	allocated   []float64
+}
+
+// readDiskUsage reads the total data directory sizes of all members.
+func (data *analyzeData) readDiskUsage(fpaths ...string) error {
+	for _, fpath := range fpaths {
+		fr, err := dataframe.NewFromCSV(nil, fpath)
+		if err != nil {
+			return err
+		}
+		uc, err := fr.Column("UNIX-SECOND")
+		if err != nil {
+			return err
+		}
+		ac, err := fr.Column("TOTAL-APPARENT-BYTES")
+		if err != nil {
+			return err
+		}
+		lc, err := fr.Column("TOTAL-ALLOCATED-BYTES")
+		if err != nil {
+			return err
+		}
+
+		var ds diskUsageSeries
+		for rowIdx := 0; rowIdx < uc.Count(); rowIdx++ {
+			uv, err := uc.Value(rowIdx)
+			if err != nil {
+				return err
+			}
+			us, _ := uv.String()
+			ts, err := strconv.ParseInt(us, 10, 64)
+			if err != nil {
+				return err
+			}
+			av, err := ac.Value(rowIdx)
+			if err != nil {
+				return err
+			}
+			afv, _ := av.Float64()
+			lv, err := lc.Value(rowIdx)
+			if err != nil {
+				return err
+			}
+			lfv, _ := lv.Float64()
+
+			ds.unixSeconds = append(ds.unixSeconds, ts)
+			ds.apparent = append(ds.apparent, afv)
+			ds.allocated = append(ds.allocated, lfv)
+		}
+		data.diskUsage = append(data.diskUsage, ds)
+	}
+	return nil
+}
+
+// diskUsageAt returns the average data directory size of the members
+// at the unix second. The size is measured every few seconds, so each
+// member takes its last measurement at or before the second.
+func (data *analyzeData) diskUsageAt(unixSecond int64) (apparent, allocated float64) {
+	n := 0
+	for _, ds := range data.diskUsage {
+		idx := sort.Search(len(ds.unixSeconds), func(i int) bool { return ds.unixSeconds[i] > unixSecond }) - 1
+		if idx < 0 {
+			continue
+		}
+		apparent += ds.apparent[idx]
+		allocated += ds.allocated[idx]
+		n++
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	return apparent / float64(n), allocated / float64(n)
+}
+
+// gcSecond is the JVM GC pauses of a member in a unix second.
+type gcSecond struct {
+	pauses      float64
+	pauseMs     float64
+	maxPauseMs  float64
+	heapAfterMB float64
+}
+
+// readGCMetrics reads the per-second JVM GC pauses of all members.
+func (data *analyzeData) readGCMetrics(fpaths ...string) error {
+	for _, fpath := range fpaths {
+		fr, err := dataframe.NewFromCSV(nil, fpath)
+		if err != nil {
+			return err
+		}
+		var cols []dataframe.Column
+		for _, header := range []string{"UNIX-SECOND", "GC-PAUSE-COUNT", "GC-PAUSE-MS", "GC-MAX-PAUSE-MS", "HEAP-AFTER-MB"} {
+			col, err := fr.Column(header)
+			if err != nil {
+				return err
+			}
+			cols = append(cols, col)
+		}
+
+		sec2gc := make(map[int64]gcSecond, cols[0].Count())
+		for rowIdx := 0; rowIdx < cols[0].Count(); rowIdx++ {
+			vs := make([]float64, len(cols))
+			for i, col := range cols {
+				v, err := col.Value(rowIdx)
+				if err != nil {
+					return err
+				}
+				vs[i], _ = v.Float64()
+			}
+			sec2gc[int64(vs[0])] = gcSecond{pauses: vs[1], pauseMs: vs[2], maxPauseMs: vs[3], heapAfterMB: vs[4]}
+		}
+		data.gcMetrics = append(data.gcMetrics, sec2gc)
+	}
+	return nil
+}
+
+// gcAt returns the GC pauses of all members at the unix second.
+// The pause count and time are summed, and the heap usage is
+// averaged over the members with GC in or before the second.
+func (data *analyzeData) gcAt(unixSecond int64) (pauses, pauseMs, maxPauseMs, avgHeapAfterMB float64) {
+	n := 0
+	for _, sec2gc := range data.gcMetrics {
+		g, ok := sec2gc[unixSecond]
+		if !ok {
+			continue
+		}
+		pauses += g.pauses
+		pauseMs += g.pauseMs
+		if maxPauseMs < g.maxPauseMs {
+			maxPauseMs = g.maxPauseMs
+		}
+		avgHeapAfterMB += g.heapAfterMB
+		n++
+	}
+	if n > 0 {
+		avgHeapAfterMB /= float64(n)
+	}
+	return pauses, pauseMs, maxPauseMs, avgHeapAfterMB
+}
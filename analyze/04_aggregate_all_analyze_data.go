@@ -16,6 +16,7 @@ package analyze
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/etcd-io/dbtester"
@@ -142,6 +143,12 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 		avgReceiveBytesNumDeltaCol  = dataframe.NewColumn("AVG-RECEIVE-BYTES-NUM-DELTA")     // from RECEIVE-BYTES-NUM-DELTA
 		avgTransmitBytesNumCol      = dataframe.NewColumn("AVG-TRANSMIT-BYTES-NUM")          // from TRANSMIT-BYTES-NUM
 		avgTransmitBytesNumDeltaCol = dataframe.NewColumn("AVG-TRANSMIT-BYTES-NUM-DELTA")    // from TRANSMIT-BYTES-NUM-DELTA
+
+		procSampleSize           = float64(data.procNum)
+		avgProxyCPUCol           = dataframe.NewColumn("AVG-PROXY-CPU")                  // from PROXY-CPU-NUM
+		avgProxyVMRSSMBCol       = dataframe.NewColumn("AVG-PROXY-VMRSS-MB")             // from PROXY-VMRSS-NUM
+		avgDescendantsCPUCol     = dataframe.NewColumn("AVG-DESCENDANTS-TOTAL-CPU")      // from DESCENDANTS-TOTAL-CPU-NUM
+		avgDescendantsVMRSSMBCol = dataframe.NewColumn("AVG-DESCENDANTS-TOTAL-VMRSS-MB") // from DESCENDANTS-TOTAL-VMRSS-NUM
 	)
 
 	sec2minVMRSSMB := make(map[int64]float64)
@@ -172,6 +179,10 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 			receiveBytesNumDeltaSum  float64
 			transmitBytesNumSum      float64
 			transmitBytesNumDeltaSum float64
+			proxyCPUSum              float64
+			proxyVMRSSMBSum          float64
+			descendantsCPUSum        float64
+			descendantsVMRSSMBSum    float64
 		)
 		sc, err := data.aggregated.Column("UNIX-SECOND")
 		if err != nil {
@@ -253,6 +264,16 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 				transmitBytesNumDeltaSum += vv
 			case strings.HasPrefix(hd, "TRANSMIT-BYTES-NUM-"):
 				transmitBytesNumSum += vv
+
+			// proxy and descendant processes, if any
+			case strings.HasPrefix(hd, "PROXY-CPU-"):
+				proxyCPUSum += vv
+			case strings.HasPrefix(hd, "PROXY-VMRSS-MB-"):
+				proxyVMRSSMBSum += vv
+			case strings.HasPrefix(hd, "DESCENDANTS-TOTAL-CPU-"):
+				descendantsCPUSum += vv
+			case strings.HasPrefix(hd, "DESCENDANTS-TOTAL-VMRSS-MB-"):
+				descendantsVMRSSMBSum += vv
 			}
 		}
 
@@ -277,6 +298,12 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 		avgReceiveBytesNumDeltaCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", receiveBytesNumDeltaSum/sampleSize)))
 		avgTransmitBytesNumCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", transmitBytesNumSum/sampleSize)))
 		avgTransmitBytesNumDeltaCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", transmitBytesNumDeltaSum/sampleSize)))
+		if data.procNum > 0 {
+			avgProxyCPUCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", proxyCPUSum/procSampleSize)))
+			avgProxyVMRSSMBCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", proxyVMRSSMBSum/procSampleSize)))
+			avgDescendantsCPUCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", descendantsCPUSum/procSampleSize)))
+			avgDescendantsVMRSSMBCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", descendantsVMRSSMBSum/procSampleSize)))
+		}
 	}
 
 	// add all cumulative, average columns
@@ -346,6 +373,13 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 	if err = data.aggregated.AddColumn(avgTransmitBytesNumDeltaCol); err != nil {
 		return err
 	}
+	if data.procNum > 0 {
+		for _, col := range []dataframe.Column{avgProxyCPUCol, avgProxyVMRSSMBCol, avgDescendantsCPUCol, avgDescendantsVMRSSMBCol} {
+			if err = data.aggregated.AddColumn(col); err != nil {
+				return err
+			}
+		}
+	}
 
 	// add SECOND column
 	uc, err := data.aggregated.Column("UNIX-SECOND")
@@ -359,6 +393,35 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 	if err = data.aggregated.AddColumn(secondCol); err != nil {
 		return err
 	}
+	if len(data.gcMetrics) > 0 {
+		var (
+			gcPauseCountCol   = dataframe.NewColumn("GC-PAUSE-COUNT")    // from GC-PAUSE-COUNT
+			gcPauseMsCol      = dataframe.NewColumn("GC-PAUSE-MS")       // from GC-PAUSE-MS
+			maxGCPauseMsCol   = dataframe.NewColumn("MAX-GC-PAUSE-MS")   // from GC-MAX-PAUSE-MS
+			avgHeapAfterMBCol = dataframe.NewColumn("AVG-HEAP-AFTER-MB") // from HEAP-AFTER-MB
+		)
+		for i := 0; i < uc.Count(); i++ {
+			uv, err := uc.Value(i)
+			if err != nil {
+				return err
+			}
+			us, _ := uv.String()
+			ts, err := strconv.ParseInt(us, 10, 64)
+			if err != nil {
+				return err
+			}
+			pauses, pauseMs, maxPauseMs, heapAfterMB := data.gcAt(ts)
+			gcPauseCountCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.0f", pauses)))
+			gcPauseMsCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", pauseMs)))
+			maxGCPauseMsCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", maxPauseMs)))
+			avgHeapAfterMBCol.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", heapAfterMB)))
+		}
+		for _, col := range []dataframe.Column{gcPauseCountCol, gcPauseMsCol, maxGCPauseMsCol, avgHeapAfterMBCol} {
+			if err = data.aggregated.AddColumn(col); err != nil {
+				return err
+			}
+		}
+	}
 	// move to 2th column
 	if err = data.aggregated.MoveColumn("SECOND", 1); err != nil {
 		return err
@@ -495,6 +558,7 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 			AvgReadBytesDelta:  vf3,
 			AvgWriteBytesDelta: vf4,
 		}
+		point.AvgDiskApparentBytes, point.AvgDiskAllocatedBytes = data.diskUsageAt(v0)
 		cdata = append(cdata, point)
 	}
 
@@ -567,6 +631,29 @@ func (data *analyzeData) aggregateAll(memoryByKeyPath string, readBytesDeltaByKe
 		panic(err)
 	}
 
+	// aggregate data directory size by number of keys
+	if data.diskUsageByKeyNumberPath != "" {
+		ckk9 := dataframe.NewColumn("AVG-DISK-APPARENT-MB")
+		ckk10 := dataframe.NewColumn("AVG-DISK-ALLOCATED-MB")
+		for i := range knms {
+			ckk9.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", knms[i].AvgDiskApparentBytes*0.000001)))
+			ckk10.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.2f", knms[i].AvgDiskAllocatedBytes*0.000001)))
+		}
+		fr4 := dataframe.New()
+		if err := fr4.AddColumn(ckk1); err != nil {
+			panic(err)
+		}
+		if err := fr4.AddColumn(ckk9); err != nil {
+			panic(err)
+		}
+		if err := fr4.AddColumn(ckk10); err != nil {
+			panic(err)
+		}
+		if err := fr4.CSV(data.diskUsageByKeyNumberPath); err != nil {
+			panic(err)
+		}
+	}
+
 	return nil
 }
 
@@ -80,6 +80,58 @@ func (all *allAggregatedData) draw(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pair
 	}
 	plt.Add(ps...)
 
+	// mark events after all lines are added, to span the full Y-axis range
+	for i, p := range pairs {
+		if err = all.annotate(plt, p.y.Header(), i); err != nil {
+			return err
+		}
+	}
+
+	for _, outputPath := range cfg.OutputPathList {
+		if err = plt.Save(plotWidth, plotHeight, outputPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drawOverlay draws the columns of a database on the same plot
+// (e.g. latency and GC pauses), to correlate them by second.
+func (all *allAggregatedData) drawOverlay(cfg dbtesterpb.ConfigAnalyzeMachinePlot, databaseID string, cols ...dataframe.Column) error {
+	plt, err := plot.New()
+	if err != nil {
+		return err
+	}
+	plt.Title.Text = fmt.Sprintf("%s, %s", all.title, cfg.YAxis)
+	plt.X.Label.Text = cfg.XAxis
+	plt.Y.Label.Text = cfg.YAxis
+	plt.Legend.Top = true
+
+	var ps []plot.Plotter
+	for i, col := range cols {
+		pt, err := points(col)
+		if err != nil {
+			return err
+		}
+
+		l, err := plotter.NewLine(pt)
+		if err != nil {
+			return err
+		}
+		l.Color = dbtesterpb.GetRGBI(databaseID, i)
+		l.Dashes = plotutil.Dashes(i)
+		ps = append(ps, l)
+
+		plt.Legend.Add(col.Header(), l)
+	}
+	plt.Add(ps...)
+
+	if len(cols) > 0 {
+		if err = all.annotate(plt, cols[0].Header(), 0); err != nil {
+			return err
+		}
+	}
+
 	for _, outputPath := range cfg.OutputPathList {
 		if err = plt.Save(plotWidth, plotHeight, outputPath); err != nil {
 			return err
@@ -88,6 +140,40 @@ func (all *allAggregatedData) draw(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pair
 	return nil
 }
 
+// annotate draws vertical lines at the events of the database.
+func (all *allAggregatedData) annotate(plt *plot.Plot, header string, i int) error {
+	databaseID := all.headerToDatabaseID[header]
+	ans := all.databaseIDToAnnotations[databaseID]
+	if len(ans) == 0 {
+		return nil
+	}
+
+	lbs := plotter.XYLabels{XYs: make(plotter.XYs, len(ans)), Labels: make([]string, len(ans))}
+	for j, an := range ans {
+		l, err := plotter.NewLine(plotter.XYs{{X: an.x, Y: plt.Y.Min}, {X: an.x, Y: plt.Y.Max}})
+		if err != nil {
+			return err
+		}
+		l.Color = dbtesterpb.GetRGBI(databaseID, i)
+		l.Dashes = []vg.Length{vg.Points(2), vg.Points(2)}
+		l.Width = vg.Points(1)
+		plt.Add(l)
+		if j == 0 {
+			plt.Legend.Add(all.headerToDatabaseDescription[header]+" events", l)
+		}
+
+		lbs.XYs[j].X, lbs.XYs[j].Y = an.x, plt.Y.Max
+		lbs.Labels[j] = an.label
+	}
+
+	labels, err := plotter.NewLabels(lbs)
+	if err != nil {
+		return err
+	}
+	plt.Add(labels)
+	return nil
+}
+
 func (all *allAggregatedData) drawXY(cfg dbtesterpb.ConfigAnalyzeMachinePlot, pairs ...pair) error {
 	// frame now contains
 	// KEYS-DB-TAG-X, AVG-LATENCY-MS-DB-TAG-Y, ...
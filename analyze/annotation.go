@@ -0,0 +1,178 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyze
+
+import (
+	"fmt"
+
+	"github.com/gyuho/dataframe"
+)
+
+// annotation marks an event (e.g. injected fault) on time series plots.
+type annotation struct {
+	// x is the number of seconds since the first aggregated row.
+	x     float64
+	label string
+}
+
+// readFaultTimeline reads fault timeline written by control,
+// and converts fault timestamps to annotations relative to 'frontUnixSecond'.
+func readFaultTimeline(fpath string, frontUnixSecond int64) ([]annotation, error) {
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return nil, err
+	}
+	secCol, err := fr.Column("UNIX-SECOND")
+	if err != nil {
+		return nil, err
+	}
+	faultCol, err := fr.Column("FAULT")
+	if err != nil {
+		return nil, err
+	}
+	idxCol, err := fr.Column("MEMBER-INDEX")
+	if err != nil {
+		return nil, err
+	}
+	// timelines without injected network faults may not have this column
+	unavailCol, _ := fr.Column("UNAVAILABLE-MILLISECOND")
+	catchUpCol, _ := fr.Column("CATCH-UP-MILLISECOND")
+
+	var ans []annotation
+	for i := 0; i < secCol.Count(); i++ {
+		sv, err := secCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		sec, ok := sv.Int64()
+		if !ok {
+			return nil, fmt.Errorf("cannot Int64 %v", sv)
+		}
+		fv, err := faultCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		fault, _ := fv.String()
+		iv, err := idxCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		idx, _ := iv.String()
+		label := fmt.Sprintf("%s (member %s)", fault, idx)
+		if unavailCol != nil {
+			uv, err := unavailCol.Value(i)
+			if err != nil {
+				return nil, err
+			}
+			if ms, ok := uv.Float64(); ok && ms > 0 {
+				label = fmt.Sprintf("%s (member %s, unavailable %.0f ms)", fault, idx, ms)
+			}
+		}
+		if catchUpCol != nil {
+			cv, err := catchUpCol.Value(i)
+			if err != nil {
+				return nil, err
+			}
+			if ms, ok := cv.Float64(); ok && ms > 0 {
+				label = fmt.Sprintf("%s, caught up in %.0f ms", label, ms)
+			}
+		}
+		ans = append(ans, annotation{
+			x:     float64(sec - frontUnixSecond),
+			label: label,
+		})
+	}
+	return ans, nil
+}
+
+// readLeaderTimeline reads leader timeline written by control, and
+// converts leader changes to annotations relative to 'frontUnixSecond'.
+func readLeaderTimeline(fpath string, frontUnixSecond int64) ([]annotation, error) {
+	fr, err := dataframe.NewFromCSV(nil, fpath)
+	if err != nil {
+		return nil, err
+	}
+	secCol, err := fr.Column("UNIX-SECOND")
+	if err != nil {
+		return nil, err
+	}
+	leaderCol, err := fr.Column("LEADER-INDEX")
+	if err != nil {
+		return nil, err
+	}
+	termCol, err := fr.Column("TERM")
+	if err != nil {
+		return nil, err
+	}
+	changedCol, err := fr.Column("LEADER-CHANGED")
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []annotation
+	for i := 0; i < secCol.Count(); i++ {
+		cv, err := changedCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		if changed, _ := cv.String(); changed != "1" {
+			continue
+		}
+		sv, err := secCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		sec, ok := sv.Int64()
+		if !ok {
+			return nil, fmt.Errorf("cannot Int64 %v", sv)
+		}
+		lv, err := leaderCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		leader, _ := lv.String()
+		tv, err := termCol.Value(i)
+		if err != nil {
+			return nil, err
+		}
+		term, _ := tv.String()
+		label := fmt.Sprintf("leader member %s (term %s)", leader, term)
+		if leader == "-1" {
+			label = fmt.Sprintf("no leader (term %s)", term)
+		}
+		ans = append(ans, annotation{
+			x:     float64(sec - frontUnixSecond),
+			label: label,
+		})
+	}
+	return ans, nil
+}
+
+// frontUnixSecond returns the first unix second of aggregated data.
+func (data *analyzeData) frontUnixSecond() (int64, error) {
+	col, err := data.aggregated.Column("UNIX-SECOND")
+	if err != nil {
+		return 0, err
+	}
+	fv, ok := col.FrontNonNil()
+	if !ok {
+		return 0, fmt.Errorf("%q has empty UNIX-SECOND", data.databaseTag)
+	}
+	sec, ok := fv.Int64()
+	if !ok {
+		return 0, fmt.Errorf("cannot Int64 %v", fv)
+	}
+	return sec, nil
+}
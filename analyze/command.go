@@ -56,6 +56,9 @@ type allAggregatedData struct {
 	headerToDatabaseID          map[string]string
 	headerToDatabaseDescription map[string]string
 	allDatabaseIDList           []string
+
+	// databaseIDToAnnotations marks events on time series plots
+	databaseIDToAnnotations map[string][]annotation
 }
 
 func do(configPath string) error {
@@ -63,13 +66,18 @@ func do(configPath string) error {
 	if err != nil {
 		return err
 	}
+	return Run(cfg)
+}
 
+// Run analyzes the test results of all databases in the configuration.
+func Run(cfg *dbtester.Config) (err error) {
 	all := &allAggregatedData{
 		title:                       cfg.TestTitle,
 		data:                        make([]*analyzeData, 0, len(cfg.DatabaseIDToConfigAnalyzeMachineInitial)),
 		headerToDatabaseID:          make(map[string]string),
 		headerToDatabaseDescription: make(map[string]string),
 		allDatabaseIDList:           cfg.AllDatabaseIDList,
+		databaseIDToAnnotations:     make(map[string][]annotation),
 	}
 	for _, databaseID := range cfg.AllDatabaseIDList {
 		testgroup := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
@@ -87,9 +95,28 @@ func do(configPath string) error {
 		if err = ad.aggSystemMetrics(); err != nil {
 			return err
 		}
+		if len(testdata.ServerProcessMetricsPathList) > 0 {
+			lg.Sugar().Infof("reading process metrics data for %s", databaseID)
+			if err = ad.aggProcessMetrics(testdata.ServerProcessMetricsPathList...); err != nil {
+				return err
+			}
+		}
 		if err = ad.importBenchMetrics(testdata.ClientLatencyThroughputTimeseriesPath); err != nil {
 			return err
 		}
+		if len(testdata.ServerDiskUsagePathList) > 0 && testdata.ServerDiskUsageByKeyNumberPath != "" {
+			lg.Sugar().Infof("reading disk usage data for %s", databaseID)
+			if err = ad.readDiskUsage(testdata.ServerDiskUsagePathList...); err != nil {
+				return err
+			}
+			ad.diskUsageByKeyNumberPath = testdata.ServerDiskUsageByKeyNumberPath
+		}
+		if len(testdata.ServerGCMetricsPathList) > 0 {
+			lg.Sugar().Infof("reading GC metrics data for %s", databaseID)
+			if err = ad.readGCMetrics(testdata.ServerGCMetricsPathList...); err != nil {
+				return err
+			}
+		}
 		if err = ad.aggregateAll(testdata.ServerMemoryByKeyNumberPath, testdata.ServerReadBytesDeltaByKeyNumberPath, testdata.ServerWriteBytesDeltaByKeyNumberPath, testgroup.ConfigClientMachineBenchmarkOptions.RequestNumber); err != nil {
 			return err
 		}
@@ -97,6 +124,31 @@ func do(configPath string) error {
 			return err
 		}
 
+		if testdata.ClientFaultTimelinePath != "" {
+			lg.Sugar().Infof("reading fault timeline for %s", databaseID)
+			front, err := ad.frontUnixSecond()
+			if err != nil {
+				return err
+			}
+			ans, err := readFaultTimeline(testdata.ClientFaultTimelinePath, front)
+			if err != nil {
+				return err
+			}
+			all.databaseIDToAnnotations[databaseID] = append(all.databaseIDToAnnotations[databaseID], ans...)
+		}
+		if testdata.ClientLeaderTimelinePath != "" {
+			lg.Sugar().Infof("reading leader timeline for %s", databaseID)
+			front, err := ad.frontUnixSecond()
+			if err != nil {
+				return err
+			}
+			ans, err := readLeaderTimeline(testdata.ClientLeaderTimelinePath, front)
+			if err != nil {
+				return err
+			}
+			all.databaseIDToAnnotations[databaseID] = append(all.databaseIDToAnnotations[databaseID], ans...)
+		}
+
 		all.data = append(all.data, ad)
 		for _, hd := range ad.aggregated.Headers() {
 			all.headerToDatabaseID[makeHeader(hd, testgroup.DatabaseTag)] = databaseID
@@ -271,6 +323,7 @@ func do(configPath string) error {
 	row24ClientMaxMemory := []string{"CLIENT-MAX-MEMORY-USAGE"}                         // VMRSS-NUM
 	row25ClientErrorCount := []string{"CLIENT-ERROR-COUNT"}                             // ERROR:
 	row30AvgDiskSpaceUsage := []string{"SERVER-AVG-DISK-SPACE-USAGE"}                   // DISK-SPACE-USAGE
+	row31AvgDiskSpaceAllocated := []string{"SERVER-AVG-DISK-SPACE-ALLOCATED"}           // DISK-SPACE-ALLOCATED
 
 	databaseIDToErrs := make(map[string][]string)
 	for i, databaseID := range cfg.AllDatabaseIDList {
@@ -468,6 +521,24 @@ func do(configPath string) error {
 			}
 			avg := uint64(sum / float64(col.Count()))
 			row30AvgDiskSpaceUsage = append(row30AvgDiskSpaceUsage, humanize.Bytes(avg))
+
+			// summaries from older agents have no allocated size
+			col, err = fr.Column(dbtester.DiskSpaceUsageSummaryColumns[5])
+			if err != nil {
+				row31AvgDiskSpaceAllocated = append(row31AvgDiskSpaceAllocated, "N/A")
+			} else {
+				sum = 0
+				for i := 0; i < col.Count(); i++ {
+					val, err := col.Value(i)
+					if err != nil {
+						return err
+					}
+					fv, _ := val.Float64()
+					sum += fv
+				}
+				avg = uint64(sum / float64(col.Count()))
+				row31AvgDiskSpaceAllocated = append(row31AvgDiskSpaceAllocated, humanize.Bytes(avg))
+			}
 		}
 		{
 			f, err := openToRead(testdata.ClientLatencyDistributionPercentilePath)
@@ -560,6 +631,7 @@ func do(configPath string) error {
 		row28WritesCompletedDeltaSum,
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
+		row31AvgDiskSpaceAllocated,
 	}
 	file, err := openToOverwrite(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
 	if err != nil {
@@ -614,6 +686,7 @@ func do(configPath string) error {
 		row28WritesCompletedDeltaSum,
 		row29SectorsWrittenDeltaSum,
 		row30AvgDiskSpaceUsage,
+		row31AvgDiskSpaceAllocated,
 	}
 	buf := new(bytes.Buffer)
 	tw := tablewriter.NewWriter(buf)
@@ -806,6 +879,46 @@ func do(configPath string) error {
 			return err
 		}
 	}
+	// KEYS, AVG-DISK-APPARENT-MB, AVG-DISK-ALLOCATED-MB
+	lg.Sugar().Info("combining all server disk usage by keys")
+	allDiskUsageFrame := dataframe.New()
+	for _, databaseID := range cfg.AllDatabaseIDList {
+		testdata := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+		if testdata.ServerDiskUsageByKeyNumberPath == "" {
+			continue
+		}
+
+		fr, err := dataframe.NewFromCSV(nil, testdata.ServerDiskUsageByKeyNumberPath)
+		if err != nil {
+			return err
+		}
+		colKeys, err := fr.Column("KEYS")
+		if err != nil {
+			return err
+		}
+		colKeys.UpdateHeader(makeHeader("KEYS", testdata.DatabaseTag))
+		if err = allDiskUsageFrame.AddColumn(colKeys); err != nil {
+			return err
+		}
+
+		col1, err := fr.Column("AVG-DISK-APPARENT-MB")
+		if err != nil {
+			return err
+		}
+		col1.UpdateHeader(makeHeader("AVG-DISK-APPARENT-MB", testdata.DatabaseTag))
+		if err = allDiskUsageFrame.AddColumn(col1); err != nil {
+			return err
+		}
+
+		col2, err := fr.Column("AVG-DISK-ALLOCATED-MB")
+		if err != nil {
+			return err
+		}
+		col2.UpdateHeader(makeHeader("AVG-DISK-ALLOCATED-MB", testdata.DatabaseTag))
+		if err = allDiskUsageFrame.AddColumn(col2); err != nil {
+			return err
+		}
+	}
 
 	{
 		allLatencyFrameCfg := dbtesterpb.ConfigAnalyzeMachinePlot{
@@ -1017,6 +1130,64 @@ func do(configPath string) error {
 			return err
 		}
 	}
+	if len(allDiskUsageFrame.Columns()) > 0 {
+		allDiskUsageFrameCfg := dbtesterpb.ConfigAnalyzeMachinePlot{
+			Column:         "AVG-DISK-ALLOCATED-MB",
+			XAxis:          "Cumulative Number of Keys",
+			YAxis:          "Disk Usage(MB) by Keys",
+			OutputPathList: make([]string, len(cfg.AnalyzePlotList[0].OutputPathList)),
+		}
+		allDiskUsageFrameCfg.OutputPathList[0] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-DISK-ALLOCATED-MB-BY-KEY.svg")
+		allDiskUsageFrameCfg.OutputPathList[1] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-DISK-ALLOCATED-MB-BY-KEY.png")
+		lg.Sugar().Info("plotting %v", allDiskUsageFrameCfg.OutputPathList)
+		var pairs []pair
+		allCols := allDiskUsageFrame.Columns()
+		for i := 0; i < len(allCols)-2; i += 3 {
+			pairs = append(pairs, pair{
+				x: allCols[i],   // x
+				y: allCols[i+2], // allocated
+			})
+		}
+		if err = all.drawXY(allDiskUsageFrameCfg, pairs...); err != nil {
+			return err
+		}
+		csvPath := filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), "AVG-DISK-MB-BY-KEY.csv")
+		if err := allDiskUsageFrame.CSV(csvPath); err != nil {
+			return err
+		}
+	}
+
+	for i, ad := range all.data {
+		if len(ad.gcMetrics) == 0 {
+			continue
+		}
+		databaseID := all.allDatabaseIDList[i]
+		tag := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID].DatabaseTag
+
+		latencyCol, err := ad.aggregated.Column("AVG-LATENCY-MS")
+		if err != nil {
+			return err
+		}
+		latencyCol.UpdateHeader(makeHeader("AVG-LATENCY-MS", tag))
+		gcPauseCol, err := ad.aggregated.Column("MAX-GC-PAUSE-MS")
+		if err != nil {
+			return err
+		}
+		gcPauseCol.UpdateHeader(makeHeader("MAX-GC-PAUSE-MS", tag))
+
+		gcPauseCfg := dbtesterpb.ConfigAnalyzeMachinePlot{
+			Column:         "MAX-GC-PAUSE-MS",
+			XAxis:          "Second",
+			YAxis:          fmt.Sprintf("Latency and GC Pause(millisecond) of %s", all.headerToDatabaseDescription[latencyCol.Header()]),
+			OutputPathList: make([]string, len(cfg.AnalyzePlotList[0].OutputPathList)),
+		}
+		gcPauseCfg.OutputPathList[0] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), tag+"-AVG-LATENCY-MS-GC-PAUSE.svg")
+		gcPauseCfg.OutputPathList[1] = filepath.Join(filepath.Dir(cfg.AnalyzePlotList[0].OutputPathList[0]), tag+"-AVG-LATENCY-MS-GC-PAUSE.png")
+		lg.Sugar().Info("plotting %v", gcPauseCfg.OutputPathList)
+		if err = all.drawOverlay(gcPauseCfg, databaseID, latencyCol, gcPauseCol); err != nil {
+			return err
+		}
+	}
 
 	lg.Info("combining data for plotting")
 	for _, plotConfig := range cfg.AnalyzePlotList {
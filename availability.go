@@ -0,0 +1,134 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// availability records the results of requests, to find out how long
+// clients could not make any progress after faults, and how requests
+// performed between faults.
+type availability struct {
+	mu        sync.Mutex
+	sorted    bool
+	successes []int64 // unix nanoseconds
+	results   []requestResult
+}
+
+// requestResult is the result of a request, ordered by its end time.
+type requestResult struct {
+	end     int64 // unix nanoseconds
+	latency time.Duration
+	failed  bool
+}
+
+// windowStats is the statistics of the requests that ended in a window.
+type windowStats struct {
+	requests int64
+	errors   int64
+
+	avgLatency time.Duration
+	maxLatency time.Duration
+}
+
+func newAvailability() *availability {
+	return &availability{sorted: true}
+}
+
+func (a *availability) record(start, end time.Time, err error) {
+	a.mu.Lock()
+	n := end.UnixNano()
+	if len(a.results) > 0 && a.results[len(a.results)-1].end > n {
+		a.sorted = false
+	}
+	if err == nil {
+		a.successes = append(a.successes, n)
+	}
+	a.results = append(a.results, requestResult{end: n, latency: end.Sub(start), failed: err != nil})
+	a.mu.Unlock()
+}
+
+// unavailable returns the longest duration in [from, to) without any
+// successful request, counting from 'from' and until 'to'.
+func (a *availability) unavailable(from, to int64) time.Duration {
+	if to <= from {
+		return 0
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sort()
+
+	i := sort.Search(len(a.successes), func(i int) bool { return a.successes[i] >= from })
+	prev, longest := from, int64(0)
+	for ; i < len(a.successes) && a.successes[i] < to; i++ {
+		if d := a.successes[i] - prev; d > longest {
+			longest = d
+		}
+		prev = a.successes[i]
+	}
+	if d := to - prev; d > longest {
+		longest = d
+	}
+	return time.Duration(longest)
+}
+
+// last returns the unix nanoseconds of the last successful request,
+// or 0 if none succeeded.
+func (a *availability) last() int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sort()
+	if len(a.successes) == 0 {
+		return 0
+	}
+	return a.successes[len(a.successes)-1]
+}
+
+// stats returns the statistics of the requests that ended in [from, to).
+func (a *availability) stats(from, to int64) (ws windowStats) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.sort()
+
+	var total time.Duration
+	i := sort.Search(len(a.results), func(i int) bool { return a.results[i].end >= from })
+	for ; i < len(a.results) && a.results[i].end < to; i++ {
+		r := a.results[i]
+		ws.requests++
+		if r.failed {
+			ws.errors++
+			continue
+		}
+		total += r.latency
+		if r.latency > ws.maxLatency {
+			ws.maxLatency = r.latency
+		}
+	}
+	if n := ws.requests - ws.errors; n > 0 {
+		ws.avgLatency = total / time.Duration(n)
+	}
+	return ws
+}
+
+func (a *availability) sort() {
+	if !a.sorted {
+		sort.Slice(a.successes, func(i, j int) bool { return a.successes[i] < a.successes[j] })
+		sort.Slice(a.results, func(i, j int) bool { return a.results[i].end < a.results[j].end })
+		a.sorted = true
+	}
+}
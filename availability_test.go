@@ -0,0 +1,59 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_availability(t *testing.T) {
+	a := newAvailability()
+	for _, n := range []int64{10, 12, 11, 30, 31, 50} {
+		// latency is the same as end time
+		a.record(time.Unix(0, 0), time.Unix(0, n), nil)
+	}
+	for _, n := range []int64{20, 21} {
+		a.record(time.Unix(0, 0), time.Unix(0, n), errors.New("fail"))
+	}
+
+	tests := []struct {
+		from, to int64
+		exp      time.Duration
+	}{
+		{0, 50, 19},
+		{0, 100, 50},
+		{12, 30, 18},
+		{13, 30, 17},
+		{32, 50, 18},
+		{51, 100, 49},
+		{100, 90, 0},
+	}
+	if l := a.last(); l != 50 {
+		t.Fatalf("last expected 50, got %d", l)
+	}
+	for i, tt := range tests {
+		if d := a.unavailable(tt.from, tt.to); d != tt.exp {
+			t.Fatalf("#%d: unavailable(%d, %d) expected %v, got %v", i, tt.from, tt.to, tt.exp, d)
+		}
+	}
+
+	ws := a.stats(0, 50)
+	exp := windowStats{requests: 7, errors: 2, avgLatency: 18, maxLatency: 31}
+	if ws != exp {
+		t.Fatalf("stats expected %+v, got %+v", exp, ws)
+	}
+}
@@ -45,37 +45,11 @@ func (cfg *Config) BroadcaseRequest(databaseID string, op dbtesterpb.Operation)
 		ep := gcfg.AgentEndpoints[i]
 
 		go func(i int, ep string, req *dbtesterpb.Request) {
-			cfg.lg.Info("sending message",
-				zap.Int("index", i),
-				zap.String("endpoint", ep),
-				zap.String("operation", op.String()),
-				zap.String("database", req.DatabaseID.String()),
-			)
-			conn, err := grpc.Dial(ep, grpc.WithInsecure())
+			resp, err := cfg.sendRequest(i, ep, req)
 			if err != nil {
-				errc <- fmt.Errorf("%v (%q)", err, ep)
+				errc <- err
 				return
 			}
-			defer conn.Close()
-
-			// give enough timeout
-			// e.g. uploading logs takes longer
-			cli := dbtesterpb.NewTransporterClient(conn)
-			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
-			resp, err := cli.Transfer(ctx, req)
-			cancel()
-			if err != nil {
-				errc <- fmt.Errorf("%v (%q)", err, ep)
-				return
-			}
-			cfg.lg.Info("received response",
-				zap.Int("index", i),
-				zap.String("endpoint", ep),
-				zap.String("operation", op.String()),
-				zap.String("database", req.DatabaseID.String()),
-				zap.String("response", fmt.Sprintf("%+v", resp)),
-			)
-
 			donec <- result{idx: i, r: *resp}
 		}(i, ep, req)
 
@@ -97,3 +71,55 @@ func (cfg *Config) BroadcaseRequest(databaseID string, op dbtesterpb.Operation)
 	}
 	return im, nil
 }
+
+// SendRequest sends request to the agent of the member at the index.
+func (cfg *Config) SendRequest(databaseID string, op dbtesterpb.Operation, idx int) (dbtesterpb.Response, error) {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return dbtesterpb.Response{}, fmt.Errorf("database id %q does not exist", databaseID)
+	}
+	if idx < 0 || idx >= len(gcfg.AgentEndpoints) {
+		return dbtesterpb.Response{}, fmt.Errorf("member index %d is out of range [0, %d)", idx, len(gcfg.AgentEndpoints))
+	}
+	req, err := cfg.ToRequest(databaseID, op, idx)
+	if err != nil {
+		return dbtesterpb.Response{}, err
+	}
+	resp, err := cfg.sendRequest(idx, gcfg.AgentEndpoints[idx], req)
+	if err != nil {
+		return dbtesterpb.Response{}, err
+	}
+	return *resp, nil
+}
+
+func (cfg *Config) sendRequest(idx int, ep string, req *dbtesterpb.Request) (*dbtesterpb.Response, error) {
+	cfg.lg.Info("sending message",
+		zap.Int("index", idx),
+		zap.String("endpoint", ep),
+		zap.String("operation", req.Operation.String()),
+		zap.String("database", req.DatabaseID.String()),
+	)
+	conn, err := grpc.Dial(ep, grpc.WithInsecure())
+	if err != nil {
+		return nil, fmt.Errorf("%v (%q)", err, ep)
+	}
+	defer conn.Close()
+
+	// give enough timeout
+	// e.g. uploading logs takes longer
+	cli := dbtesterpb.NewTransporterClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	resp, err := cli.Transfer(ctx, req)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("%v (%q)", err, ep)
+	}
+	cfg.lg.Info("received response",
+		zap.Int("index", idx),
+		zap.String("endpoint", ep),
+		zap.String("operation", req.Operation.String()),
+		zap.String("database", req.DatabaseID.String()),
+		zap.String("response", fmt.Sprintf("%+v", resp)),
+	)
+	return resp, nil
+}
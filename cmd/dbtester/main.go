@@ -21,6 +21,7 @@
 //	agent       Database 'agent' in remote servers.
 //	analyze     Analyzes test dbtester test results.
 //	control     Controls tests.
+//	local       Runs agents, database and tests on this host.
 //
 package main
 
@@ -31,6 +32,7 @@ import (
 	"github.com/etcd-io/dbtester/agent"
 	"github.com/etcd-io/dbtester/analyze"
 	"github.com/etcd-io/dbtester/control"
+	"github.com/etcd-io/dbtester/local"
 	"github.com/spf13/cobra"
 )
 
@@ -50,6 +52,7 @@ func init() {
 	rootCommand.AddCommand(agent.Command)
 	rootCommand.AddCommand(analyze.Command)
 	rootCommand.AddCommand(control.Command)
+	rootCommand.AddCommand(local.Command)
 }
 
 func main() {
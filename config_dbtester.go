@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
 
@@ -53,6 +54,76 @@ type Config struct {
 	AnalyzePlotPathPrefix                              string                                `yaml:"analyze_plot_path_prefix"`
 	AnalyzePlotList                                    []dbtesterpb.ConfigAnalyzeMachinePlot `yaml:"analyze_plot_list"`
 	dbtesterpb.ConfigAnalyzeMachineREADME              `yaml:"analyze_readme"`
+
+	// avail records the results of requests while faults are injected
+	avail *availability
+}
+
+// legacyFlagKeys maps the flag keys of database IDs, which were replaced
+// by the flag keys of database families, to the family.
+var legacyFlagKeys = map[string]string{
+	"etcd__other":            "etcd",
+	"etcd__tip":              "etcd",
+	"etcd__v3_2":             "etcd",
+	"etcd__v3_3":             "etcd",
+	"etcd__embed":            "etcd",
+	"zookeeper__r3_5_3_beta": "zookeeper",
+	"consul__v1_0_2":         "consul",
+	"zetcd__beta":            "zetcd",
+	"cetcd__beta":            "cetcd",
+}
+
+// renameLegacyFlagKeys renames the legacy flag keys (e.g. "etcd__v3_3")
+// of each database to the key of its family (e.g. "etcd"), so that old
+// configuration files keep their flags. It returns an error if the flags
+// of a family are given more than once.
+func renameLegacyFlagKeys(bts []byte) ([]byte, error) {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(bts, &doc); err != nil {
+		return nil, err
+	}
+	renamed := false
+	for _, item := range doc {
+		if item.Key != "datatbase_id_to_config_client_machine_agent_control" {
+			continue
+		}
+		groups, _ := item.Value.(yaml.MapSlice)
+		for _, group := range groups {
+			fields, _ := group.Value.(yaml.MapSlice)
+			given := make(map[string]string)
+			for i := range fields {
+				key, _ := fields[i].Key.(string)
+				family, ok := legacyFlagKeys[key]
+				if !ok {
+					if !isFlagFamily(key) {
+						continue
+					}
+					family = key
+				}
+				if prev, ok := given[family]; ok {
+					return nil, fmt.Errorf("database %v has flags %q and %q of %q", group.Key, prev, key, family)
+				}
+				given[family] = key
+				if key != family {
+					fields[i].Key = family
+					renamed = true
+				}
+			}
+		}
+	}
+	if !renamed {
+		return bts, nil
+	}
+	return yaml.Marshal(doc)
+}
+
+func isFlagFamily(key string) bool {
+	for _, family := range legacyFlagKeys {
+		if key == family {
+			return true
+		}
+	}
+	return false
 }
 
 // ReadConfig reads control configuration file.
@@ -61,6 +132,9 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if bts, err = renameLegacyFlagKeys(bts); err != nil {
+		return nil, err
+	}
 	cfg := Config{}
 	if err = yaml.Unmarshal(bts, &cfg); err != nil {
 		return nil, err
@@ -88,6 +162,14 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 		cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyDistributionSummaryPath)
 		cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLatencyByKeyNumberPath)
 		cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
+		cfg.ConfigClientMachineInitial.ClientSessionConsistencyPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientSessionConsistencyPath)
+		cfg.ConfigClientMachineInitial.ClientFaultTimelinePath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientFaultTimelinePath)
+		cfg.ConfigClientMachineInitial.ClientCrashRecoveryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientCrashRecoveryPath)
+		cfg.ConfigClientMachineInitial.ClientSnapshotPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientSnapshotPath)
+		cfg.ConfigClientMachineInitial.ServerStartupSummaryPath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ServerStartupSummaryPath)
+		if cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath != "" {
+			cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath = filepath.Join(cfg.ConfigClientMachineInitial.PathPrefix, cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath)
+		}
 	}
 
 	for databaseID, group := range cfg.DatabaseIDToConfigClientMachineAgentControl {
@@ -97,12 +179,90 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 
 		group.DatabaseID = databaseID
 		group.DatabaseTag = MakeTag(group.DatabaseDescription)
-		group.PeerIPsString = strings.Join(group.PeerIPs, "___")
-		group.DatabaseEndpoints = make([]string, len(group.PeerIPs))
-		group.AgentEndpoints = make([]string, len(group.PeerIPs))
-		for j := range group.PeerIPs {
-			group.DatabaseEndpoints[j] = fmt.Sprintf("%s:%d", group.PeerIPs[j], group.DatabasePortToConnect)
-			group.AgentEndpoints[j] = fmt.Sprintf("%s:%d", group.PeerIPs[j], group.AgentPortToConnect)
+		if idx := group.ConfigClientMachineBenchmarkOptions.SnapshotMemberIndex; idx < 0 || idx >= int64(len(group.PeerIPs)) {
+			return nil, fmt.Errorf("%q has invalid snapshot member index %d", databaseID, idx)
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "crash-recovery" {
+			if err = checkCrashOffset(*group.ConfigClientMachineBenchmarkOptions); err != nil {
+				return nil, fmt.Errorf("%q %v", databaseID, err)
+			}
+		}
+		if group.ConfigClientMachineBenchmarkOptions.Type == "snapshot" {
+			switch dbtesterpb.DatabaseFamily(databaseID) {
+			case "etcd", "zetcd", "cetcd":
+				// a member restored from a snapshot file cannot rejoin the running etcd cluster
+				return nil, fmt.Errorf("%q does not support %q benchmark", databaseID, "snapshot")
+			}
+		}
+		if group.InitialClusterSize < 0 || group.InitialClusterSize > int64(len(group.PeerIPs)) {
+			return nil, fmt.Errorf("%q has invalid initial cluster size %d", databaseID, group.InitialClusterSize)
+		}
+		for _, ft := range group.Faults {
+			if _, ok := faultTypeToOperation[ft.Type]; !ok {
+				return nil, fmt.Errorf("%q has unknown fault type %q", databaseID, ft.Type)
+			}
+			if ft.MemberIndex < -1 || ft.MemberIndex >= int64(len(group.PeerIPs)) {
+				return nil, fmt.Errorf("%q has fault %q with invalid member index %d", databaseID, ft.Type, ft.MemberIndex)
+			}
+			for _, idx := range ft.TargetMemberIndexes {
+				if idx < 0 || idx >= int64(len(group.PeerIPs)) {
+					return nil, fmt.Errorf("%q has fault %q with invalid target member index %d", databaseID, ft.Type, idx)
+				}
+			}
+			if ft.Type == "netem" && ft.DelayMillisecond <= 0 && ft.LossPercent <= 0 {
+				return nil, fmt.Errorf("%q has fault %q without delay or loss", databaseID, ft.Type)
+			}
+			// each member has its own band of the 16-band 'tc' prio qdisc,
+			// and the first band has no fault
+			if ft.Type == "netem" && len(group.PeerIPs) > 15 {
+				return nil, fmt.Errorf("%q has fault %q with %d members, but netem supports up to 15", databaseID, ft.Type, len(group.PeerIPs))
+			}
+			if ft.Type == "upgrade" {
+				if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[ft.UpgradeDatabaseID]; !ok {
+					return nil, fmt.Errorf("%q has fault %q to undefined database ID %q", databaseID, ft.Type, ft.UpgradeDatabaseID)
+				}
+			}
+		}
+		for _, dg := range group.Diagnostics {
+			if dg.OffsetMillisecond < 0 || dg.CPUProfileSeconds < 0 {
+				return nil, fmt.Errorf("%q has diagnostics with negative offset %d or CPU profile seconds %d", databaseID, dg.OffsetMillisecond, dg.CPUProfileSeconds)
+			}
+			for _, idx := range dg.MemberIndexes {
+				if idx < 0 || idx >= int64(len(group.PeerIPs)) {
+					return nil, fmt.Errorf("%q has diagnostics with invalid member index %d", databaseID, idx)
+				}
+			}
+		}
+		for _, eo := range append([]*dbtesterpb.ConfigClientMachineExtraOptions{group.ExtraOptions}, group.MemberExtraOptions...) {
+			if eo == nil {
+				continue
+			}
+			if eo != group.ExtraOptions && (eo.MemberIndex < 0 || eo.MemberIndex >= int64(len(group.PeerIPs))) {
+				return nil, fmt.Errorf("%q has extra options with invalid member index %d", databaseID, eo.MemberIndex)
+			}
+			for _, env := range eo.Env {
+				if !strings.Contains(env, "=") {
+					return nil, fmt.Errorf("%q has extra environment %q without '='", databaseID, env)
+				}
+			}
+		}
+		if fc := group.Flag_Consul; fc != nil {
+			if fc.RaftMultiplier > 10 {
+				return nil, fmt.Errorf("%q has Consul raft multiplier %d out of [1, 10]", databaseID, fc.RaftMultiplier)
+			}
+			if fc.RaftSnapshotInterval != "" {
+				if _, err := time.ParseDuration(fc.RaftSnapshotInterval); err != nil {
+					return nil, fmt.Errorf("%q has invalid Consul raft snapshot interval %q (%v)", databaseID, fc.RaftSnapshotInterval, err)
+				}
+			}
+		}
+		if rl := group.ResourceLimits; rl != nil {
+			if rl.CPUs < 0 || rl.MemoryBytes < 0 || rl.IOReadBytesPerSecond < 0 || rl.IOWriteBytesPerSecond < 0 || rl.IOReadIOPS < 0 || rl.IOWriteIOPS < 0 {
+				return nil, fmt.Errorf("%q has negative resource limits %+v", databaseID, *rl)
+			}
+			if rl.IOWeight > 10000 {
+				return nil, fmt.Errorf("%q has IO weight %d out of [1, 10000]", databaseID, rl.IOWeight)
+			}
 		}
 		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = group
 	}
@@ -128,153 +288,67 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 				amc.ServerSystemMetricsInterpolatedPathList[i] = amc.PathPrefix + "-" + amc.ServerSystemMetricsInterpolatedPathList[i]
 			}
 			amc.AllAggregatedOutputPath = amc.PathPrefix + "-" + amc.AllAggregatedOutputPath
+			if amc.ClientFaultTimelinePath != "" {
+				amc.ClientFaultTimelinePath = amc.PathPrefix + "-" + amc.ClientFaultTimelinePath
+			}
+			if amc.ClientLeaderTimelinePath != "" {
+				amc.ClientLeaderTimelinePath = amc.PathPrefix + "-" + amc.ClientLeaderTimelinePath
+			}
+			for i := range amc.ServerProcessMetricsPathList {
+				amc.ServerProcessMetricsPathList[i] = amc.PathPrefix + "-" + amc.ServerProcessMetricsPathList[i]
+			}
+			for i := range amc.ServerDiskUsagePathList {
+				amc.ServerDiskUsagePathList[i] = amc.PathPrefix + "-" + amc.ServerDiskUsagePathList[i]
+			}
+			if amc.ServerDiskUsageByKeyNumberPath != "" {
+				amc.ServerDiskUsageByKeyNumberPath = amc.PathPrefix + "-" + amc.ServerDiskUsageByKeyNumberPath
+			}
+			for i := range amc.ServerGCMetricsPathList {
+				amc.ServerGCMetricsPathList[i] = amc.PathPrefix + "-" + amc.ServerGCMetricsPathList[i]
+			}
 		}
 
 		cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
 	}
 
 	for databaseID, ctrl := range cfg.DatabaseIDToConfigClientMachineAgentControl {
-		if databaseID != dbtesterpb.DatabaseID_etcd__other.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__tip.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__v3_2.String() &&
-			databaseID != dbtesterpb.DatabaseID_etcd__v3_3.String() &&
+		// only etcd clients share connections
+		if clientFamily(ctrl) != "etcd" &&
 			ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber != ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber {
 			return nil, fmt.Errorf("%q got connected %d != clients %d", databaseID, ctrl.ConfigClientMachineBenchmarkOptions.ConnectionNumber, ctrl.ConfigClientMachineBenchmarkOptions.ClientNumber)
 		}
 	}
 
-	const (
-		defaultAgentPort           int64 = 3500
-		defaultEtcdClientPort      int64 = 2379
-		defaultZookeeperClientPort int64 = 2181
-		defaultConsulClientPort    int64 = 8500
-
-		defaultEtcdSnapshotCount             int64 = 100000
-		defaultEtcdQuotaSizeBytes            int64 = 8000000000
-		defaultZookeeperSnapCount            int64 = 100000
-		defaultZookeeperTickTime             int64 = 2000
-		defaultZookeeperInitLimit            int64 = 5
-		defaultZookeeperSyncLimit            int64 = 5
-		defaultZookeeperMaxClientConnections int64 = 5000
-	)
-
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_Other.SnapshotCount == 0 {
-			v.Flag_Etcd_Other.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_Other.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_Other.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()] = v
-	}
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_Tip.SnapshotCount == 0 {
-			v.Flag_Etcd_Tip.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_Tip.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_Tip.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()] = v
-	}
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_V3_2.SnapshotCount == 0 {
-			v.Flag_Etcd_V3_2.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_V3_2.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_V3_2.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()] = v
-	}
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultEtcdClientPort
-		}
-		if v.Flag_Etcd_V3_3.SnapshotCount == 0 {
-			v.Flag_Etcd_V3_3.SnapshotCount = defaultEtcdSnapshotCount
-		}
-		if v.Flag_Etcd_V3_3.QuotaSizeBytes == 0 {
-			v.Flag_Etcd_V3_3.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
-		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()] = v
-	}
-
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultZookeeperClientPort
-		}
-		v.Flag_Zookeeper_R3_5_3Beta.ClientPort = v.DatabasePortToConnect
-		if v.Flag_Zookeeper_R3_5_3Beta.TickTime == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.TickTime = defaultZookeeperTickTime
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.TickTime == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.TickTime = defaultZookeeperTickTime
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.InitLimit == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.InitLimit = defaultZookeeperInitLimit
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.SyncLimit == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.SyncLimit = defaultZookeeperSyncLimit
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.SnapCount == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.SnapCount = defaultZookeeperSnapCount
-		}
-		if v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections == 0 {
-			v.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections = defaultZookeeperMaxClientConnections
+	for databaseID, group := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		dr, err := getDriver(group)
+		if err != nil {
+			return nil, err
 		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta.String()] = v
+		dr.setDefaults(&group)
+		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = group
 	}
 
-	if v, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_consul__v1_0_2.String()]; ok {
-		if v.AgentPortToConnect == 0 {
-			v.AgentPortToConnect = defaultAgentPort
-		}
-		if v.DatabasePortToConnect == 0 {
-			v.DatabasePortToConnect = defaultConsulClientPort
+	// endpoints need the default ports above
+	for databaseID, group := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		if err = setPeerEndpoints(&group); err != nil {
+			return nil, err
 		}
-		cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_consul__v1_0_2.String()] = v
+		cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = group
 	}
 
-	// need etcd configs since it's backed by etcd
-	if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_zetcd__beta.String()]; ok {
-		_, okOther := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()]
-		_, okTip := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]
-		_, ok32 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]
-		_, ok33 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()]
-		if !okOther && !okTip && !ok32 && !ok33 {
-			return nil, fmt.Errorf("got %q config, but no etcd config is given", dbtesterpb.DatabaseID_zetcd__beta.String())
+	// zetcd and cetcd need etcd configs since they're backed by etcd
+	hasEtcd := false
+	for databaseID := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		if dbtesterpb.DatabaseFamily(databaseID) == "etcd" {
+			hasEtcd = true
 		}
 	}
-	if _, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_cetcd__beta.String()]; ok {
-		_, okOther := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__other.String()]
-		_, okTip := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__tip.String()]
-		_, ok32 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_2.String()]
-		_, ok33 := cfg.DatabaseIDToConfigClientMachineAgentControl[dbtesterpb.DatabaseID_etcd__v3_3.String()]
-		if !okOther && !okTip && !ok32 && !ok33 {
-			return nil, fmt.Errorf("got %q config, but no etcd config is given", dbtesterpb.DatabaseID_cetcd__beta.String())
+	for databaseID := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+		switch dbtesterpb.DatabaseFamily(databaseID) {
+		case "zetcd", "cetcd":
+			if !hasEtcd {
+				return nil, fmt.Errorf("got %q config, but no etcd config is given", databaseID)
+			}
 		}
 	}
 
@@ -296,7 +370,47 @@ func ReadConfig(fpath string, analyze bool) (*Config, error) {
 	return &cfg, nil
 }
 
-const maxEtcdQuotaSize = 8000000000
+// setPeerEndpoints normalizes the peers, "host" or "host:clientPort:peerPort",
+// into 'PeerIPsString' of "host:clientPort:peerPort" tuples. "host" peers
+// serve clients on 'DatabasePortToConnect'. Each member has its own agent,
+// so the agents of the members on the same host listen on consecutive
+// ports from 'AgentPortToConnect', in the order of the peers.
+func setPeerEndpoints(gcfg *dbtesterpb.ConfigClientMachineAgentControl) error {
+	did := dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[gcfg.DatabaseID])
+	clientPort, peerPort := dbtesterpb.DefaultPeerPorts(did)
+	if gcfg.DatabasePortToConnect != 0 {
+		clientPort = gcfg.DatabasePortToConnect
+	}
+
+	peers := make([]string, len(gcfg.PeerIPs))
+	gcfg.DatabaseEndpoints = make([]string, len(gcfg.PeerIPs))
+	gcfg.AgentEndpoints = make([]string, len(gcfg.PeerIPs))
+	bound := make(map[string]bool)
+	hostToN := make(map[string]int64)
+	for j := range gcfg.PeerIPs {
+		p, err := dbtesterpb.ParsePeer(gcfg.PeerIPs[j], clientPort, peerPort)
+		if err != nil {
+			return fmt.Errorf("%q has invalid peer (%v)", gcfg.DatabaseID, err)
+		}
+		agentPort := gcfg.AgentPortToConnect + hostToN[p.Host]
+		hostToN[p.Host]++
+		for _, port := range append(p.Ports(did), agentPort) {
+			addr := p.Addr(port)
+			if bound[addr] {
+				return fmt.Errorf("%q has peer %q binding %q of another member", gcfg.DatabaseID, gcfg.PeerIPs[j], addr)
+			}
+			bound[addr] = true
+		}
+
+		peers[j] = p.String()
+		gcfg.DatabaseEndpoints[j] = p.ClientAddr()
+		gcfg.AgentEndpoints[j] = p.Addr(agentPort)
+	}
+	gcfg.PeerIPsString = strings.Join(peers, dbtesterpb.PeerSeparator)
+	return nil
+}
+
+const defaultAgentPort int64 = 3500
 
 // ToRequest converts configuration to 'dbtesterpb.Request'.
 func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int) (req *dbtesterpb.Request, err error) {
@@ -312,9 +426,13 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 		TriggerLogUpload:    gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs,
 		DatabaseID:          did,
 		DatabaseTag:         gcfg.DatabaseTag,
+		DatabaseVersion:     gcfg.DatabaseVersion,
 		PeerIPsString:       gcfg.PeerIPsString,
 		IPIndex:             uint32(idx),
 		CurrentClientNumber: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+		ClusterIPIndexes:    initialClusterIPIndexes(gcfg),
+		ResourceLimits:      gcfg.ResourceLimits,
+		ExtraOptions:        memberExtraOptions(gcfg, idx),
 		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{
 			GoogleCloudProjectName:         cfg.ConfigClientMachineInitial.GoogleCloudProjectName,
 			GoogleCloudStorageKey:          cfg.ConfigClientMachineInitial.GoogleCloudStorageKey,
@@ -323,66 +441,39 @@ func (cfg *Config) ToRequest(databaseID string, op dbtesterpb.Operation, idx int
 		},
 	}
 
-	switch req.DatabaseID {
-	case dbtesterpb.DatabaseID_etcd__other:
-		if gcfg.Flag_Etcd_Other.QuotaSizeBytes > maxEtcdQuotaSize {
-			err = fmt.Errorf("maximum etcd quota is 8 GB (%d), got %d", maxEtcdQuotaSize, gcfg.Flag_Etcd_Other.QuotaSizeBytes)
-			return
-		}
-		req.Flag_Etcd_Other = &dbtesterpb.Flag_Etcd_Other{
-			SnapshotCount:  gcfg.Flag_Etcd_Other.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_Other.QuotaSizeBytes,
-		}
-	case dbtesterpb.DatabaseID_etcd__tip:
-		if gcfg.Flag_Etcd_Tip.QuotaSizeBytes > maxEtcdQuotaSize {
-			err = fmt.Errorf("maximum etcd quota is 8 GB (%d), got %d", maxEtcdQuotaSize, gcfg.Flag_Etcd_Tip.QuotaSizeBytes)
-			return
-		}
-		req.Flag_Etcd_Tip = &dbtesterpb.Flag_Etcd_Tip{
-			SnapshotCount:  gcfg.Flag_Etcd_Tip.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_Tip.QuotaSizeBytes,
-		}
-	case dbtesterpb.DatabaseID_etcd__v3_2:
-		if gcfg.Flag_Etcd_V3_2.QuotaSizeBytes > maxEtcdQuotaSize {
-			err = fmt.Errorf("maximum etcd quota is 8 GB (%d), got %d", maxEtcdQuotaSize, gcfg.Flag_Etcd_V3_2.QuotaSizeBytes)
-			return
-		}
-		req.Flag_Etcd_V3_2 = &dbtesterpb.Flag_Etcd_V3_2{
-			SnapshotCount:  gcfg.Flag_Etcd_V3_2.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_V3_2.QuotaSizeBytes,
-		}
-	case dbtesterpb.DatabaseID_etcd__v3_3:
-		if gcfg.Flag_Etcd_V3_3.QuotaSizeBytes > maxEtcdQuotaSize {
-			err = fmt.Errorf("maximum etcd quota is 8 GB (%d), got %d", maxEtcdQuotaSize, gcfg.Flag_Etcd_V3_3.QuotaSizeBytes)
-			return
-		}
-		req.Flag_Etcd_V3_3 = &dbtesterpb.Flag_Etcd_V3_3{
-			SnapshotCount:  gcfg.Flag_Etcd_V3_3.SnapshotCount,
-			QuotaSizeBytes: gcfg.Flag_Etcd_V3_3.QuotaSizeBytes,
-		}
+	var dr driver
+	if dr, err = getDriver(gcfg); err != nil {
+		return
+	}
+	err = dr.setRequestFlags(gcfg, idx, req)
+	return
+}
 
-	case dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta:
-		req.Flag_Zookeeper_R3_5_3Beta = &dbtesterpb.Flag_Zookeeper_R3_5_3Beta{
-			JavaDJuteMaxBuffer:   gcfg.Flag_Zookeeper_R3_5_3Beta.JavaDJuteMaxBuffer,
-			JavaXms:              gcfg.Flag_Zookeeper_R3_5_3Beta.JavaXms,
-			JavaXmx:              gcfg.Flag_Zookeeper_R3_5_3Beta.JavaXmx,
-			MyID:                 uint32(idx + 1),
-			ClientPort:           gcfg.Flag_Zookeeper_R3_5_3Beta.ClientPort,
-			TickTime:             gcfg.Flag_Zookeeper_R3_5_3Beta.TickTime,
-			InitLimit:            gcfg.Flag_Zookeeper_R3_5_3Beta.InitLimit,
-			SyncLimit:            gcfg.Flag_Zookeeper_R3_5_3Beta.SyncLimit,
-			SnapCount:            gcfg.Flag_Zookeeper_R3_5_3Beta.SnapCount,
-			MaxClientConnections: gcfg.Flag_Zookeeper_R3_5_3Beta.MaxClientConnections,
+// memberExtraOptions merges the extra options of the database and the member,
+// or returns nil if none. Member flags and environment come after those of
+// the database, to take precedence.
+func memberExtraOptions(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) *dbtesterpb.ConfigClientMachineExtraOptions {
+	var eos []*dbtesterpb.ConfigClientMachineExtraOptions
+	if gcfg.ExtraOptions != nil {
+		eos = append(eos, gcfg.ExtraOptions)
+	}
+	for _, eo := range gcfg.MemberExtraOptions {
+		if eo.MemberIndex == int64(idx) {
+			eos = append(eos, eo)
 		}
-
-	case dbtesterpb.DatabaseID_consul__v1_0_2:
-
-	case dbtesterpb.DatabaseID_zetcd__beta:
-	case dbtesterpb.DatabaseID_cetcd__beta:
-
-	default:
-		err = fmt.Errorf("unknown %v", req.DatabaseID)
+	}
+	if len(eos) == 0 {
+		return nil
 	}
 
-	return
+	merged := &dbtesterpb.ConfigClientMachineExtraOptions{MemberIndex: int64(idx)}
+	for _, eo := range eos {
+		merged.Flags = append(merged.Flags, eo.Flags...)
+		merged.ProxyFlags = append(merged.ProxyFlags, eo.ProxyFlags...)
+		merged.Env = append(merged.Env, eo.Env...)
+		if eo.ConfigTemplate != "" {
+			merged.ConfigTemplate = eo.ConfigTemplate
+		}
+	}
+	return merged
 }
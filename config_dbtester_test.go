@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"gopkg.in/yaml.v2"
 )
 
 func TestConfig(t *testing.T) {
@@ -51,6 +53,12 @@ func TestConfig(t *testing.T) {
 			ClientLatencyDistributionSummaryPath:    "/home/gyuho/client-latency-distribution-summary.csv",
 			ClientLatencyByKeyNumberPath:            "/home/gyuho/client-latency-by-key-number.csv",
 			ServerDiskSpaceUsageSummaryPath:         "/home/gyuho/server-disk-space-usage-summary.csv",
+			ClientSessionConsistencyPath:            "/home/gyuho/client-session-consistency.csv",
+			ClientFaultTimelinePath:                 "/home/gyuho/client-fault-timeline.csv",
+			ClientCrashRecoveryPath:                 "/home/gyuho/client-crash-recovery.csv",
+			ClientSnapshotPath:                      "/home/gyuho/client-snapshot.csv",
+			ServerStartupSummaryPath:                "/home/gyuho/server-startup-summary.csv",
+			ClientLeaderTimelinePath:                "/home/gyuho/client-leader-timeline.csv",
 			GoogleCloudProjectName:                  "etcd-development",
 			GoogleCloudStorageKeyPath:               "config-dbtester-gcloud-key.json",
 			GoogleCloudStorageKey:                   "test-key",
@@ -64,14 +72,16 @@ func TestConfig(t *testing.T) {
 				DatabaseTag:           "etcd-tip-go1.8.0",
 				DatabaseDescription:   "etcd tip (Go 1.8.0)",
 				PeerIPs:               []string{"10.240.0.7", "10.240.0.8", "10.240.0.12"},
-				PeerIPsString:         "10.240.0.7___10.240.0.8___10.240.0.12",
+				PeerIPsString:         "10.240.0.7:2379:2380___10.240.0.8:2379:2380___10.240.0.12:2379:2380",
 				DatabasePortToConnect: 2379,
 				DatabaseEndpoints:     []string{"10.240.0.7:2379", "10.240.0.8:2379", "10.240.0.12:2379"},
 				AgentPortToConnect:    3500,
 				AgentEndpoints:        []string{"10.240.0.7:3500", "10.240.0.8:3500", "10.240.0.12:3500"},
-				Flag_Etcd_Tip: &dbtesterpb.Flag_Etcd_Tip{
-					SnapshotCount:  100000,
-					QuotaSizeBytes: 8000000000,
+				DatabaseFlags: dbtesterpb.DatabaseFlags{
+					Flag_Etcd: &dbtesterpb.Flag_Etcd{
+						SnapshotCount:  100000,
+						QuotaSizeBytes: 8000000000,
+					},
 				},
 				ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
 					Type:                       "write",
@@ -97,21 +107,38 @@ func TestConfig(t *testing.T) {
 				DatabaseTag:           "zookeeper-r3.5.3-beta-java8",
 				DatabaseDescription:   "Zookeeper r3.5.3-beta (Java 8)",
 				PeerIPs:               []string{"10.240.0.21", "10.240.0.22", "10.240.0.23"},
-				PeerIPsString:         "10.240.0.21___10.240.0.22___10.240.0.23",
+				PeerIPsString:         "10.240.0.21:2181:2888___10.240.0.22:2181:2888___10.240.0.23:2181:2888",
 				DatabasePortToConnect: 2181,
 				DatabaseEndpoints:     []string{"10.240.0.21:2181", "10.240.0.22:2181", "10.240.0.23:2181"},
 				AgentPortToConnect:    3500,
 				AgentEndpoints:        []string{"10.240.0.21:3500", "10.240.0.22:3500", "10.240.0.23:3500"},
-				Flag_Zookeeper_R3_5_3Beta: &dbtesterpb.Flag_Zookeeper_R3_5_3Beta{
-					JavaDJuteMaxBuffer:   33554432,
-					JavaXms:              "50G",
-					JavaXmx:              "50G",
-					ClientPort:           2181,
-					TickTime:             2000,
-					InitLimit:            5,
-					SyncLimit:            5,
-					SnapCount:            100000,
-					MaxClientConnections: 5000,
+				DatabaseFlags: dbtesterpb.DatabaseFlags{
+					Flag_Zookeeper: &dbtesterpb.Flag_Zookeeper{
+						JavaDJuteMaxBuffer:   33554432,
+						JavaXms:              "50G",
+						JavaXmx:              "50G",
+						ClientPort:           2181,
+						TickTime:             2000,
+						InitLimit:            5,
+						SyncLimit:            5,
+						SnapCount:            100000,
+						MaxClientConnections: 5000,
+					},
+				},
+				ResourceLimits: &dbtesterpb.ConfigClientMachineResourceLimits{
+					CPUs:        2,
+					MemoryBytes: 4294967296,
+				},
+				ExtraOptions: &dbtesterpb.ConfigClientMachineExtraOptions{
+					Flags:          []string{"-XX:+UseG1GC"},
+					ConfigTemplate: "globalOutstandingLimit=1000",
+				},
+				MemberExtraOptions: []*dbtesterpb.ConfigClientMachineExtraOptions{
+					{
+						MemberIndex: 2,
+						Flags:       []string{"-XX:MaxGCPauseMillis=50"},
+						Env:         []string{"ZOO_LOG4J_PROP=WARN,CONSOLE"},
+					},
 				},
 				ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
 					Type:                       "write",
@@ -137,11 +164,18 @@ func TestConfig(t *testing.T) {
 				DatabaseTag:           "consul-v1.0.2-go1.8.0",
 				DatabaseDescription:   "Consul v1.0.2 (Go 1.8.0)",
 				PeerIPs:               []string{"10.240.0.27", "10.240.0.28", "10.240.0.29"},
-				PeerIPsString:         "10.240.0.27___10.240.0.28___10.240.0.29",
+				PeerIPsString:         "10.240.0.27:8500:8300___10.240.0.28:8500:8300___10.240.0.29:8500:8300",
 				DatabasePortToConnect: 8500,
 				DatabaseEndpoints:     []string{"10.240.0.27:8500", "10.240.0.28:8500", "10.240.0.29:8500"},
 				AgentPortToConnect:    3500,
 				AgentEndpoints:        []string{"10.240.0.27:3500", "10.240.0.28:3500", "10.240.0.29:3500"},
+				DatabaseFlags: dbtesterpb.DatabaseFlags{
+					Flag_Consul: &dbtesterpb.Flag_Consul{
+						RaftMultiplier:        1,
+						RaftSnapshotThreshold: 16384,
+						RaftSnapshotInterval:  "30s",
+					},
+				},
 				ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{
 					Type:                       "write",
 					RequestNumber:              1000000,
@@ -492,6 +526,12 @@ func TestConfig(t *testing.T) {
 			},
 		},
 	}
+	// the logger is created when reading the configuration,
+	// so only check that there is one
+	if cfg.lg == nil {
+		t.Fatal("expected logger")
+	}
+	expected.lg = cfg.lg
 	if !reflect.DeepEqual(cfg, expected) {
 		t.Fatalf("configuration expected\n%+v\n, got\n%+v\n", expected, cfg)
 	}
@@ -505,7 +545,7 @@ func TestConfig(t *testing.T) {
 		TriggerLogUpload:    true,
 		DatabaseID:          dbtesterpb.DatabaseID_etcd__tip,
 		DatabaseTag:         "etcd-tip-go1.8.0",
-		PeerIPsString:       "10.240.0.7___10.240.0.8___10.240.0.12",
+		PeerIPsString:       "10.240.0.7:2379:2380___10.240.0.8:2379:2380___10.240.0.12:2379:2380",
 		IPIndex:             0,
 		CurrentClientNumber: 0,
 		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{
@@ -514,9 +554,11 @@ func TestConfig(t *testing.T) {
 			GoogleCloudStorageBucketName:   "dbtester-results",
 			GoogleCloudStorageSubDirectory: "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable",
 		},
-		Flag_Etcd_Tip: &dbtesterpb.Flag_Etcd_Tip{
-			SnapshotCount:  100000,
-			QuotaSizeBytes: 8000000000,
+		DatabaseFlags: dbtesterpb.DatabaseFlags{
+			Flag_Etcd: &dbtesterpb.Flag_Etcd{
+				SnapshotCount:  100000,
+				QuotaSizeBytes: 8000000000,
+			},
 		},
 	}
 	if !reflect.DeepEqual(req1, expected1) {
@@ -532,7 +574,7 @@ func TestConfig(t *testing.T) {
 		TriggerLogUpload:    true,
 		DatabaseID:          dbtesterpb.DatabaseID_zookeeper__r3_5_3_beta,
 		DatabaseTag:         "zookeeper-r3.5.3-beta-java8",
-		PeerIPsString:       "10.240.0.21___10.240.0.22___10.240.0.23",
+		PeerIPsString:       "10.240.0.21:2181:2888___10.240.0.22:2181:2888___10.240.0.23:2181:2888",
 		IPIndex:             2,
 		CurrentClientNumber: 0,
 		ConfigClientMachineInitial: &dbtesterpb.ConfigClientMachineInitial{
@@ -541,20 +583,224 @@ func TestConfig(t *testing.T) {
 			GoogleCloudStorageBucketName:   "dbtester-results",
 			GoogleCloudStorageSubDirectory: "2017Q1-01-etcd-zookeeper-consul/01-write-1M-keys-client-variable",
 		},
-		Flag_Zookeeper_R3_5_3Beta: &dbtesterpb.Flag_Zookeeper_R3_5_3Beta{
-			JavaDJuteMaxBuffer:   33554432,
-			JavaXms:              "50G",
-			JavaXmx:              "50G",
-			MyID:                 3,
-			ClientPort:           2181,
-			TickTime:             2000,
-			InitLimit:            5,
-			SyncLimit:            5,
-			SnapCount:            100000,
-			MaxClientConnections: 5000,
+		DatabaseFlags: dbtesterpb.DatabaseFlags{
+			Flag_Zookeeper: &dbtesterpb.Flag_Zookeeper{
+				JavaDJuteMaxBuffer:   33554432,
+				JavaXms:              "50G",
+				JavaXmx:              "50G",
+				MyID:                 3,
+				ClientPort:           2181,
+				TickTime:             2000,
+				InitLimit:            5,
+				SyncLimit:            5,
+				SnapCount:            100000,
+				MaxClientConnections: 5000,
+			},
+		},
+		ResourceLimits: &dbtesterpb.ConfigClientMachineResourceLimits{
+			CPUs:        2,
+			MemoryBytes: 4294967296,
+		},
+		ExtraOptions: &dbtesterpb.ConfigClientMachineExtraOptions{
+			MemberIndex:    2,
+			Flags:          []string{"-XX:+UseG1GC", "-XX:MaxGCPauseMillis=50"},
+			Env:            []string{"ZOO_LOG4J_PROP=WARN,CONSOLE"},
+			ConfigTemplate: "globalOutstandingLimit=1000",
 		},
 	}
 	if !reflect.DeepEqual(req2, expected2) {
 		t.Fatalf("configuration expected\n%+v\n, got\n%+v\n", expected2, req2)
 	}
 }
+
+func Test_setPeerEndpoints(t *testing.T) {
+	tests := []struct {
+		gcfg dbtesterpb.ConfigClientMachineAgentControl
+
+		peerIPsString     string
+		databaseEndpoints []string
+		agentEndpoints    []string
+		err               bool
+	}{
+		{
+			gcfg: dbtesterpb.ConfigClientMachineAgentControl{
+				DatabaseID:         "etcd__v3_3",
+				PeerIPs:            []string{"127.0.0.1:2379:2380", "127.0.0.1:22379:22380", "127.0.0.1:32379:32380"},
+				AgentPortToConnect: 3500,
+			},
+			peerIPsString:     "127.0.0.1:2379:2380___127.0.0.1:22379:22380___127.0.0.1:32379:32380",
+			databaseEndpoints: []string{"127.0.0.1:2379", "127.0.0.1:22379", "127.0.0.1:32379"},
+			agentEndpoints:    []string{"127.0.0.1:3500", "127.0.0.1:3501", "127.0.0.1:3502"},
+		},
+		{
+			gcfg: dbtesterpb.ConfigClientMachineAgentControl{
+				DatabaseID:            "zookeeper__r3_5_3_beta",
+				PeerIPs:               []string{"10.0.0.1", "10.0.0.2:12181:12888", "10.0.0.2:22181:22888"},
+				DatabasePortToConnect: 2182,
+				AgentPortToConnect:    3500,
+			},
+			peerIPsString:     "10.0.0.1:2182:2888___10.0.0.2:12181:12888___10.0.0.2:22181:22888",
+			databaseEndpoints: []string{"10.0.0.1:2182", "10.0.0.2:12181", "10.0.0.2:22181"},
+			agentEndpoints:    []string{"10.0.0.1:3500", "10.0.0.2:3500", "10.0.0.2:3501"},
+		},
+		{
+			// default ports collide on the same host
+			gcfg: dbtesterpb.ConfigClientMachineAgentControl{
+				DatabaseID:         "etcd__v3_3",
+				PeerIPs:            []string{"127.0.0.1", "127.0.0.1"},
+				AgentPortToConnect: 3500,
+			},
+			err: true,
+		},
+		{
+			// Consul Serf LAN port 8301 of the first member
+			gcfg: dbtesterpb.ConfigClientMachineAgentControl{
+				DatabaseID:         "consul__v1_0_2",
+				PeerIPs:            []string{"127.0.0.1:8500:8300", "127.0.0.1:18500:8301"},
+				AgentPortToConnect: 3500,
+			},
+			err: true,
+		},
+		{
+			gcfg: dbtesterpb.ConfigClientMachineAgentControl{
+				DatabaseID: "etcd__v3_3",
+				PeerIPs:    []string{"127.0.0.1:2379"},
+			},
+			err: true,
+		},
+	}
+	for i, tt := range tests {
+		gcfg := tt.gcfg
+		err := setPeerEndpoints(&gcfg)
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+		if tt.err {
+			continue
+		}
+		if gcfg.PeerIPsString != tt.peerIPsString {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.peerIPsString, gcfg.PeerIPsString)
+		}
+		if !reflect.DeepEqual(gcfg.DatabaseEndpoints, tt.databaseEndpoints) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.databaseEndpoints, gcfg.DatabaseEndpoints)
+		}
+		if !reflect.DeepEqual(gcfg.AgentEndpoints, tt.agentEndpoints) {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.agentEndpoints, gcfg.AgentEndpoints)
+		}
+	}
+}
+
+func Test_renameLegacyFlagKeys(t *testing.T) {
+	tests := []struct {
+		config string
+
+		flags map[string]dbtesterpb.DatabaseFlags
+		err   bool
+	}{
+		{
+			config: `
+datatbase_id_to_config_client_machine_agent_control:
+  etcd__v3_3:
+    etcd__v3_3:
+      snapshot_count: 10000
+  zookeeper__r3_5_3_beta:
+    zookeeper__r3_5_3_beta:
+      snap_count: 100000
+`,
+			flags: map[string]dbtesterpb.DatabaseFlags{
+				"etcd__v3_3":             {Flag_Etcd: &dbtesterpb.Flag_Etcd{SnapshotCount: 10000}},
+				"zookeeper__r3_5_3_beta": {Flag_Zookeeper: &dbtesterpb.Flag_Zookeeper{SnapCount: 100000}},
+			},
+		},
+		{
+			config: `
+datatbase_id_to_config_client_machine_agent_control:
+  etcd__embed:
+    etcd__embed:
+      quota_size_bytes: 1000
+  consul:
+    consul:
+      raft_multiplier: 1
+`,
+			flags: map[string]dbtesterpb.DatabaseFlags{
+				"etcd__embed": {Flag_Etcd: &dbtesterpb.Flag_Etcd{QuotaSizeBytes: 1000}},
+				"consul":      {Flag_Consul: &dbtesterpb.Flag_Consul{RaftMultiplier: 1}},
+			},
+		},
+		{
+			config: `
+datatbase_id_to_config_client_machine_agent_control:
+  etcd__v3_3:
+    etcd:
+      snapshot_count: 10000
+    etcd__v3_3:
+      snapshot_count: 20000
+`,
+			err: true,
+		},
+		{
+			config: `
+datatbase_id_to_config_client_machine_agent_control:
+  etcd__v3_3:
+    etcd__tip:
+      snapshot_count: 10000
+    etcd__v3_3:
+      snapshot_count: 20000
+`,
+			err: true,
+		},
+	}
+	for i, tt := range tests {
+		bts, err := renameLegacyFlagKeys([]byte(tt.config))
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+		if tt.err {
+			continue
+		}
+		var cfg Config
+		if err = yaml.Unmarshal(bts, &cfg); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		flags := make(map[string]dbtesterpb.DatabaseFlags)
+		for id, gcfg := range cfg.DatabaseIDToConfigClientMachineAgentControl {
+			flags[id] = gcfg.DatabaseFlags
+		}
+		if !reflect.DeepEqual(flags, tt.flags) {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tt.flags, flags)
+		}
+	}
+}
+
+func Test_checkCrashOffset(t *testing.T) {
+	tests := []struct {
+		opts dbtesterpb.ConfigClientMachineBenchmarkOptions
+		err  bool
+	}{
+		{
+			opts: dbtesterpb.ConfigClientMachineBenchmarkOptions{CrashOffsetMillisecond: 5000, RequestNumber: 100000},
+		},
+		{
+			// 100,000 requests at 10,000 per second run for 10 seconds
+			opts: dbtesterpb.ConfigClientMachineBenchmarkOptions{CrashOffsetMillisecond: 5000, RequestNumber: 100000, RateLimitRequestsPerSecond: 10000},
+		},
+		{
+			opts: dbtesterpb.ConfigClientMachineBenchmarkOptions{CrashOffsetMillisecond: 10000, RequestNumber: 100000, RateLimitRequestsPerSecond: 10000},
+			err:  true,
+		},
+		{
+			opts: dbtesterpb.ConfigClientMachineBenchmarkOptions{CrashOffsetMillisecond: 0, RequestNumber: 100000},
+			err:  true,
+		},
+		{
+			opts: dbtesterpb.ConfigClientMachineBenchmarkOptions{CrashOffsetMillisecond: -1, RequestNumber: 100000, RateLimitRequestsPerSecond: 10000},
+			err:  true,
+		},
+	}
+	for i, tt := range tests {
+		err := checkCrashOffset(tt.opts)
+		if (err != nil) != tt.err {
+			t.Fatalf("#%d: expected error %v, got %v", i, tt.err, err)
+		}
+	}
+}
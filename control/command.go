@@ -24,11 +24,10 @@ import (
 	"github.com/etcd-io/dbtester"
 	"github.com/etcd-io/dbtester/dbtesterpb"
 	"github.com/etcd-io/dbtester/pkg/ntp"
+	"github.com/etcd-io/dbtester/pkg/procfs"
 
 	"github.com/coreos/etcd/pkg/netutil"
 	"github.com/gyuho/linux-inspect/df"
-	"github.com/gyuho/linux-inspect/inspect"
-	"github.com/gyuho/linux-inspect/top"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -44,6 +43,7 @@ var databaseID string
 var configPath string
 var diskDevice string
 var networkInterface string
+var metricsInterval time.Duration
 
 func init() {
 	dn, err := df.GetDevice("/")
@@ -65,6 +65,7 @@ func init() {
 	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
 	Command.PersistentFlags().StringVar(&diskDevice, "disk-device", dn, "Disk device to collect disk statistics metrics from.")
 	Command.PersistentFlags().StringVar(&networkInterface, "network-interface", nt, "Network interface to record in/outgoing packets.")
+	Command.PersistentFlags().DurationVar(&metricsInterval, "metrics-interval", time.Second, "System metrics sample interval (minimum 100ms).")
 }
 
 func commandFunc(cmd *cobra.Command, args []string) error {
@@ -76,6 +77,11 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	return Run(cfg, databaseID)
+}
+
+// Run runs the benchmark steps of the database with its agents.
+func Run(cfg *dbtester.Config, databaseID string) (err error) {
 	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
 	if !ok {
 		return fmt.Errorf("%q is not found", databaseID)
@@ -86,6 +92,9 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		case "write":
 		case "read":
 		case "read-oneshot":
+		case "session":
+		case "crash-recovery":
+		case "snapshot":
 		default:
 			return fmt.Errorf("%q is not supported", gcfg.ConfigClientMachineBenchmarkOptions.Type)
 		}
@@ -98,24 +107,23 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		zap.String("disk-device", diskDevice),
 		zap.String("network-device", networkInterface),
 		zap.Int64("pid", pid),
+		zap.Duration("interval", metricsInterval),
 	)
 	if err = os.RemoveAll(cfg.ConfigClientMachineInitial.ClientSystemMetricsPath); err != nil {
 		return err
 	}
-	tcfg := &top.Config{
-		Exec:           top.DefaultExecPath,
-		IntervalSecond: 1,
-		PID:            pid,
-	}
-	var metricsCSV *inspect.CSV
-	metricsCSV, err = inspect.NewCSV(
+	var metricsCSV *procfs.CSV
+	metricsCSV, err = procfs.NewCSV(
 		cfg.ConfigClientMachineInitial.ClientSystemMetricsPath,
 		pid,
 		diskDevice,
 		networkInterface,
 		"",
-		tcfg,
+		metricsInterval,
 	)
+	if err != nil {
+		return err
+	}
 	if err = metricsCSV.Add(); err != nil {
 		return err
 	}
@@ -124,9 +132,9 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	go func() {
 		for {
 			select {
-			case <-time.After(time.Second):
+			case <-time.After(metricsInterval):
 				if err := metricsCSV.Add(); err != nil {
-					lg.Warn("inspect.CSV.Add error", zap.Error(err))
+					lg.Warn("procfs.CSV.Add error", zap.Error(err))
 					continue
 				}
 
@@ -134,18 +142,18 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 				lg.Info("finishing collecting system metrics; saving CSV", zap.String("path", cfg.ConfigClientMachineInitial.ClientSystemMetricsPath))
 
 				if err := metricsCSV.Save(); err != nil {
-					lg.Warn("inspect.CSV.Save failed", zap.String("path", metricsCSV.FilePath), zap.Error(err))
+					lg.Warn("procfs.CSV.Save failed", zap.String("path", metricsCSV.FilePath), zap.Error(err))
 				} else {
 					lg.Info("saved CSV", zap.String("path", metricsCSV.FilePath))
 				}
 
 				interpolated, err := metricsCSV.Interpolate()
 				if err != nil {
-					lg.Fatal("inspect.CSV.Interpolate failed", zap.String("path", metricsCSV.FilePath), zap.Error(err))
+					lg.Fatal("procfs.CSV.Interpolate failed", zap.String("path", metricsCSV.FilePath), zap.Error(err))
 				}
 				interpolated.FilePath = cfg.ConfigClientMachineInitial.ClientSystemMetricsInterpolatedPath
 				if err := interpolated.Save(); err != nil {
-					lg.Warn("inspect.CSV.Save failed", zap.String("path", interpolated.FilePath), zap.Error(err))
+					lg.Warn("procfs.CSV.Save failed", zap.String("path", interpolated.FilePath), zap.Error(err))
 				} else {
 					lg.Info("saved CSV", zap.String("path", interpolated.FilePath))
 				}
@@ -167,17 +175,48 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 	println()
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
 		lg.Info("step 1: starting databases...")
-		if _, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start); err != nil {
+		// agents respond to 'Start' once the database is ready with a leader
+		var idxToResp map[int]dbtesterpb.Response
+		idxToResp, err = cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Start)
+		if err != nil {
+			return err
+		}
+		if err = cfg.SaveStartupSummary(databaseID, idxToResp); err != nil {
 			return err
 		}
 	}
 
 	if gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase {
-		println()
-		time.Sleep(5 * time.Second)
 		println()
 		lg.Info("step 2: starting tests...")
-		if err = cfg.Stress(databaseID); err != nil {
+		var faultc, leaderc, diagc chan error
+		stopc := make(chan struct{})
+		if len(gcfg.Faults) > 0 {
+			lg.Info("step 2: injecting faults during tests...", zap.Int("faults", len(gcfg.Faults)))
+			faultc = make(chan error, 1)
+			go func() { faultc <- cfg.InjectFaults(databaseID, stopc) }()
+		}
+		if len(gcfg.Diagnostics) > 0 {
+			lg.Info("step 2: capturing diagnostics during tests...", zap.Int("diagnostics", len(gcfg.Diagnostics)))
+			diagc = make(chan error, 1)
+			go func() { diagc <- cfg.CaptureDiagnostics(databaseID, stopc) }()
+		}
+		if cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath != "" {
+			lg.Info("step 2: sampling leader during tests...", zap.String("path", cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath))
+			leaderc = make(chan error, 1)
+			go func() { leaderc <- cfg.SampleLeaders(databaseID, stopc) }()
+		}
+		err = cfg.Stress(databaseID)
+		close(stopc)
+		for _, c := range []chan error{faultc, leaderc, diagc} {
+			if c == nil {
+				continue
+			}
+			if cerr := <-c; cerr != nil && err == nil {
+				err = cerr
+			}
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -245,6 +284,36 @@ func commandFunc(cmd *cobra.Command, args []string) error {
 		if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath); err != nil {
 			return err
 		}
+		if gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ServerStartupSummaryPath); err != nil {
+				return err
+			}
+		}
+		if cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath != "" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath); err != nil {
+				return err
+			}
+		}
+		if len(gcfg.Faults) > 0 {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientFaultTimelinePath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.Type == "session" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientSessionConsistencyPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.Type == "crash-recovery" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientCrashRecoveryPath); err != nil {
+				return err
+			}
+		}
+		if gcfg.ConfigClientMachineBenchmarkOptions.Type == "snapshot" {
+			if err = cfg.UploadToGoogle(databaseID, cfg.ConfigClientMachineInitial.ClientSnapshotPath); err != nil {
+				return err
+			}
+		}
 	}
 
 	lg.Info("all done!")
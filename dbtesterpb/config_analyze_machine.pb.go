@@ -2,39 +2,47 @@
 // source: dbtesterpb/config_analyze_machine.proto
 
 /*
-	Package dbtesterpb is a generated protocol buffer package.
+Package dbtesterpb is a generated protocol buffer package.
 
-	It is generated from these files:
-		dbtesterpb/config_analyze_machine.proto
-		dbtesterpb/config_client_machine.proto
-		dbtesterpb/database_id.proto
-		dbtesterpb/flag_cetcd.proto
-		dbtesterpb/flag_consul.proto
-		dbtesterpb/flag_etcd.proto
-		dbtesterpb/flag_zetcd.proto
-		dbtesterpb/flag_zookeeper.proto
-		dbtesterpb/message.proto
+It is generated from these files:
 
-	It has these top-level messages:
-		ConfigAnalyzeMachineInitial
-		ConfigAnalyzeMachineAllAggregatedOutput
-		ConfigAnalyzeMachinePlot
-		ConfigAnalyzeMachineImage
-		ConfigAnalyzeMachineREADME
-		ConfigClientMachineInitial
-		ConfigClientMachineBenchmarkOptions
-		ConfigClientMachineBenchmarkSteps
-		ConfigClientMachineAgentControl
-		Flag_Cetcd_Beta
-		Flag_Consul_V1_0_2
-		Flag_Etcd_Other
-		Flag_Etcd_Tip
-		Flag_Etcd_V3_2
-		Flag_Etcd_V3_3
-		Flag_Zetcd_Beta
-		Flag_Zookeeper_R3_5_3Beta
-		Request
-		Response
+	dbtesterpb/config_analyze_machine.proto
+	dbtesterpb/config_client_machine.proto
+	dbtesterpb/database_id.proto
+	dbtesterpb/flag.proto
+	dbtesterpb/flag_cetcd.proto
+	dbtesterpb/flag_consul.proto
+	dbtesterpb/flag_custom.proto
+	dbtesterpb/flag_etcd.proto
+	dbtesterpb/flag_zetcd.proto
+	dbtesterpb/flag_zookeeper.proto
+	dbtesterpb/message.proto
+
+It has these top-level messages:
+
+	ConfigAnalyzeMachineInitial
+	ConfigAnalyzeMachineAllAggregatedOutput
+	ConfigAnalyzeMachinePlot
+	ConfigAnalyzeMachineImage
+	ConfigAnalyzeMachineREADME
+	ConfigClientMachineInitial
+	ConfigClientMachineBenchmarkOptions
+	ConfigClientMachineBenchmarkSteps
+	ConfigClientMachineFault
+	ConfigClientMachineDiagnostics
+	ConfigClientMachineResourceLimits
+	ConfigClientMachineExtraOptions
+	ConfigClientMachineAgentControl
+	DatabaseFlags
+	Flag_Cetcd
+	Flag_Consul
+	Flag_Custom
+	Flag_Etcd
+	Flag_Zetcd
+	Flag_Zookeeper
+	NetworkFault
+	Request
+	Response
 */
 package dbtesterpb
 
@@ -74,6 +82,21 @@ type ConfigAnalyzeMachineInitial struct {
 	ServerWriteBytesDeltaByKeyNumberPath    string   `protobuf:"bytes,14,opt,name=ServerWriteBytesDeltaByKeyNumberPath,proto3" json:"ServerWriteBytesDeltaByKeyNumberPath,omitempty" yaml:"server_write_bytes_delta_by_key_number_path"`
 	ServerSystemMetricsInterpolatedPathList []string `protobuf:"bytes,15,rep,name=ServerSystemMetricsInterpolatedPathList" json:"ServerSystemMetricsInterpolatedPathList,omitempty" yaml:"server_system_metrics_interpolated_path_list"`
 	AllAggregatedOutputPath                 string   `protobuf:"bytes,16,opt,name=AllAggregatedOutputPath,proto3" json:"AllAggregatedOutputPath,omitempty" yaml:"all_aggregated_output_path"`
+	ClientFaultTimelinePath                 string   `protobuf:"bytes,17,opt,name=ClientFaultTimelinePath,proto3" json:"ClientFaultTimelinePath,omitempty" yaml:"client_fault_timeline_path"`
+	ClientLeaderTimelinePath                string   `protobuf:"bytes,18,opt,name=ClientLeaderTimelinePath,proto3" json:"ClientLeaderTimelinePath,omitempty" yaml:"client_leader_timeline_path"`
+	// ServerProcessMetricsPathList is the list of proxy and descendant
+	// process metrics of each member, optional. Samples within the same
+	// second are averaged, and descendant metrics are the totals over
+	// all descendant processes of the member.
+	ServerProcessMetricsPathList []string `protobuf:"bytes,19,rep,name=ServerProcessMetricsPathList" json:"ServerProcessMetricsPathList,omitempty" yaml:"server_process_metrics_path_list"`
+	// ServerDiskUsagePathList is the list of data directory size time series
+	// of each member, optional. If set, ServerDiskUsageByKeyNumberPath is
+	// the average size by the number of keys.
+	ServerDiskUsagePathList        []string `protobuf:"bytes,20,rep,name=ServerDiskUsagePathList" json:"ServerDiskUsagePathList,omitempty" yaml:"server_disk_usage_path_list"`
+	ServerDiskUsageByKeyNumberPath string   `protobuf:"bytes,21,opt,name=ServerDiskUsageByKeyNumberPath,proto3" json:"ServerDiskUsageByKeyNumberPath,omitempty" yaml:"server_disk_usage_by_key_number_path"`
+	// ServerGCMetricsPathList is the list of per-second JVM GC pauses
+	// of each member, optional (Zookeeper only).
+	ServerGCMetricsPathList []string `protobuf:"bytes,22,rep,name=ServerGCMetricsPathList" json:"ServerGCMetricsPathList,omitempty" yaml:"server_gc_metrics_path_list"`
 }
 
 func (m *ConfigAnalyzeMachineInitial) Reset()         { *m = ConfigAnalyzeMachineInitial{} }
@@ -269,6 +292,81 @@ func (m *ConfigAnalyzeMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.AllAggregatedOutputPath)))
 		i += copy(dAtA[i:], m.AllAggregatedOutputPath)
 	}
+	if len(m.ClientFaultTimelinePath) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientFaultTimelinePath)))
+		i += copy(dAtA[i:], m.ClientFaultTimelinePath)
+	}
+	if len(m.ClientLeaderTimelinePath) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ClientLeaderTimelinePath)))
+		i += copy(dAtA[i:], m.ClientLeaderTimelinePath)
+	}
+	if len(m.ServerProcessMetricsPathList) > 0 {
+		for _, s := range m.ServerProcessMetricsPathList {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ServerDiskUsagePathList) > 0 {
+		for _, s := range m.ServerDiskUsagePathList {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ServerDiskUsageByKeyNumberPath) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigAnalyzeMachine(dAtA, i, uint64(len(m.ServerDiskUsageByKeyNumberPath)))
+		i += copy(dAtA[i:], m.ServerDiskUsageByKeyNumberPath)
+	}
+	if len(m.ServerGCMetricsPathList) > 0 {
+		for _, s := range m.ServerGCMetricsPathList {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -509,6 +607,36 @@ func (m *ConfigAnalyzeMachineInitial) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
 	}
+	l = len(m.ClientFaultTimelinePath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	l = len(m.ClientLeaderTimelinePath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if len(m.ServerProcessMetricsPathList) > 0 {
+		for _, s := range m.ServerProcessMetricsPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	if len(m.ServerDiskUsagePathList) > 0 {
+		for _, s := range m.ServerDiskUsagePathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
+	l = len(m.ServerDiskUsageByKeyNumberPath)
+	if l > 0 {
+		n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+	}
+	if len(m.ServerGCMetricsPathList) > 0 {
+		for _, s := range m.ServerGCMetricsPathList {
+			l = len(s)
+			n += 2 + l + sovConfigAnalyzeMachine(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1094,6 +1222,180 @@ func (m *ConfigAnalyzeMachineInitial) Unmarshal(dAtA []byte) error {
 			}
 			m.AllAggregatedOutputPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFaultTimelinePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientFaultTimelinePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLeaderTimelinePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientLeaderTimelinePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerProcessMetricsPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerProcessMetricsPathList = append(m.ServerProcessMetricsPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDiskUsagePathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDiskUsagePathList = append(m.ServerDiskUsagePathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerDiskUsageByKeyNumberPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerDiskUsageByKeyNumberPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerGCMetricsPathList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigAnalyzeMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigAnalyzeMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerGCMetricsPathList = append(m.ServerGCMetricsPathList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigAnalyzeMachine(dAtA[iNdEx:])
@@ -1775,68 +2077,77 @@ func init() {
 }
 
 var fileDescriptorConfigAnalyzeMachine = []byte{
-	// 999 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0xdc, 0x44,
-	0x18, 0xaf, 0x9b, 0x26, 0x90, 0x49, 0xd3, 0x96, 0x01, 0xb5, 0x4b, 0x82, 0xd6, 0xc1, 0x69, 0x48,
-	0xaa, 0x42, 0x52, 0x12, 0x28, 0x12, 0x27, 0x76, 0xb3, 0x3d, 0x44, 0x34, 0x10, 0x39, 0x0b, 0x84,
-	0xd3, 0x68, 0xec, 0x9d, 0x78, 0x47, 0xf1, 0x3f, 0x79, 0xc6, 0x65, 0x0d, 0x57, 0x24, 0x24, 0x24,
-	0x24, 0xb8, 0x71, 0xe2, 0xc8, 0xb3, 0xf4, 0xc8, 0x13, 0x18, 0x08, 0x6f, 0xe0, 0x17, 0xa0, 0x9a,
-	0x6f, 0x9c, 0xc4, 0xbb, 0xf1, 0xfe, 0xb9, 0xad, 0xe7, 0xfb, 0xfd, 0xfb, 0x3e, 0xcf, 0x8e, 0x07,
-	0x6d, 0xf6, 0x1c, 0xc9, 0x84, 0x64, 0x49, 0xec, 0xec, 0xb8, 0x51, 0x78, 0xca, 0x3d, 0x42, 0x43,
-	0xea, 0x67, 0xdf, 0x33, 0x12, 0x50, 0xb7, 0xcf, 0x43, 0xb6, 0x1d, 0x27, 0x91, 0x8c, 0x30, 0xba,
-	0x02, 0xae, 0x7c, 0xe0, 0x71, 0xd9, 0x4f, 0x9d, 0x6d, 0x37, 0x0a, 0x76, 0xbc, 0xc8, 0x8b, 0x76,
-	0x00, 0xe2, 0xa4, 0xa7, 0xf0, 0x04, 0x0f, 0xf0, 0x4b, 0x53, 0xad, 0xbf, 0x97, 0xd1, 0xea, 0x3e,
-	0x68, 0xb7, 0xb4, 0xf4, 0xa1, 0x56, 0x3e, 0x08, 0xb9, 0xe4, 0xd4, 0xc7, 0x4d, 0x84, 0x3a, 0x54,
-	0x52, 0x87, 0x0a, 0x76, 0xd0, 0x69, 0x18, 0x6b, 0xc6, 0xd6, 0xa2, 0x5d, 0x59, 0xc1, 0x6b, 0x68,
-	0xe9, 0xe2, 0xa9, 0x4b, 0xbd, 0xc6, 0x4d, 0x00, 0x54, 0x97, 0xf0, 0x13, 0xf4, 0xe6, 0xc5, 0x63,
-	0x87, 0x09, 0x37, 0xe1, 0xb1, 0xe4, 0x51, 0xd8, 0x98, 0x03, 0x64, 0x5d, 0x09, 0x3f, 0x45, 0xe8,
-	0x88, 0xca, 0xfe, 0x51, 0xc2, 0x4e, 0xf9, 0xa0, 0x71, 0x4b, 0x01, 0xdb, 0xf7, 0x8b, 0xdc, 0xc4,
-	0x19, 0x0d, 0xfc, 0x4f, 0xad, 0x98, 0xca, 0x3e, 0x89, 0xa1, 0x68, 0xd9, 0x15, 0x24, 0xfe, 0xd1,
-	0x40, 0xeb, 0xfb, 0x3e, 0x67, 0xa1, 0x3c, 0xce, 0x84, 0x64, 0xc1, 0x21, 0x93, 0x09, 0x77, 0xc5,
-	0x41, 0xa8, 0x26, 0x13, 0xf9, 0x54, 0xb2, 0x9e, 0x42, 0x37, 0xe6, 0x41, 0x71, 0xb7, 0xc8, 0xcd,
-	0x6d, 0xad, 0xe8, 0x02, 0x89, 0x08, 0x60, 0x91, 0x40, 0xd3, 0x08, 0xaf, 0xf0, 0x88, 0x32, 0xb5,
-	0xec, 0x59, 0xe4, 0xf1, 0xcf, 0x06, 0xda, 0xd0, 0xb8, 0xe7, 0x54, 0xb2, 0xd0, 0xcd, 0xba, 0xfd,
-	0x24, 0x4a, 0xbd, 0x7e, 0x9c, 0xca, 0x2e, 0x0f, 0x98, 0x60, 0x09, 0x67, 0x02, 0x82, 0x2c, 0x40,
-	0x90, 0x8f, 0x8a, 0xdc, 0x7c, 0x32, 0x14, 0xc4, 0xd7, 0x3c, 0x22, 0x2f, 0x89, 0x44, 0x5e, 0x32,
-	0xcb, 0x28, 0xb3, 0x59, 0xe0, 0x1f, 0xd0, 0xda, 0x10, 0xb0, 0xc3, 0x85, 0x4c, 0xb8, 0x93, 0xaa,
-	0x41, 0xb7, 0x7c, 0x1f, 0x62, 0xbc, 0x06, 0x31, 0x76, 0x8a, 0xdc, 0x7c, 0x5c, 0x1b, 0xa3, 0x57,
-	0xe1, 0x10, 0xea, 0xfb, 0x65, 0x82, 0xa9, 0xc2, 0xf8, 0x57, 0x03, 0x6d, 0x8e, 0x05, 0x1d, 0xb1,
-	0xc4, 0x65, 0xa1, 0xe4, 0x3e, 0x83, 0x10, 0xaf, 0x43, 0x88, 0xa7, 0x45, 0x6e, 0xee, 0x4e, 0x0f,
-	0x11, 0x5f, 0x72, 0xcb, 0x2c, 0xb3, 0xda, 0xe0, 0x9f, 0x0c, 0xf4, 0x70, 0x2c, 0xf6, 0x38, 0x0d,
-	0x02, 0x9a, 0x64, 0x90, 0x67, 0x11, 0xf2, 0xec, 0x15, 0xb9, 0xb9, 0x33, 0x3d, 0x8f, 0xd0, 0xc4,
-	0x32, 0xcc, 0x4c, 0x06, 0x38, 0x46, 0xef, 0x0c, 0xe1, 0xda, 0xd9, 0xe7, 0x2c, 0xfb, 0x22, 0x0d,
-	0x1c, 0x96, 0x40, 0x00, 0x04, 0x01, 0xde, 0x2f, 0x72, 0x73, 0xab, 0x36, 0x80, 0x93, 0x91, 0x33,
-	0x96, 0x91, 0x10, 0x18, 0xa5, 0xf3, 0x44, 0x45, 0x9c, 0x21, 0xf3, 0x98, 0x25, 0x2f, 0x58, 0xd2,
-	0xe1, 0xe2, 0xec, 0x38, 0xa6, 0x2e, 0xfb, 0x4a, 0x50, 0x8f, 0x55, 0xbb, 0x5e, 0x1a, 0xdd, 0x0a,
-	0x02, 0x08, 0xaa, 0xdb, 0x33, 0x22, 0x14, 0x85, 0xa4, 0x8a, 0x33, 0xd2, 0xf1, 0x34, 0x5d, 0x1c,
-	0xa0, 0x55, 0x0d, 0x39, 0x64, 0x41, 0x94, 0x5c, 0xeb, 0xf5, 0x36, 0xd8, 0x3e, 0x2e, 0x72, 0x73,
-	0x73, 0xc8, 0x36, 0x00, 0x74, 0x6d, 0xab, 0x93, 0xf4, 0xd4, 0x5b, 0x5e, 0xd7, 0x75, 0x9b, 0xd1,
-	0x5e, 0x3b, 0x93, 0x4c, 0x74, 0x98, 0x2f, 0xe9, 0xa8, 0xef, 0x32, 0xf8, 0x7e, 0x5c, 0xe4, 0xe6,
-	0x87, 0x43, 0xbe, 0x09, 0xa3, 0x3d, 0xe2, 0x28, 0x1a, 0xe9, 0x29, 0x5e, 0x6d, 0x82, 0x59, 0x1c,
-	0xd4, 0x61, 0xf0, 0x50, 0xe3, 0xbe, 0x49, 0xb8, 0x64, 0xe3, 0xa3, 0xdc, 0x19, 0xdd, 0xff, 0x65,
-	0x94, 0xef, 0x14, 0x6d, 0x6a, 0x96, 0x99, 0x3c, 0xf0, 0x6f, 0x06, 0xda, 0xd4, 0xc0, 0x89, 0x27,
-	0xd8, 0x73, 0x2e, 0x64, 0xe3, 0xee, 0xda, 0xdc, 0xd6, 0x62, 0xfb, 0x93, 0x22, 0x37, 0xf7, 0x86,
-	0xf2, 0x4c, 0x3b, 0x24, 0x89, 0xcf, 0x85, 0xb4, 0xec, 0x59, 0x7d, 0x30, 0x41, 0x0f, 0x5a, 0xbe,
-	0xdf, 0xf2, 0xbc, 0x84, 0x79, 0xaa, 0xf0, 0x65, 0x2a, 0xe3, 0x54, 0xc2, 0x48, 0xee, 0xc1, 0x48,
-	0x36, 0x8a, 0xdc, 0x7c, 0x57, 0x47, 0x50, 0x67, 0x0f, 0xbd, 0x44, 0x92, 0x08, 0xa0, 0xe5, 0x04,
-	0xc6, 0xa9, 0x58, 0xff, 0xab, 0x43, 0xa8, 0xe6, 0x0b, 0x57, 0x83, 0xc7, 0x1c, 0xad, 0x8c, 0x91,
-	0xd9, 0x3f, 0xfe, 0x5a, 0x7f, 0xfd, 0xda, 0x8f, 0x8a, 0xdc, 0xdc, 0x98, 0x96, 0x87, 0xb8, 0xe2,
-	0x85, 0x65, 0x4f, 0x10, 0x9b, 0x60, 0xd5, 0x3d, 0xe9, 0xea, 0xef, 0xe8, 0x8c, 0x56, 0x72, 0x20,
-	0xc7, 0x5b, 0x75, 0x4f, 0xba, 0xd6, 0x1f, 0x37, 0x51, 0xa3, 0x6e, 0x02, 0x47, 0x7e, 0x24, 0xf1,
-	0x23, 0xb4, 0xb0, 0x1f, 0xf9, 0x69, 0x10, 0x96, 0xed, 0xbd, 0x51, 0xe4, 0xe6, 0x72, 0x79, 0xe0,
-	0xc0, 0xba, 0x65, 0x97, 0x00, 0xbc, 0x89, 0xe6, 0x4f, 0x5a, 0x03, 0x2e, 0xca, 0x74, 0x15, 0xe4,
-	0x80, 0xd0, 0x01, 0x17, 0x96, 0xad, 0xeb, 0x0a, 0xf8, 0x2d, 0x00, 0xe7, 0x46, 0x81, 0xd9, 0x05,
-	0x10, 0xea, 0xf8, 0x33, 0xb4, 0x3c, 0x3c, 0x62, 0xfd, 0xb1, 0x5f, 0x29, 0x72, 0xf3, 0xbe, 0x26,
-	0x5c, 0x9b, 0xe9, 0x30, 0x01, 0xef, 0xa3, 0x3b, 0x57, 0x0b, 0xb0, 0x71, 0xe7, 0x61, 0xe3, 0xae,
-	0x16, 0xb9, 0xf9, 0xe0, 0xba, 0x84, 0xde, 0x9c, 0x23, 0x14, 0xeb, 0x17, 0x03, 0xbd, 0x5d, 0x7b,
-	0x09, 0x0a, 0xa8, 0xc7, 0xf0, 0x7b, 0x68, 0xbe, 0xcb, 0xa5, 0xcf, 0xca, 0x01, 0xdd, 0x2b, 0x72,
-	0xf3, 0xb6, 0x56, 0x96, 0x6a, 0xd9, 0xb2, 0x75, 0x19, 0xaf, 0xa3, 0x5b, 0xb0, 0x6d, 0xf5, 0x74,
-	0xee, 0x16, 0xb9, 0xb9, 0x74, 0x75, 0x61, 0xb1, 0x6c, 0x28, 0x2a, 0x50, 0x37, 0x8b, 0x59, 0x39,
-	0x99, 0x0a, 0x48, 0x66, 0x31, 0xb3, 0x6c, 0x28, 0x5a, 0x7f, 0x1a, 0x68, 0xa5, 0x2e, 0x8f, 0xfd,
-	0xac, 0xd5, 0x39, 0x7c, 0xa6, 0xee, 0x47, 0x95, 0x7f, 0x89, 0x31, 0x7a, 0x3f, 0x1a, 0xfa, 0x5b,
-	0x54, 0x90, 0xf8, 0x08, 0x2d, 0x40, 0x47, 0xea, 0x05, 0xce, 0x6d, 0x2d, 0xed, 0x6e, 0x6c, 0x5f,
-	0xdd, 0x1b, 0xb7, 0xc7, 0xf6, 0x5f, 0x7d, 0x7d, 0x1c, 0xe8, 0x96, 0x5d, 0xea, 0xb4, 0xdf, 0x7a,
-	0xf9, 0x6f, 0xf3, 0xc6, 0xcb, 0xf3, 0xa6, 0xf1, 0xd7, 0x79, 0xd3, 0xf8, 0xe7, 0xbc, 0x69, 0xfc,
-	0xfe, 0x5f, 0xf3, 0x86, 0xb3, 0x00, 0x57, 0xcb, 0xbd, 0x57, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47,
-	0x8f, 0xbe, 0x24, 0xc0, 0x0a, 0x00, 0x00,
+	// 1145 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0xae, 0xb3, 0x4d, 0xa0, 0x93, 0xfe, 0x4e, 0x4b, 0xb2, 0x24, 0xd5, 0x7a, 0x71, 0x9a, 0x26,
+	0x55, 0x21, 0x29, 0x09, 0x14, 0x89, 0x2b, 0xf6, 0xa7, 0xa0, 0x88, 0x06, 0x56, 0xce, 0x02, 0xe1,
+	0xca, 0xcc, 0x7a, 0x27, 0xde, 0x51, 0xfc, 0x27, 0xcf, 0xb8, 0x8d, 0xe1, 0x16, 0x09, 0x09, 0x09,
+	0x09, 0xee, 0xb8, 0xe2, 0x92, 0x67, 0xe9, 0x25, 0xe2, 0x01, 0x2c, 0x08, 0x6f, 0xe0, 0x17, 0x00,
+	0xcd, 0x8c, 0xb3, 0x6b, 0x3b, 0xf6, 0xee, 0x72, 0x17, 0xcf, 0x7c, 0xdf, 0xf9, 0xbe, 0x73, 0x66,
+	0xce, 0xc9, 0x2c, 0xd8, 0x1a, 0x0e, 0x18, 0xa6, 0x0c, 0x07, 0xfe, 0x60, 0xd7, 0xf4, 0xdc, 0x13,
+	0x62, 0x19, 0xc8, 0x45, 0x76, 0xf4, 0x2d, 0x36, 0x1c, 0x64, 0x8e, 0x88, 0x8b, 0x77, 0xfc, 0xc0,
+	0x63, 0x1e, 0x04, 0x13, 0xe0, 0xda, 0x3b, 0x16, 0x61, 0xa3, 0x70, 0xb0, 0x63, 0x7a, 0xce, 0xae,
+	0xe5, 0x59, 0xde, 0xae, 0x80, 0x0c, 0xc2, 0x13, 0xf1, 0x25, 0x3e, 0xc4, 0x5f, 0x92, 0xaa, 0xfd,
+	0x09, 0xc1, 0x7a, 0x47, 0xc4, 0x6e, 0xc9, 0xd0, 0x87, 0x32, 0xf2, 0x81, 0x4b, 0x18, 0x41, 0x36,
+	0x6c, 0x00, 0xd0, 0x45, 0x0c, 0x0d, 0x10, 0xc5, 0x07, 0xdd, 0xba, 0xd2, 0x54, 0xb6, 0xaf, 0xe9,
+	0x99, 0x15, 0xd8, 0x04, 0xcb, 0x17, 0x5f, 0x7d, 0x64, 0xd5, 0x17, 0x04, 0x20, 0xbb, 0x04, 0x9f,
+	0x80, 0xbb, 0x17, 0x9f, 0x5d, 0x4c, 0xcd, 0x80, 0xf8, 0x8c, 0x78, 0x6e, 0xbd, 0x26, 0x90, 0x65,
+	0x5b, 0xf0, 0x29, 0x00, 0x3d, 0xc4, 0x46, 0xbd, 0x00, 0x9f, 0x90, 0xb3, 0xfa, 0x55, 0x0e, 0x6c,
+	0xaf, 0x24, 0xb1, 0x0a, 0x23, 0xe4, 0xd8, 0x1f, 0x6a, 0x3e, 0x62, 0x23, 0xc3, 0x17, 0x9b, 0x9a,
+	0x9e, 0x41, 0xc2, 0xef, 0x15, 0xb0, 0xd1, 0xb1, 0x09, 0x76, 0xd9, 0x51, 0x44, 0x19, 0x76, 0x0e,
+	0x31, 0x0b, 0x88, 0x49, 0x0f, 0x5c, 0x5e, 0x19, 0xcf, 0x46, 0x0c, 0x0f, 0x39, 0xba, 0xbe, 0x28,
+	0x22, 0xee, 0x25, 0xb1, 0xba, 0x23, 0x23, 0x9a, 0x82, 0x64, 0x50, 0xc1, 0x32, 0x1c, 0x49, 0x33,
+	0x48, 0x86, 0x67, 0x70, 0x51, 0x4d, 0x9f, 0x27, 0x3c, 0xfc, 0x51, 0x01, 0x9b, 0x12, 0xf7, 0x1c,
+	0x31, 0xec, 0x9a, 0x51, 0x7f, 0x14, 0x78, 0xa1, 0x35, 0xf2, 0x43, 0xd6, 0x27, 0x0e, 0xa6, 0x38,
+	0x20, 0x98, 0x0a, 0x23, 0x4b, 0xc2, 0xc8, 0x7b, 0x49, 0xac, 0x3e, 0xc9, 0x19, 0xb1, 0x25, 0xcf,
+	0x60, 0x63, 0xa2, 0xc1, 0xc6, 0xcc, 0xd4, 0xca, 0x7c, 0x12, 0xf0, 0x3b, 0xd0, 0xcc, 0x01, 0xbb,
+	0x84, 0xb2, 0x80, 0x0c, 0x42, 0x5e, 0xe8, 0x96, 0x6d, 0x0b, 0x1b, 0xaf, 0x09, 0x1b, 0xbb, 0x49,
+	0xac, 0x3e, 0x2e, 0xb5, 0x31, 0xcc, 0x70, 0x0c, 0x64, 0xdb, 0xa9, 0x83, 0x99, 0x81, 0xe1, 0xcf,
+	0x0a, 0xd8, 0xaa, 0x04, 0xf5, 0x70, 0x60, 0x62, 0x97, 0x11, 0x1b, 0x0b, 0x13, 0xaf, 0x0b, 0x13,
+	0x4f, 0x93, 0x58, 0xdd, 0x9b, 0x6d, 0xc2, 0x1f, 0x73, 0x53, 0x2f, 0xf3, 0xca, 0xc0, 0x1f, 0x14,
+	0xf0, 0xa0, 0x12, 0x7b, 0x14, 0x3a, 0x0e, 0x0a, 0x22, 0xe1, 0xe7, 0x9a, 0xf0, 0xb3, 0x9f, 0xc4,
+	0xea, 0xee, 0x6c, 0x3f, 0x54, 0x12, 0x53, 0x33, 0x73, 0x09, 0x40, 0x1f, 0xdc, 0xcf, 0xe1, 0xda,
+	0xd1, 0xa7, 0x38, 0xfa, 0x2c, 0x74, 0x06, 0x38, 0x10, 0x06, 0x80, 0x30, 0xf0, 0x76, 0x12, 0xab,
+	0xdb, 0xa5, 0x06, 0x06, 0x91, 0x71, 0x8a, 0x23, 0xc3, 0x15, 0x8c, 0x54, 0x79, 0x6a, 0x44, 0x18,
+	0x01, 0xf5, 0x08, 0x07, 0x2f, 0x70, 0xd0, 0x25, 0xf4, 0xf4, 0xc8, 0x47, 0x26, 0xfe, 0x82, 0x22,
+	0x0b, 0x67, 0xb3, 0x5e, 0x2e, 0x5e, 0x05, 0x2a, 0x08, 0x3c, 0xdb, 0x53, 0x83, 0x72, 0x8a, 0x11,
+	0x72, 0x4e, 0x21, 0xe3, 0x59, 0x71, 0xa1, 0x03, 0xd6, 0x25, 0xe4, 0x10, 0x3b, 0x5e, 0x70, 0x29,
+	0xd7, 0xeb, 0x42, 0xf6, 0x71, 0x12, 0xab, 0x5b, 0x39, 0x59, 0x47, 0xa0, 0x4b, 0x53, 0x9d, 0x16,
+	0x8f, 0x9f, 0xf2, 0x86, 0xdc, 0xd7, 0x31, 0x1a, 0xb6, 0x23, 0x86, 0x69, 0x17, 0xdb, 0x0c, 0x15,
+	0x75, 0x6f, 0x08, 0xdd, 0xf7, 0x93, 0x58, 0x7d, 0x37, 0xa7, 0x1b, 0x60, 0x34, 0x34, 0x06, 0x9c,
+	0x66, 0x0c, 0x39, 0xaf, 0xd4, 0xc1, 0x3c, 0x0a, 0x7c, 0x18, 0x3c, 0x90, 0xb8, 0xaf, 0x02, 0xc2,
+	0x70, 0xb5, 0x95, 0x9b, 0xc5, 0xfb, 0x9f, 0x5a, 0x79, 0xc9, 0x69, 0x33, 0xbd, 0xcc, 0xa5, 0x01,
+	0x7f, 0x51, 0xc0, 0x96, 0x04, 0x4e, 0x9d, 0x60, 0xcf, 0x09, 0x65, 0xf5, 0x5b, 0xcd, 0xda, 0xf6,
+	0xb5, 0xf6, 0x07, 0x49, 0xac, 0xee, 0xe7, 0xfc, 0xcc, 0x1a, 0x92, 0x86, 0x4d, 0x28, 0xd3, 0xf4,
+	0x79, 0x75, 0xa0, 0x01, 0x56, 0x5b, 0xb6, 0xdd, 0xb2, 0xac, 0x00, 0x5b, 0x7c, 0xe3, 0xf3, 0x90,
+	0xf9, 0x21, 0x13, 0x25, 0xb9, 0x2d, 0x4a, 0xb2, 0x99, 0xc4, 0xea, 0x5b, 0xd2, 0x02, 0x9f, 0x3d,
+	0x68, 0x8c, 0x34, 0x3c, 0x01, 0x4d, 0x2b, 0x50, 0x15, 0x85, 0x0b, 0xc8, 0xae, 0xf8, 0x18, 0x85,
+	0xb6, 0x18, 0x8f, 0x36, 0x71, 0xe5, 0xcc, 0xb9, 0x53, 0x14, 0x48, 0x5b, 0xec, 0x84, 0x23, 0x0d,
+	0x96, 0x42, 0x2f, 0x04, 0x2a, 0xa2, 0xc0, 0x01, 0xa8, 0xa7, 0x6d, 0x87, 0xd1, 0x10, 0x07, 0x39,
+	0x05, 0x28, 0x14, 0x1e, 0x26, 0xb1, 0xaa, 0xe5, 0x9b, 0x58, 0x40, 0x8b, 0x12, 0x95, 0x71, 0xa0,
+	0x07, 0xee, 0xcb, 0x82, 0xf6, 0x02, 0xcf, 0xc4, 0x94, 0xa6, 0x15, 0x1d, 0x9f, 0xd6, 0xdd, 0x66,
+	0xad, 0xb4, 0x81, 0x7c, 0x09, 0x1f, 0x1f, 0x57, 0xe6, 0x84, 0xa6, 0x06, 0x84, 0xdf, 0x80, 0xd5,
+	0x49, 0x4f, 0x8b, 0x76, 0x1e, 0x6b, 0xdd, 0x6b, 0xd6, 0xf2, 0x39, 0x65, 0x67, 0x84, 0x9c, 0x0e,
+	0x19, 0x99, 0xaa, 0x30, 0xf0, 0x25, 0x68, 0x14, 0xb6, 0x8a, 0x2d, 0xf1, 0xc6, 0xb4, 0x61, 0x24,
+	0x85, 0xca, 0x7a, 0x61, 0x46, 0xd8, 0x49, 0x6a, 0x9f, 0x74, 0x8a, 0x65, 0x5c, 0xa9, 0x48, 0xcd,
+	0x32, 0xcb, 0x2a, 0x58, 0x15, 0x46, 0xfb, 0x97, 0xff, 0xdf, 0x2b, 0x79, 0x54, 0x95, 0x5c, 0x51,
+	0x48, 0xc0, 0x5a, 0xc5, 0xcd, 0xed, 0x1c, 0x7d, 0x29, 0x1f, 0x5c, 0xed, 0x47, 0x49, 0xac, 0x6e,
+	0xce, 0x6a, 0x01, 0xc3, 0xa4, 0x2f, 0x34, 0x7d, 0x4a, 0xb0, 0x29, 0x52, 0xfd, 0xe3, 0x7e, 0x7d,
+	0xe1, 0x7f, 0x48, 0xb1, 0x33, 0x56, 0x2d, 0xd5, 0x3f, 0xee, 0x6b, 0xbf, 0x2d, 0x80, 0x7a, 0x59,
+	0x05, 0x7a, 0xb6, 0xc7, 0xe0, 0x23, 0xb0, 0xd4, 0xf1, 0xec, 0xd0, 0x71, 0xd3, 0xf4, 0xee, 0x24,
+	0xb1, 0x7a, 0x23, 0x6d, 0x0f, 0xb1, 0xae, 0xe9, 0x29, 0x00, 0x6e, 0x81, 0xc5, 0xe3, 0xd6, 0x19,
+	0xa1, 0xf5, 0x85, 0x22, 0xf2, 0xcc, 0x40, 0x67, 0x84, 0x6a, 0xba, 0xdc, 0xe7, 0xc0, 0xaf, 0x05,
+	0xb0, 0x56, 0x04, 0x46, 0x17, 0x40, 0xb1, 0x0f, 0x3f, 0x02, 0x37, 0xf2, 0x25, 0x96, 0xef, 0xcb,
+	0xb5, 0x24, 0x56, 0x57, 0x24, 0xe1, 0x52, 0x4d, 0xf3, 0x04, 0xd8, 0x01, 0x37, 0x27, 0x0b, 0xe2,
+	0xda, 0x2c, 0x8a, 0x6b, 0xb3, 0x9e, 0xc4, 0xea, 0xea, 0xe5, 0x10, 0xf2, 0xae, 0x14, 0x28, 0xda,
+	0x4f, 0x0a, 0x78, 0xb3, 0xf4, 0xdd, 0xed, 0x20, 0x0b, 0xc3, 0x87, 0x60, 0xb1, 0x4f, 0x98, 0x8d,
+	0xd3, 0x02, 0xdd, 0x4e, 0x62, 0xf5, 0xba, 0x8c, 0xcc, 0xf8, 0xb2, 0xa6, 0xcb, 0x6d, 0xb8, 0x01,
+	0xae, 0x8a, 0x4e, 0x91, 0xd5, 0xb9, 0x95, 0xc4, 0xea, 0xf2, 0xe4, 0x8d, 0xac, 0xe9, 0x62, 0x93,
+	0x83, 0xfa, 0x91, 0x8f, 0xeb, 0xb5, 0x22, 0x88, 0x45, 0x3e, 0xd6, 0x74, 0xb1, 0xa9, 0xfd, 0xae,
+	0x80, 0xb5, 0x32, 0x3f, 0xfa, 0xb3, 0x56, 0xf7, 0xf0, 0x19, 0x7f, 0x92, 0x67, 0x06, 0xb3, 0x52,
+	0x7c, 0x92, 0xe7, 0x26, 0x71, 0x06, 0x09, 0x7b, 0x60, 0x49, 0x64, 0xc4, 0x0f, 0xb0, 0xb6, 0xbd,
+	0xbc, 0xb7, 0xb9, 0x33, 0xf9, 0xa9, 0xb2, 0x53, 0x99, 0x7f, 0xf6, 0xf8, 0x88, 0xa0, 0x6b, 0x7a,
+	0x1a, 0xa7, 0x7d, 0xef, 0xd5, 0xdf, 0x8d, 0x2b, 0xaf, 0xce, 0x1b, 0xca, 0x1f, 0xe7, 0x0d, 0xe5,
+	0xaf, 0xf3, 0x86, 0xf2, 0xeb, 0x3f, 0x8d, 0x2b, 0x83, 0x25, 0xf1, 0x6b, 0x66, 0xff, 0xbf, 0x01,
+	0x00, 0x41, 0x85, 0x1c, 0x9c, 0x33, 0x0d, 0x00, 0x00,
 }
@@ -8,6 +8,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -27,11 +29,19 @@ type ConfigClientMachineInitial struct {
 	ClientLatencyDistributionSummaryPath    string `protobuf:"bytes,8,opt,name=ClientLatencyDistributionSummaryPath,proto3" json:"ClientLatencyDistributionSummaryPath,omitempty" yaml:"client_latency_distribution_summary_path"`
 	ClientLatencyByKeyNumberPath            string `protobuf:"bytes,9,opt,name=ClientLatencyByKeyNumberPath,proto3" json:"ClientLatencyByKeyNumberPath,omitempty" yaml:"client_latency_by_key_number_path"`
 	ServerDiskSpaceUsageSummaryPath         string `protobuf:"bytes,10,opt,name=ServerDiskSpaceUsageSummaryPath,proto3" json:"ServerDiskSpaceUsageSummaryPath,omitempty" yaml:"server_disk_space_usage_summary_path"`
-	GoogleCloudProjectName                  string `protobuf:"bytes,100,opt,name=GoogleCloudProjectName,proto3" json:"GoogleCloudProjectName,omitempty" yaml:"google_cloud_project_name"`
-	GoogleCloudStorageKeyPath               string `protobuf:"bytes,101,opt,name=GoogleCloudStorageKeyPath,proto3" json:"GoogleCloudStorageKeyPath,omitempty" yaml:"google_cloud_storage_key_path"`
-	GoogleCloudStorageKey                   string `protobuf:"bytes,102,opt,name=GoogleCloudStorageKey,proto3" json:"GoogleCloudStorageKey,omitempty"`
-	GoogleCloudStorageBucketName            string `protobuf:"bytes,103,opt,name=GoogleCloudStorageBucketName,proto3" json:"GoogleCloudStorageBucketName,omitempty" yaml:"google_cloud_storage_bucket_name"`
-	GoogleCloudStorageSubDirectory          string `protobuf:"bytes,104,opt,name=GoogleCloudStorageSubDirectory,proto3" json:"GoogleCloudStorageSubDirectory,omitempty" yaml:"google_cloud_storage_sub_directory"`
+	ClientSessionConsistencyPath            string `protobuf:"bytes,11,opt,name=ClientSessionConsistencyPath,proto3" json:"ClientSessionConsistencyPath,omitempty" yaml:"client_session_consistency_path"`
+	ClientFaultTimelinePath                 string `protobuf:"bytes,12,opt,name=ClientFaultTimelinePath,proto3" json:"ClientFaultTimelinePath,omitempty" yaml:"client_fault_timeline_path"`
+	ClientCrashRecoveryPath                 string `protobuf:"bytes,13,opt,name=ClientCrashRecoveryPath,proto3" json:"ClientCrashRecoveryPath,omitempty" yaml:"client_crash_recovery_path"`
+	ClientSnapshotPath                      string `protobuf:"bytes,14,opt,name=ClientSnapshotPath,proto3" json:"ClientSnapshotPath,omitempty" yaml:"client_snapshot_path"`
+	ServerStartupSummaryPath                string `protobuf:"bytes,15,opt,name=ServerStartupSummaryPath,proto3" json:"ServerStartupSummaryPath,omitempty" yaml:"server_startup_summary_path"`
+	// ClientLeaderTimelinePath is the per-second leader and term of the
+	// cluster during benchmark. The leader is not sampled if empty.
+	ClientLeaderTimelinePath       string `protobuf:"bytes,16,opt,name=ClientLeaderTimelinePath,proto3" json:"ClientLeaderTimelinePath,omitempty" yaml:"client_leader_timeline_path"`
+	GoogleCloudProjectName         string `protobuf:"bytes,100,opt,name=GoogleCloudProjectName,proto3" json:"GoogleCloudProjectName,omitempty" yaml:"google_cloud_project_name"`
+	GoogleCloudStorageKeyPath      string `protobuf:"bytes,101,opt,name=GoogleCloudStorageKeyPath,proto3" json:"GoogleCloudStorageKeyPath,omitempty" yaml:"google_cloud_storage_key_path"`
+	GoogleCloudStorageKey          string `protobuf:"bytes,102,opt,name=GoogleCloudStorageKey,proto3" json:"GoogleCloudStorageKey,omitempty"`
+	GoogleCloudStorageBucketName   string `protobuf:"bytes,103,opt,name=GoogleCloudStorageBucketName,proto3" json:"GoogleCloudStorageBucketName,omitempty" yaml:"google_cloud_storage_bucket_name"`
+	GoogleCloudStorageSubDirectory string `protobuf:"bytes,104,opt,name=GoogleCloudStorageSubDirectory,proto3" json:"GoogleCloudStorageSubDirectory,omitempty" yaml:"google_cloud_storage_sub_directory"`
 }
 
 func (m *ConfigClientMachineInitial) Reset()         { *m = ConfigClientMachineInitial{} }
@@ -53,6 +63,12 @@ type ConfigClientMachineBenchmarkOptions struct {
 	KeySizeBytes               int64   `protobuf:"varint,8,opt,name=KeySizeBytes,proto3" json:"KeySizeBytes,omitempty" yaml:"key_size_bytes"`
 	ValueSizeBytes             int64   `protobuf:"varint,9,opt,name=ValueSizeBytes,proto3" json:"ValueSizeBytes,omitempty" yaml:"value_size_bytes"`
 	StaleRead                  bool    `protobuf:"varint,10,opt,name=StaleRead,proto3" json:"StaleRead,omitempty" yaml:"stale_read"`
+	// CrashOffsetMillisecond is the delay since the benchmark started,
+	// before "crash-recovery" kills all members at once.
+	CrashOffsetMillisecond int64 `protobuf:"varint,11,opt,name=CrashOffsetMillisecond,proto3" json:"CrashOffsetMillisecond,omitempty" yaml:"crash_offset_millisecond"`
+	// SnapshotMemberIndex is the member that "snapshot" saves the snapshot
+	// from, and wipes and recovers after the writes.
+	SnapshotMemberIndex int64 `protobuf:"varint,12,opt,name=SnapshotMemberIndex,proto3" json:"SnapshotMemberIndex,omitempty" yaml:"snapshot_member_index"`
 }
 
 func (m *ConfigClientMachineBenchmarkOptions) Reset()         { *m = ConfigClientMachineBenchmarkOptions{} }
@@ -77,40 +93,167 @@ func (*ConfigClientMachineBenchmarkSteps) Descriptor() ([]byte, []int) {
 	return fileDescriptorConfigClientMachine, []int{2}
 }
 
+// ConfigClientMachineFault represents a fault injected during benchmark.
+type ConfigClientMachineFault struct {
+	// Type is one of "kill", "restart", "pause", "resume", "kill-leader",
+	// "partition", "netem", "heal", "member-add", "member-remove" and "upgrade".
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty" yaml:"type"`
+	// OffsetMillisecond is the delay since the benchmark started.
+	OffsetMillisecond int64 `protobuf:"varint,2,opt,name=OffsetMillisecond,proto3" json:"OffsetMillisecond,omitempty" yaml:"offset_millisecond"`
+	// MemberIndex is the index of target member in 'peer_ips'.
+	// -1 targets the member of the previous fault (e.g. to restart
+	// the member that "kill-leader" killed). "kill-leader" ignores it.
+	MemberIndex int64 `protobuf:"varint,3,opt,name=MemberIndex,proto3" json:"MemberIndex,omitempty" yaml:"member_index"`
+	// TargetMemberIndexes are the peers that "partition" and "netem"
+	// apply to. If empty, all other members are the targets.
+	TargetMemberIndexes []int64 `protobuf:"varint,4,rep,packed,name=TargetMemberIndexes" json:"TargetMemberIndexes,omitempty" yaml:"target_member_indexes"`
+	DelayMillisecond    int64   `protobuf:"varint,5,opt,name=DelayMillisecond,proto3" json:"DelayMillisecond,omitempty" yaml:"delay_millisecond"`
+	JitterMillisecond   int64   `protobuf:"varint,6,opt,name=JitterMillisecond,proto3" json:"JitterMillisecond,omitempty" yaml:"jitter_millisecond"`
+	LossPercent         float64 `protobuf:"fixed64,7,opt,name=LossPercent,proto3" json:"LossPercent,omitempty" yaml:"loss_percent"`
+	// UpgradeDatabaseID is the database ID that "upgrade" restarts the member
+	// with, which must be defined with its flags in the same configuration.
+	UpgradeDatabaseID string `protobuf:"bytes,8,opt,name=UpgradeDatabaseID,proto3" json:"UpgradeDatabaseID,omitempty" yaml:"upgrade_database_id"`
+}
+
+func (m *ConfigClientMachineFault) Reset()         { *m = ConfigClientMachineFault{} }
+func (m *ConfigClientMachineFault) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineFault) ProtoMessage()    {}
+func (*ConfigClientMachineFault) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{3}
+}
+
+// ConfigClientMachineDiagnostics represents diagnostics captured during benchmark.
+type ConfigClientMachineDiagnostics struct {
+	// OffsetMillisecond is the delay since the benchmark started.
+	OffsetMillisecond int64 `protobuf:"varint,1,opt,name=OffsetMillisecond,proto3" json:"OffsetMillisecond,omitempty" yaml:"offset_millisecond"`
+	// MemberIndexes are the members to capture from, in 'peer_ips'.
+	// If empty, all members are captured.
+	MemberIndexes []int64 `protobuf:"varint,2,rep,packed,name=MemberIndexes" json:"MemberIndexes,omitempty" yaml:"member_indexes"`
+	// CPUProfileSeconds is the duration of CPU profile on Go databases.
+	// If zero, no CPU profile is taken.
+	CPUProfileSeconds int64 `protobuf:"varint,3,opt,name=CPUProfileSeconds,proto3" json:"CPUProfileSeconds,omitempty" yaml:"cpu_profile_seconds"`
+}
+
+func (m *ConfigClientMachineDiagnostics) Reset()         { *m = ConfigClientMachineDiagnostics{} }
+func (m *ConfigClientMachineDiagnostics) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineDiagnostics) ProtoMessage()    {}
+func (*ConfigClientMachineDiagnostics) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{4}
+}
+
+// ConfigClientMachineResourceLimits represents the cgroup v2 limits
+// that agents apply to the database process. Zero values are unlimited.
+type ConfigClientMachineResourceLimits struct {
+	// CPUs is the CPU quota in the number of CPUs (e.g. 2, 0.5), for 'cpu.max'.
+	CPUs float64 `protobuf:"fixed64,1,opt,name=CPUs,proto3" json:"CPUs,omitempty" yaml:"cpus"`
+	// MemoryBytes is the hard memory limit, for 'memory.max'.
+	MemoryBytes int64 `protobuf:"varint,2,opt,name=MemoryBytes,proto3" json:"MemoryBytes,omitempty" yaml:"memory_bytes"`
+	// IOWeight is the proportional block IO weight between 1 and 10000,
+	// for 'io.weight'.
+	IOWeight uint64 `protobuf:"varint,3,opt,name=IOWeight,proto3" json:"IOWeight,omitempty" yaml:"io_weight"`
+	// IO throttles on the agent disk device, for 'io.max'.
+	IOReadBytesPerSecond  int64 `protobuf:"varint,4,opt,name=IOReadBytesPerSecond,proto3" json:"IOReadBytesPerSecond,omitempty" yaml:"io_read_bytes_per_second"`
+	IOWriteBytesPerSecond int64 `protobuf:"varint,5,opt,name=IOWriteBytesPerSecond,proto3" json:"IOWriteBytesPerSecond,omitempty" yaml:"io_write_bytes_per_second"`
+	IOReadIOPS            int64 `protobuf:"varint,6,opt,name=IOReadIOPS,proto3" json:"IOReadIOPS,omitempty" yaml:"io_read_iops"`
+	IOWriteIOPS           int64 `protobuf:"varint,7,opt,name=IOWriteIOPS,proto3" json:"IOWriteIOPS,omitempty" yaml:"io_write_iops"`
+}
+
+func (m *ConfigClientMachineResourceLimits) Reset()         { *m = ConfigClientMachineResourceLimits{} }
+func (m *ConfigClientMachineResourceLimits) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineResourceLimits) ProtoMessage()    {}
+func (*ConfigClientMachineResourceLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{5}
+}
+
+// ConfigClientMachineExtraOptions represents database options without
+// dedicated flag fields. Flags, proxy flags and the configuration template
+// are Go templates with '.MemberIndex', '.MemberID' (1-based), '.IP',
+// '.PeerIPs' (hosts only), '.PeerAddrs' and '.ClientAddrs' ("host:port"),
+// '.ClientPort', '.PeerPort', '.DataDir' and '.ConfigFile' (where the
+// rendered template is written), and the 'join' function
+// (e.g. '{{join .PeerAddrs ","}}').
+type ConfigClientMachineExtraOptions struct {
+	// MemberIndex is the index of target member in 'peer_ips',
+	// only for 'member_extra_options'.
+	MemberIndex int64 `protobuf:"varint,1,opt,name=MemberIndex,proto3" json:"MemberIndex,omitempty" yaml:"member_index"`
+	// Flags are appended to the database command line. Zookeeper takes
+	// them as JVM flags. etcd takes them for zetcd and cetcd.
+	Flags []string `protobuf:"bytes,2,rep,name=Flags" json:"Flags,omitempty" yaml:"flags"`
+	// ProxyFlags are appended to the zetcd and cetcd command line.
+	ProxyFlags []string `protobuf:"bytes,3,rep,name=ProxyFlags" json:"ProxyFlags,omitempty" yaml:"proxy_flags"`
+	// Env are 'KEY=VALUE' pairs added to the environment of the database
+	// and proxy processes.
+	Env []string `protobuf:"bytes,4,rep,name=Env" json:"Env,omitempty" yaml:"env"`
+	// ConfigTemplate is the configuration file that flags refer to with
+	// '{{.ConfigFile}}' (e.g. Consul '-config-file'). Zookeeper appends it
+	// to its configuration file.
+	ConfigTemplate string `protobuf:"bytes,5,opt,name=ConfigTemplate,proto3" json:"ConfigTemplate,omitempty" yaml:"config_template"`
+}
+
+func (m *ConfigClientMachineExtraOptions) Reset()         { *m = ConfigClientMachineExtraOptions{} }
+func (m *ConfigClientMachineExtraOptions) String() string { return proto.CompactTextString(m) }
+func (*ConfigClientMachineExtraOptions) ProtoMessage()    {}
+func (*ConfigClientMachineExtraOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfigClientMachine, []int{6}
+}
+
 // ConfigClientMachineAgentControl represents control options on client machine.
 type ConfigClientMachineAgentControl struct {
-	DatabaseID                          string                               `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
-	DatabaseDescription                 string                               `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
-	DatabaseTag                         string                               `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
-	PeerIPs                             []string                             `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
-	PeerIPsString                       string                               `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
-	AgentPortToConnect                  int64                                `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
-	AgentEndpoints                      []string                             `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
-	DatabasePortToConnect               int64                                `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
-	DatabaseEndpoints                   []string                             `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
-	Flag_Etcd_Other                     *Flag_Etcd_Other                     `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty" yaml:"etcd__other"`
-	Flag_Etcd_Tip                       *Flag_Etcd_Tip                       `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty" yaml:"etcd__tip"`
-	Flag_Etcd_V3_2                      *Flag_Etcd_V3_2                      `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty" yaml:"etcd__v3_2"`
-	Flag_Etcd_V3_3                      *Flag_Etcd_V3_3                      `protobuf:"bytes,103,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty" yaml:"etcd__v3_3"`
-	Flag_Zookeeper_R3_5_3Beta           *Flag_Zookeeper_R3_5_3Beta           `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty" yaml:"zookeeper__r3_5_3_beta"`
-	Flag_Consul_V1_0_2                  *Flag_Consul_V1_0_2                  `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty" yaml:"consul__v1_0_2"`
-	Flag_Cetcd_Beta                     *Flag_Cetcd_Beta                     `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty" yaml:"cetcd__beta"`
-	Flag_Zetcd_Beta                     *Flag_Zetcd_Beta                     `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty" yaml:"zetcd__beta"`
+	DatabaseID          string `protobuf:"bytes,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty" yaml:"database_id"`
+	DatabaseDescription string `protobuf:"bytes,2,opt,name=DatabaseDescription,proto3" json:"DatabaseDescription,omitempty" yaml:"database_description"`
+	DatabaseTag         string `protobuf:"bytes,3,opt,name=DatabaseTag,proto3" json:"DatabaseTag,omitempty" yaml:"database_tag"`
+	// PeerIPs are the members, as "host" or "host:clientPort:peerPort".
+	// "host" members serve clients on 'database_port_to_connect', and peers
+	// on the default port of the database. Members on the same host need
+	// different ports, and their agents listen on consecutive ports from
+	// 'agent_port_to_connect', in the order of 'peer_ips'. zetcd and cetcd
+	// serve clients on the proxy, and their etcd on 'peerPort - 1'. Zookeeper
+	// elects leaders on 'peerPort + 1000', and Consul Serf uses 'peerPort + 1'
+	// and 'peerPort + 2'.
+	PeerIPs []string `protobuf:"bytes,4,rep,name=PeerIPs" json:"PeerIPs,omitempty" yaml:"peer_ips"`
+	// PeerIPsString is "host:clientPort:peerPort" of 'PeerIPs', joined by "___".
+	PeerIPsString         string   `protobuf:"bytes,5,opt,name=PeerIPsString,proto3" json:"PeerIPsString,omitempty" yaml:"peer_ips_string"`
+	AgentPortToConnect    int64    `protobuf:"varint,6,opt,name=AgentPortToConnect,proto3" json:"AgentPortToConnect,omitempty" yaml:"agent_port_to_connect"`
+	AgentEndpoints        []string `protobuf:"bytes,7,rep,name=AgentEndpoints" json:"AgentEndpoints,omitempty" yaml:"agent_endpoints"`
+	DatabasePortToConnect int64    `protobuf:"varint,8,opt,name=DatabasePortToConnect,proto3" json:"DatabasePortToConnect,omitempty" yaml:"database_port_to_connect"`
+	DatabaseEndpoints     []string `protobuf:"bytes,9,rep,name=DatabaseEndpoints" json:"DatabaseEndpoints,omitempty" yaml:"database_endpoints"`
+	// InitialClusterSize is the number of members to start with.
+	// The rest of 'peer_ips' are started by "member-add" faults.
+	// If zero, all members are started.
+	InitialClusterSize int64 `protobuf:"varint,10,opt,name=InitialClusterSize,proto3" json:"InitialClusterSize,omitempty" yaml:"initial_cluster_size"`
+	// DatabaseVersion is the free-form version of the database (e.g. "3.4"),
+	// for the IDs without version. If not empty, the version of the binary
+	// must start with it (e.g. "3.4.2"), ignoring the leading "v".
+	DatabaseVersion string `protobuf:"bytes,11,opt,name=DatabaseVersion,proto3" json:"DatabaseVersion,omitempty" yaml:"database_version"`
+	// DatabaseFlags is the flags of the database family, inlined so that
+	// each family is keyed by its name (e.g. "etcd" for "etcd__v3_3").
+	DatabaseFlags                       `protobuf:"bytes,700,opt,name=DatabaseFlags,embedded=DatabaseFlags" json:"DatabaseFlags" yaml:",inline"`
 	ConfigClientMachineBenchmarkOptions *ConfigClientMachineBenchmarkOptions `protobuf:"bytes,1000,opt,name=ConfigClientMachineBenchmarkOptions" json:"ConfigClientMachineBenchmarkOptions,omitempty" yaml:"benchmark_options"`
 	ConfigClientMachineBenchmarkSteps   *ConfigClientMachineBenchmarkSteps   `protobuf:"bytes,1001,opt,name=ConfigClientMachineBenchmarkSteps" json:"ConfigClientMachineBenchmarkSteps,omitempty" yaml:"benchmark_steps"`
+	Faults                              []*ConfigClientMachineFault          `protobuf:"bytes,1002,rep,name=Faults" json:"Faults,omitempty" yaml:"faults"`
+	Diagnostics                         []*ConfigClientMachineDiagnostics    `protobuf:"bytes,1003,rep,name=Diagnostics" json:"Diagnostics,omitempty" yaml:"diagnostics"`
+	ResourceLimits                      *ConfigClientMachineResourceLimits   `protobuf:"bytes,1004,opt,name=ResourceLimits" json:"ResourceLimits,omitempty" yaml:"resource_limits"`
+	// ExtraOptions apply to all members. MemberExtraOptions append flags and
+	// environment to the member, and override the configuration template.
+	ExtraOptions       *ConfigClientMachineExtraOptions   `protobuf:"bytes,1005,opt,name=ExtraOptions" json:"ExtraOptions,omitempty" yaml:"extra_options"`
+	MemberExtraOptions []*ConfigClientMachineExtraOptions `protobuf:"bytes,1006,rep,name=MemberExtraOptions" json:"MemberExtraOptions,omitempty" yaml:"member_extra_options"`
 }
 
 func (m *ConfigClientMachineAgentControl) Reset()         { *m = ConfigClientMachineAgentControl{} }
 func (m *ConfigClientMachineAgentControl) String() string { return proto.CompactTextString(m) }
 func (*ConfigClientMachineAgentControl) ProtoMessage()    {}
 func (*ConfigClientMachineAgentControl) Descriptor() ([]byte, []int) {
-	return fileDescriptorConfigClientMachine, []int{3}
+	return fileDescriptorConfigClientMachine, []int{7}
 }
 
 func init() {
 	proto.RegisterType((*ConfigClientMachineInitial)(nil), "dbtesterpb.ConfigClientMachineInitial")
 	proto.RegisterType((*ConfigClientMachineBenchmarkOptions)(nil), "dbtesterpb.ConfigClientMachineBenchmarkOptions")
 	proto.RegisterType((*ConfigClientMachineBenchmarkSteps)(nil), "dbtesterpb.ConfigClientMachineBenchmarkSteps")
+	proto.RegisterType((*ConfigClientMachineFault)(nil), "dbtesterpb.ConfigClientMachineFault")
+	proto.RegisterType((*ConfigClientMachineDiagnostics)(nil), "dbtesterpb.ConfigClientMachineDiagnostics")
+	proto.RegisterType((*ConfigClientMachineResourceLimits)(nil), "dbtesterpb.ConfigClientMachineResourceLimits")
+	proto.RegisterType((*ConfigClientMachineExtraOptions)(nil), "dbtesterpb.ConfigClientMachineExtraOptions")
 	proto.RegisterType((*ConfigClientMachineAgentControl)(nil), "dbtesterpb.ConfigClientMachineAgentControl")
 }
 func (m *ConfigClientMachineInitial) Marshal() (dAtA []byte, err error) {
@@ -188,6 +331,44 @@ func (m *ConfigClientMachineInitial) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ServerDiskSpaceUsageSummaryPath)))
 		i += copy(dAtA[i:], m.ServerDiskSpaceUsageSummaryPath)
 	}
+	if len(m.ClientSessionConsistencyPath) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientSessionConsistencyPath)))
+		i += copy(dAtA[i:], m.ClientSessionConsistencyPath)
+	}
+	if len(m.ClientFaultTimelinePath) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientFaultTimelinePath)))
+		i += copy(dAtA[i:], m.ClientFaultTimelinePath)
+	}
+	if len(m.ClientCrashRecoveryPath) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientCrashRecoveryPath)))
+		i += copy(dAtA[i:], m.ClientCrashRecoveryPath)
+	}
+	if len(m.ClientSnapshotPath) > 0 {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientSnapshotPath)))
+		i += copy(dAtA[i:], m.ClientSnapshotPath)
+	}
+	if len(m.ServerStartupSummaryPath) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ServerStartupSummaryPath)))
+		i += copy(dAtA[i:], m.ServerStartupSummaryPath)
+	}
+	if len(m.ClientLeaderTimelinePath) > 0 {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ClientLeaderTimelinePath)))
+		i += copy(dAtA[i:], m.ClientLeaderTimelinePath)
+	}
 	if len(m.GoogleCloudProjectName) > 0 {
 		dAtA[i] = 0xa2
 		i++
@@ -320,6 +501,16 @@ func (m *ConfigClientMachineBenchmarkOptions) MarshalTo(dAtA []byte) (int, error
 		}
 		i++
 	}
+	if m.CrashOffsetMillisecond != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CrashOffsetMillisecond))
+	}
+	if m.SnapshotMemberIndex != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.SnapshotMemberIndex))
+	}
 	return i, nil
 }
 
@@ -381,6 +572,254 @@ func (m *ConfigClientMachineBenchmarkSteps) MarshalTo(dAtA []byte) (int, error)
 	return i, nil
 }
 
+func (m *ConfigClientMachineFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineFault) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if m.OffsetMillisecond != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.OffsetMillisecond))
+	}
+	if m.MemberIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MemberIndex))
+	}
+	if len(m.TargetMemberIndexes) > 0 {
+		dAtA4 := make([]byte, len(m.TargetMemberIndexes)*10)
+		var j3 int
+		for _, num1 := range m.TargetMemberIndexes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if m.DelayMillisecond != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DelayMillisecond))
+	}
+	if m.JitterMillisecond != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.JitterMillisecond))
+	}
+	if m.LossPercent != 0 {
+		dAtA[i] = 0x39
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LossPercent))))
+		i += 8
+	}
+	if len(m.UpgradeDatabaseID) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.UpgradeDatabaseID)))
+		i += copy(dAtA[i:], m.UpgradeDatabaseID)
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineDiagnostics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineDiagnostics) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OffsetMillisecond != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.OffsetMillisecond))
+	}
+	if len(m.MemberIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.MemberIndexes)*10)
+		var j5 int
+		for _, num1 := range m.MemberIndexes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if m.CPUProfileSeconds != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.CPUProfileSeconds))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineResourceLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineResourceLimits) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CPUs != 0 {
+		dAtA[i] = 0x9
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CPUs))))
+		i += 8
+	}
+	if m.MemoryBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MemoryBytes))
+	}
+	if m.IOWeight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IOWeight))
+	}
+	if m.IOReadBytesPerSecond != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IOReadBytesPerSecond))
+	}
+	if m.IOWriteBytesPerSecond != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IOWriteBytesPerSecond))
+	}
+	if m.IOReadIOPS != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IOReadIOPS))
+	}
+	if m.IOWriteIOPS != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.IOWriteIOPS))
+	}
+	return i, nil
+}
+
+func (m *ConfigClientMachineExtraOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigClientMachineExtraOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MemberIndex != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.MemberIndex))
+	}
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ProxyFlags) > 0 {
+		for _, s := range m.ProxyFlags {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ConfigTemplate) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.ConfigTemplate)))
+		i += copy(dAtA[i:], m.ConfigTemplate)
+	}
+	return i, nil
+}
+
 func (m *ConfigClientMachineAgentControl) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -475,125 +914,116 @@ func (m *ConfigClientMachineAgentControl) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if m.Flag_Etcd_Other != nil {
-		dAtA[i] = 0xa2
+	if m.InitialClusterSize != 0 {
+		dAtA[i] = 0x50
 		i++
-		dAtA[i] = 0x6
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.InitialClusterSize))
+	}
+	if len(m.DatabaseVersion) > 0 {
+		dAtA[i] = 0x5a
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
-		n3, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(len(m.DatabaseVersion)))
+		i += copy(dAtA[i:], m.DatabaseVersion)
 	}
-	if m.Flag_Etcd_Tip != nil {
-		dAtA[i] = 0xaa
+	dAtA[i] = 0xe2
+	i++
+	dAtA[i] = 0x2b
+	i++
+	i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.DatabaseFlags.Size()))
+	n7, err := m.DatabaseFlags.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.ConfigClientMachineBenchmarkOptions != nil {
+		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x3e
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n4, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
+		n8, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n8
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		dAtA[i] = 0xb2
+	if m.ConfigClientMachineBenchmarkSteps != nil {
+		dAtA[i] = 0xca
 		i++
-		dAtA[i] = 0x6
+		dAtA[i] = 0x3e
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n5, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
+		n9, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n9
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n6, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Faults) > 0 {
+		for _, msg := range m.Faults {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x3e
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n6
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0xc
-		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n7, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Diagnostics) > 0 {
+		for _, msg := range m.Diagnostics {
+			dAtA[i] = 0xda
+			i++
+			dAtA[i] = 0x3e
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n7
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
+	if m.ResourceLimits != nil {
 		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3e
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n8, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ResourceLimits.Size()))
+		n10, err := m.ResourceLimits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		dAtA[i] = 0x82
+	if m.ExtraOptions != nil {
+		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x19
+		dAtA[i] = 0x3e
 		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n9, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	if m.Flag_Zetcd_Beta != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1f
-		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n10, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	if m.ConfigClientMachineBenchmarkOptions != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x3e
-		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkOptions.Size()))
-		n11, err := m.ConfigClientMachineBenchmarkOptions.MarshalTo(dAtA[i:])
+		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ExtraOptions.Size()))
+		n11, err := m.ExtraOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n11
 	}
-	if m.ConfigClientMachineBenchmarkSteps != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x3e
-		i++
-		i = encodeVarintConfigClientMachine(dAtA, i, uint64(m.ConfigClientMachineBenchmarkSteps.Size()))
-		n12, err := m.ConfigClientMachineBenchmarkSteps.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.MemberExtraOptions) > 0 {
+		for _, msg := range m.MemberExtraOptions {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x3e
+			i++
+			i = encodeVarintConfigClientMachine(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n12
 	}
 	return i, nil
 }
@@ -650,6 +1080,30 @@ func (m *ConfigClientMachineInitial) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovConfigClientMachine(uint64(l))
 	}
+	l = len(m.ClientSessionConsistencyPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientFaultTimelinePath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientCrashRecoveryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientSnapshotPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ServerStartupSummaryPath)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	l = len(m.ClientLeaderTimelinePath)
+	if l > 0 {
+		n += 2 + l + sovConfigClientMachine(uint64(l))
+	}
 	l = len(m.GoogleCloudProjectName)
 	if l > 0 {
 		n += 2 + l + sovConfigClientMachine(uint64(l))
@@ -711,6 +1165,12 @@ func (m *ConfigClientMachineBenchmarkOptions) Size() (n int) {
 	if m.StaleRead {
 		n += 2
 	}
+	if m.CrashOffsetMillisecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CrashOffsetMillisecond))
+	}
+	if m.SnapshotMemberIndex != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.SnapshotMemberIndex))
+	}
 	return n
 }
 
@@ -732,6 +1192,119 @@ func (m *ConfigClientMachineBenchmarkSteps) Size() (n int) {
 	return n
 }
 
+func (m *ConfigClientMachineFault) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	if m.OffsetMillisecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.OffsetMillisecond))
+	}
+	if m.MemberIndex != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MemberIndex))
+	}
+	if len(m.TargetMemberIndexes) > 0 {
+		l = 0
+		for _, e := range m.TargetMemberIndexes {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.DelayMillisecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.DelayMillisecond))
+	}
+	if m.JitterMillisecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.JitterMillisecond))
+	}
+	if m.LossPercent != 0 {
+		n += 9
+	}
+	l = len(m.UpgradeDatabaseID)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineDiagnostics) Size() (n int) {
+	var l int
+	_ = l
+	if m.OffsetMillisecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.OffsetMillisecond))
+	}
+	if len(m.MemberIndexes) > 0 {
+		l = 0
+		for _, e := range m.MemberIndexes {
+			l += sovConfigClientMachine(uint64(e))
+		}
+		n += 1 + sovConfigClientMachine(uint64(l)) + l
+	}
+	if m.CPUProfileSeconds != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.CPUProfileSeconds))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineResourceLimits) Size() (n int) {
+	var l int
+	_ = l
+	if m.CPUs != 0 {
+		n += 9
+	}
+	if m.MemoryBytes != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MemoryBytes))
+	}
+	if m.IOWeight != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IOWeight))
+	}
+	if m.IOReadBytesPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IOReadBytesPerSecond))
+	}
+	if m.IOWriteBytesPerSecond != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IOWriteBytesPerSecond))
+	}
+	if m.IOReadIOPS != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IOReadIOPS))
+	}
+	if m.IOWriteIOPS != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.IOWriteIOPS))
+	}
+	return n
+}
+
+func (m *ConfigClientMachineExtraOptions) Size() (n int) {
+	var l int
+	_ = l
+	if m.MemberIndex != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.MemberIndex))
+	}
+	if len(m.Flags) > 0 {
+		for _, s := range m.Flags {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.ProxyFlags) > 0 {
+		for _, s := range m.ProxyFlags {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for _, s := range m.Env {
+			l = len(s)
+			n += 1 + l + sovConfigClientMachine(uint64(l))
+		}
+	}
+	l = len(m.ConfigTemplate)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
+	}
+	return n
+}
+
 func (m *ConfigClientMachineAgentControl) Size() (n int) {
 	var l int
 	_ = l
@@ -775,45 +1348,48 @@ func (m *ConfigClientMachineAgentControl) Size() (n int) {
 			n += 1 + l + sovConfigClientMachine(uint64(l))
 		}
 	}
-	if m.Flag_Etcd_Other != nil {
-		l = m.Flag_Etcd_Other.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
-	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.InitialClusterSize != 0 {
+		n += 1 + sovConfigClientMachine(uint64(m.InitialClusterSize))
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	l = len(m.DatabaseVersion)
+	if l > 0 {
+		n += 1 + l + sovConfigClientMachine(uint64(l))
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		l = m.Flag_Etcd_V3_3.Size()
+	l = m.DatabaseFlags.Size()
+	n += 2 + l + sovConfigClientMachine(uint64(l))
+	if m.ConfigClientMachineBenchmarkOptions != nil {
+		l = m.ConfigClientMachineBenchmarkOptions.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
+	if m.ConfigClientMachineBenchmarkSteps != nil {
+		l = m.ConfigClientMachineBenchmarkSteps.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		l = m.Flag_Consul_V1_0_2.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.Diagnostics) > 0 {
+		for _, e := range m.Diagnostics {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
 	}
-	if m.ConfigClientMachineBenchmarkOptions != nil {
-		l = m.ConfigClientMachineBenchmarkOptions.Size()
+	if m.ExtraOptions != nil {
+		l = m.ExtraOptions.Size()
 		n += 2 + l + sovConfigClientMachine(uint64(l))
 	}
-	if m.ConfigClientMachineBenchmarkSteps != nil {
-		l = m.ConfigClientMachineBenchmarkSteps.Size()
-		n += 2 + l + sovConfigClientMachine(uint64(l))
+	if len(m.MemberExtraOptions) > 0 {
+		for _, e := range m.MemberExtraOptions {
+			l = e.Size()
+			n += 2 + l + sovConfigClientMachine(uint64(l))
+		}
 	}
 	return n
 }
@@ -1150,9 +1726,9 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			}
 			m.ServerDiskSpaceUsageSummaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 100:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudProjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSessionConsistencyPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1177,11 +1753,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
+			m.ClientSessionConsistencyPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 101:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFaultTimelinePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1206,11 +1782,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
+			m.ClientFaultTimelinePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCrashRecoveryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1235,11 +1811,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
+			m.ClientCrashRecoveryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 103:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSnapshotPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1264,11 +1840,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			m.ClientSnapshotPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 104:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerStartupSummaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1293,61 +1869,11 @@ func (m *ConfigClientMachineInitial) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
+			m.ServerStartupSummaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowConfigClientMachine
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLeaderTimelinePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1372,13 +1898,13 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.ClientLeaderTimelinePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudProjectName", wireType)
 			}
-			m.RequestNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1388,16 +1914,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
 			}
-			m.ConnectionNumber = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKeyPath", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1407,16 +1943,26 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
 			}
-			m.ClientNumber = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKeyPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1426,12 +1972,869 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageBucketName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageBucketName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoogleCloudStorageSubDirectory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GoogleCloudStorageSubDirectory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestNumber", wireType)
+			}
+			m.RequestNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionNumber", wireType)
+			}
+			m.ConnectionNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectionNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientNumber", wireType)
+			}
+			m.ClientNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClientNumber |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+			}
+			m.RateLimitRequestsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameKey = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+			}
+			m.KeySizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+			}
+			m.ValueSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StaleRead = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashOffsetMillisecond", wireType)
+			}
+			m.CrashOffsetMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashOffsetMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotMemberIndex", wireType)
+			}
+			m.SnapshotMemberIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotMemberIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step1StartDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step1StartDatabase = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step2StressDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step2StressDatabase = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step3StopDatabase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step3StopDatabase = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step4UploadLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Step4UploadLogs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineFault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineFault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineFault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetMillisecond", wireType)
+			}
+			m.OffsetMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIndex", wireType)
+			}
+			m.MemberIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberIndex |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetMemberIndexes = append(m.TargetMemberIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowConfigClientMachine
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthConfigClientMachine
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowConfigClientMachine
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetMemberIndexes = append(m.TargetMemberIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetMemberIndexes", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMillisecond", wireType)
+			}
+			m.DelayMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterMillisecond", wireType)
+			}
+			m.JitterMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JitterMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LossPercent = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeDatabaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeDatabaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineDiagnostics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineDiagnostics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineDiagnostics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetMillisecond", wireType)
+			}
+			m.OffsetMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType == 0 {
 				var v int64
 				for shift := uint(0); ; shift += 7 {
@@ -1448,7 +2851,7 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+				m.MemberIndexes = append(m.MemberIndexes, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1488,16 +2891,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.ConnectionClientNumbers = append(m.ConnectionClientNumbers, v)
+					m.MemberIndexes = append(m.MemberIndexes, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionClientNumbers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIndexes", wireType)
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitRequestsPerSecond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUProfileSeconds", wireType)
 			}
-			m.RateLimitRequestsPerSecond = 0
+			m.CPUProfileSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1507,16 +2910,77 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateLimitRequestsPerSecond |= (int64(b) & 0x7F) << shift
+				m.CPUProfileSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigClientMachineResourceLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfigClientMachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigClientMachineResourceLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigClientMachineResourceLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUs", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUs = float64(math.Float64frombits(v))
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SameKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryBytes", wireType)
 			}
-			var v int
+			m.MemoryBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1526,17 +2990,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MemoryBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SameKey = bool(v != 0)
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeySizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IOWeight", wireType)
 			}
-			m.KeySizeBytes = 0
+			m.IOWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1546,16 +3009,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeySizeBytes |= (int64(b) & 0x7F) << shift
+				m.IOWeight |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueSizeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IOReadBytesPerSecond", wireType)
 			}
-			m.ValueSizeBytes = 0
+			m.IOReadBytesPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1565,16 +3028,16 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueSizeBytes |= (int64(b) & 0x7F) << shift
+				m.IOReadBytesPerSecond |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IOWriteBytesPerSecond", wireType)
 			}
-			var v int
+			m.IOWriteBytesPerSecond = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1584,12 +3047,49 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.IOWriteBytesPerSecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IOReadIOPS", wireType)
+			}
+			m.IOReadIOPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IOReadIOPS |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IOWriteIOPS", wireType)
+			}
+			m.IOWriteIOPS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IOWriteIOPS |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StaleRead = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -1611,7 +3111,7 @@ func (m *ConfigClientMachineBenchmarkOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
+func (m *ConfigClientMachineExtraOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1634,17 +3134,17 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigClientMachineExtraOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigClientMachineBenchmarkSteps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigClientMachineExtraOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step1StartDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberIndex", wireType)
 			}
-			var v int
+			m.MemberIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1654,17 +3154,16 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MemberIndex |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step1StartDatabase = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step2StressDatabase", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flags", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1674,17 +3173,26 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step2StressDatabase = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flags = append(m.Flags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step3StopDatabase", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyFlags", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1694,17 +3202,26 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step3StopDatabase = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyFlags = append(m.ProxyFlags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step4UploadLogs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -1714,12 +3231,50 @@ func (m *ConfigClientMachineBenchmarkSteps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Step4UploadLogs = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfigClientMachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfigClientMachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfigClientMachine(dAtA[iNdEx:])
@@ -2011,11 +3566,11 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			}
 			m.DatabaseEndpoints = append(m.DatabaseEndpoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 100:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Other", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClusterSize", wireType)
 			}
-			var msglen int
+			m.InitialClusterSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2025,30 +3580,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.InitialClusterSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfigClientMachine
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_Other == nil {
-				m.Flag_Etcd_Other = &Flag_Etcd_Other{}
-			}
-			if err := m.Flag_Etcd_Other.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 101:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfigClientMachine
@@ -2058,28 +3599,24 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConfigClientMachine
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_Tip == nil {
-				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
-			}
-			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DatabaseVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 700:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2103,16 +3640,13 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_2 == nil {
-				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
-			}
-			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatabaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 103:
+		case 1000:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2136,16 +3670,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_V3_3 == nil {
-				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
+			if m.ConfigClientMachineBenchmarkOptions == nil {
+				m.ConfigClientMachineBenchmarkOptions = &ConfigClientMachineBenchmarkOptions{}
 			}
-			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigClientMachineBenchmarkOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 200:
+		case 1001:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkSteps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2169,16 +3703,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zookeeper_R3_5_3Beta == nil {
-				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			if m.ConfigClientMachineBenchmarkSteps == nil {
+				m.ConfigClientMachineBenchmarkSteps = &ConfigClientMachineBenchmarkSteps{}
 			}
-			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigClientMachineBenchmarkSteps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 300:
+		case 1002:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2202,16 +3736,14 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Consul_V1_0_2 == nil {
-				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
-			}
-			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Faults = append(m.Faults, &ConfigClientMachineFault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 400:
+		case 1003:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnostics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2235,16 +3767,14 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Cetcd_Beta == nil {
-				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
-			}
-			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Diagnostics = append(m.Diagnostics, &ConfigClientMachineDiagnostics{})
+			if err := m.Diagnostics[len(m.Diagnostics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 500:
+		case 1004:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2268,16 +3798,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zetcd_Beta == nil {
-				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ConfigClientMachineResourceLimits{}
 			}
-			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 1000:
+		case 1005:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2301,16 +3831,16 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigClientMachineBenchmarkOptions == nil {
-				m.ConfigClientMachineBenchmarkOptions = &ConfigClientMachineBenchmarkOptions{}
+			if m.ExtraOptions == nil {
+				m.ExtraOptions = &ConfigClientMachineExtraOptions{}
 			}
-			if err := m.ConfigClientMachineBenchmarkOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtraOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 1001:
+		case 1006:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigClientMachineBenchmarkSteps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberExtraOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2334,10 +3864,8 @@ func (m *ConfigClientMachineAgentControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigClientMachineBenchmarkSteps == nil {
-				m.ConfigClientMachineBenchmarkSteps = &ConfigClientMachineBenchmarkSteps{}
-			}
-			if err := m.ConfigClientMachineBenchmarkSteps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MemberExtraOptions = append(m.MemberExtraOptions, &ConfigClientMachineExtraOptions{})
+			if err := m.MemberExtraOptions[len(m.MemberExtraOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2472,112 +4000,163 @@ func init() {
 }
 
 var fileDescriptorConfigClientMachine = []byte{
-	// 1711 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcd, 0x6e, 0xdb, 0xc8,
-	0x1d, 0x5f, 0x45, 0xc9, 0xc6, 0x1e, 0xe7, 0x73, 0x12, 0x27, 0x8a, 0xe3, 0x78, 0x1c, 0x26, 0xe9,
-	0x7a, 0xb1, 0x8d, 0x9d, 0x48, 0xd9, 0x05, 0x5a, 0xb4, 0x68, 0x57, 0xf6, 0xb6, 0x0d, 0xe2, 0xdd,
-	0xa8, 0x94, 0x37, 0x45, 0x83, 0xa2, 0xd3, 0x11, 0x35, 0xa6, 0xb8, 0xa6, 0x38, 0x2c, 0x67, 0x18,
-	0x54, 0xee, 0xad, 0x28, 0x50, 0xb4, 0xa7, 0x3d, 0xee, 0xb1, 0x0f, 0xd0, 0xe7, 0x28, 0x72, 0xec,
-	0x13, 0x10, 0x6d, 0xf6, 0xd2, 0x5e, 0x89, 0x3e, 0xc0, 0x62, 0xfe, 0x24, 0xa5, 0xa1, 0x44, 0xc9,
-	0xba, 0x89, 0xf3, 0xff, 0x7d, 0xcd, 0x70, 0xbe, 0x28, 0xf4, 0xbd, 0x7e, 0x4f, 0x71, 0xa9, 0x78,
-	0x14, 0xf6, 0xf6, 0x1c, 0x11, 0x1c, 0x7b, 0x2e, 0x75, 0x7c, 0x8f, 0x07, 0x8a, 0x0e, 0x99, 0x33,
-	0xf0, 0x02, 0xbe, 0x1b, 0x46, 0x42, 0x09, 0x8c, 0x26, 0xb8, 0x8d, 0xc7, 0xae, 0xa7, 0x06, 0x71,
-	0x6f, 0xd7, 0x11, 0xc3, 0x3d, 0x57, 0xb8, 0x62, 0x0f, 0x20, 0xbd, 0xf8, 0x18, 0x9e, 0xe0, 0x01,
-	0x7e, 0x65, 0xd4, 0x8d, 0x0d, 0xc3, 0xe2, 0xd8, 0x67, 0x2e, 0xe5, 0xca, 0xe9, 0xe7, 0x35, 0x32,
-	0x5d, 0x3b, 0x15, 0xe2, 0x84, 0xf3, 0x90, 0x47, 0x39, 0x60, 0x73, 0x1a, 0xe0, 0x88, 0x40, 0xc6,
-	0x7e, 0x5e, 0xbd, 0x3b, 0x43, 0x37, 0xb4, 0x67, 0x8a, 0xce, 0xa4, 0x68, 0x7d, 0x7b, 0x09, 0x6d,
-	0xec, 0x43, 0x7f, 0xf7, 0xa1, 0xbb, 0x9f, 0x67, 0xbd, 0x7d, 0x1e, 0x78, 0xca, 0x63, 0x3e, 0xfe,
-	0x04, 0xa1, 0x0e, 0x53, 0x83, 0x4e, 0xc4, 0x8f, 0xbd, 0x3f, 0x34, 0x6a, 0xdb, 0xb5, 0x9d, 0xd5,
-	0xf6, 0xad, 0x34, 0x21, 0x78, 0xc4, 0x86, 0xfe, 0x0f, 0xad, 0x90, 0xa9, 0x01, 0x0d, 0xa1, 0x68,
-	0xd9, 0x06, 0x12, 0x3f, 0x46, 0x17, 0x0f, 0x85, 0xab, 0x1b, 0x1a, 0xe7, 0x80, 0x74, 0x23, 0x4d,
-	0xc8, 0xd5, 0x8c, 0xe4, 0x0b, 0x97, 0x6a, 0xa2, 0x65, 0x17, 0x18, 0x4c, 0xd1, 0xed, 0xcc, 0xbe,
-	0x3b, 0x92, 0x8a, 0x0f, 0x3f, 0xe7, 0x2a, 0xf2, 0x1c, 0x09, 0xf4, 0x3a, 0xd0, 0x1f, 0xa5, 0x09,
-	0xb9, 0x9f, 0xd1, 0xf3, 0xd7, 0x22, 0x01, 0x49, 0x87, 0x19, 0x34, 0x17, 0x9c, 0xa7, 0x82, 0xff,
-	0x5c, 0x43, 0x0f, 0x2a, 0x6a, 0xcf, 0x03, 0x3d, 0x2c, 0xc2, 0x67, 0x8a, 0xf7, 0xc1, 0xed, 0x3c,
-	0xb8, 0x35, 0xd3, 0x84, 0xec, 0x2e, 0x72, 0xf3, 0x0c, 0x5e, 0x6e, 0xbd, 0x8c, 0x3c, 0xfe, 0x5b,
-	0x0d, 0x3d, 0xca, 0x70, 0x87, 0x4c, 0xf1, 0xc0, 0x19, 0x1d, 0x0d, 0x22, 0x11, 0xbb, 0x83, 0x30,
-	0x56, 0x47, 0xde, 0x90, 0x4b, 0x1e, 0x79, 0x3c, 0xeb, 0xf6, 0x05, 0x08, 0xf2, 0x2c, 0x4d, 0xc8,
-	0x93, 0x52, 0x10, 0x3f, 0xe3, 0x51, 0x35, 0x26, 0x52, 0x35, 0x66, 0xe6, 0x51, 0x96, 0xb3, 0xc0,
-	0x7f, 0x44, 0xdb, 0x25, 0xe0, 0x81, 0x27, 0x55, 0xe4, 0xf5, 0x62, 0xe5, 0x89, 0xe0, 0x53, 0xdf,
-	0x87, 0x18, 0xef, 0x43, 0x8c, 0xbd, 0x34, 0x21, 0x1f, 0x55, 0xc6, 0xe8, 0x1b, 0x1c, 0xca, 0x7c,
-	0x3f, 0x4f, 0x70, 0xa6, 0x30, 0xfe, 0xba, 0x86, 0x3e, 0x98, 0x0b, 0xea, 0xf0, 0xc8, 0xe1, 0x81,
-	0xf2, 0x7c, 0x0e, 0x21, 0x2e, 0x42, 0x88, 0x4f, 0xd2, 0x84, 0x34, 0xcf, 0x0e, 0x11, 0x8e, 0xb9,
-	0x79, 0x96, 0x65, 0x6d, 0xf0, 0x5f, 0x6a, 0xe8, 0xe1, 0x5c, 0x6c, 0x37, 0x1e, 0x0e, 0x59, 0x34,
-	0x82, 0x3c, 0x2b, 0x90, 0xa7, 0x95, 0x26, 0x64, 0xef, 0xec, 0x3c, 0x32, 0x23, 0xe6, 0x61, 0x96,
-	0x32, 0xc0, 0x21, 0xda, 0x2c, 0xe1, 0xda, 0xa3, 0x17, 0x7c, 0xf4, 0x45, 0x3c, 0xec, 0xf1, 0x08,
-	0x02, 0xac, 0x42, 0x80, 0xef, 0xa7, 0x09, 0xd9, 0xa9, 0x0c, 0xd0, 0x1b, 0xd1, 0x13, 0x3e, 0xa2,
-	0x01, 0x30, 0x72, 0xe7, 0x85, 0x8a, 0x78, 0x84, 0x48, 0x97, 0x47, 0x6f, 0x78, 0x74, 0xe0, 0xc9,
-	0x93, 0x6e, 0xc8, 0x1c, 0xfe, 0xa5, 0x64, 0x2e, 0x37, 0x7b, 0x8d, 0xa6, 0xa7, 0x82, 0x04, 0x82,
-	0xee, 0xed, 0x09, 0x95, 0x9a, 0x42, 0x63, 0xcd, 0x99, 0xea, 0xf1, 0x59, 0xba, 0xf8, 0x37, 0xe8,
-	0xd6, 0xcf, 0x85, 0x70, 0x7d, 0xbe, 0xef, 0x8b, 0xb8, 0xdf, 0x89, 0xc4, 0x57, 0xdc, 0x51, 0x5f,
-	0xb0, 0x21, 0x6f, 0xf4, 0xc1, 0xf1, 0x61, 0x9a, 0x90, 0xed, 0xcc, 0xd1, 0x05, 0x1c, 0x75, 0x34,
-	0x90, 0x86, 0x19, 0x92, 0x06, 0x6c, 0xc8, 0x2d, 0x7b, 0x8e, 0x06, 0x3e, 0x46, 0x77, 0x8c, 0x4a,
-	0x57, 0x89, 0x88, 0xb9, 0xfc, 0x05, 0xcf, 0xba, 0xc4, 0xc1, 0x60, 0x27, 0x4d, 0xc8, 0xc3, 0x0a,
-	0x03, 0x99, 0x81, 0x61, 0x28, 0xb3, 0xbe, 0xcc, 0x97, 0xc2, 0xcf, 0xd0, 0x7a, 0x65, 0xb1, 0x71,
-	0xac, 0x3d, 0xec, 0xea, 0x22, 0x16, 0x68, 0x73, 0xb6, 0xd0, 0x8e, 0x9d, 0x13, 0x9e, 0x8d, 0x80,
-	0x0b, 0x01, 0x3f, 0x4a, 0x13, 0xf2, 0xc1, 0x82, 0x80, 0x3d, 0x20, 0xe4, 0x03, 0xb1, 0x50, 0x10,
-	0xc7, 0x68, 0x6b, 0xb6, 0xde, 0x8d, 0x7b, 0x07, 0x5e, 0xc4, 0x1d, 0x25, 0xa2, 0x51, 0x63, 0x00,
-	0x96, 0x8f, 0xd3, 0x84, 0x7c, 0xb8, 0xc0, 0x52, 0xc6, 0x3d, 0xda, 0x2f, 0x38, 0x96, 0x7d, 0x86,
-	0xa8, 0xf5, 0xcf, 0x0b, 0xe8, 0x41, 0xc5, 0x29, 0xd3, 0xe6, 0x81, 0x33, 0x18, 0xb2, 0xe8, 0xe4,
-	0x65, 0xa8, 0x97, 0x80, 0xc4, 0x0f, 0xd0, 0xf9, 0xa3, 0x51, 0xc8, 0xf3, 0x83, 0xe6, 0x6a, 0x9a,
-	0x90, 0xb5, 0x2c, 0x84, 0x1a, 0x85, 0xdc, 0xb2, 0xa1, 0x88, 0x7f, 0x82, 0x2e, 0xdb, 0xfc, 0xf7,
-	0x31, 0x97, 0x2a, 0x9b, 0xc0, 0x70, 0xc2, 0xd4, 0xdb, 0x77, 0xd2, 0x84, 0xac, 0x67, 0xe8, 0x28,
-	0x2b, 0xe7, 0x0b, 0xc0, 0xb2, 0xcb, 0x78, 0xfc, 0x0b, 0x74, 0x6d, 0x5f, 0x04, 0x01, 0x77, 0xb4,
-	0x69, 0xae, 0x51, 0x07, 0x8d, 0xcd, 0x34, 0x21, 0x8d, 0x7c, 0x49, 0x8d, 0x11, 0x63, 0x99, 0x19,
-	0x16, 0xfe, 0x11, 0xba, 0x94, 0x75, 0x28, 0x57, 0x39, 0x0f, 0x2a, 0x8d, 0x34, 0x21, 0x37, 0x4b,
-	0x0b, 0xb3, 0x50, 0x28, 0xa1, 0xf1, 0x6f, 0xd1, 0xed, 0x89, 0xa2, 0x59, 0x91, 0x8d, 0x0b, 0xdb,
-	0xf5, 0x9d, 0xba, 0x39, 0xf5, 0x8d, 0x38, 0x25, 0x4d, 0xa9, 0x0f, 0xbd, 0x6a, 0x11, 0xec, 0xa1,
-	0x0d, 0x9b, 0x29, 0x7e, 0xe8, 0x0d, 0x3d, 0x95, 0x8f, 0x80, 0xec, 0xf0, 0xa8, 0xcb, 0x1d, 0x11,
-	0xf4, 0x61, 0x6b, 0xaf, 0xb7, 0x3f, 0x4c, 0x13, 0xf2, 0x28, 0x1f, 0x35, 0xa6, 0x38, 0xf5, 0x35,
-	0x98, 0xe6, 0x03, 0x28, 0xf5, 0x6e, 0x4a, 0x25, 0xe0, 0x2d, 0x7b, 0x81, 0x98, 0x3e, 0xef, 0xbb,
-	0x6c, 0x08, 0x13, 0x5e, 0xef, 0xd6, 0x2b, 0xe6, 0x79, 0x2f, 0xd9, 0x10, 0x16, 0x91, 0x65, 0x17,
-	0x18, 0xfc, 0x63, 0x74, 0xe9, 0x05, 0x1f, 0x75, 0xbd, 0x53, 0xde, 0x1e, 0x29, 0x2e, 0x61, 0x47,
-	0x2d, 0xbd, 0x41, 0xbd, 0xe6, 0xa4, 0x77, 0xca, 0x69, 0x4f, 0xd7, 0x2d, 0xbb, 0x04, 0xc7, 0xfb,
-	0xe8, 0xca, 0x2b, 0xe6, 0xc7, 0x7c, 0x22, 0xb0, 0x0a, 0x02, 0x77, 0xd3, 0x84, 0xdc, 0xce, 0x04,
-	0xde, 0xe8, 0x7a, 0x49, 0x62, 0x8a, 0x82, 0x5b, 0x68, 0xb5, 0xab, 0x98, 0xcf, 0x6d, 0xce, 0xfa,
-	0xb0, 0xb9, 0xad, 0xb4, 0xd7, 0xd3, 0x84, 0x5c, 0xcf, 0x43, 0xeb, 0x12, 0x8d, 0x38, 0xeb, 0x5b,
-	0xf6, 0x04, 0x67, 0x25, 0xe7, 0xd0, 0xfd, 0x45, 0x13, 0xb9, 0xab, 0x78, 0x28, 0xf1, 0x4b, 0x84,
-	0xf5, 0x8f, 0xa7, 0x5d, 0xc5, 0x22, 0x75, 0xc0, 0x14, 0xeb, 0x31, 0x99, 0x4d, 0xea, 0x95, 0x36,
-	0x49, 0x13, 0x72, 0xb7, 0xf0, 0xe0, 0xe1, 0x53, 0x2a, 0x35, 0x88, 0xf6, 0x73, 0x94, 0x65, 0x57,
-	0x50, 0xb1, 0x8d, 0x6e, 0xe8, 0xd6, 0x66, 0x57, 0x45, 0x5c, 0xca, 0xb1, 0xe2, 0x39, 0x50, 0xdc,
-	0x4e, 0x13, 0xb2, 0x39, 0x51, 0x6c, 0x52, 0x09, 0x28, 0x43, 0xb2, 0x8a, 0x8c, 0x0f, 0xd1, 0x75,
-	0xdd, 0xdc, 0xea, 0x2a, 0x11, 0x8e, 0x15, 0xeb, 0xa0, 0xb8, 0x95, 0x26, 0x64, 0x63, 0xa2, 0xd8,
-	0xd2, 0xcb, 0x3e, 0x34, 0xf4, 0x66, 0x89, 0xf8, 0x67, 0xe8, 0xaa, 0x6e, 0x7c, 0xf6, 0x65, 0xe8,
-	0x0b, 0xd6, 0x3f, 0x14, 0xae, 0x84, 0xc5, 0xb0, 0x62, 0x2e, 0x29, 0xad, 0xf5, 0x8c, 0xc6, 0x80,
-	0xa0, 0xbe, 0x70, 0xa5, 0x65, 0x4f, 0x93, 0xac, 0x3f, 0x5d, 0x41, 0xa4, 0x62, 0x80, 0x3f, 0x75,
-	0x79, 0xa0, 0xf6, 0x45, 0xa0, 0x22, 0x01, 0x97, 0xd2, 0xc2, 0xf7, 0xf9, 0xc1, 0xec, 0xa5, 0xb4,
-	0xc8, 0x49, 0xbd, 0xbe, 0x65, 0x1b, 0x48, 0xfc, 0x4b, 0x74, 0xa3, 0x78, 0x3a, 0xe0, 0xd2, 0x89,
-	0x3c, 0xd8, 0x75, 0xf2, 0x0b, 0xaa, 0xf1, 0x5e, 0xc6, 0x02, 0xfd, 0x09, 0xca, 0xb2, 0xab, 0xb8,
-	0xf8, 0x07, 0x68, 0xad, 0x68, 0x3e, 0x62, 0x6e, 0x7e, 0x59, 0xbd, 0x9d, 0x26, 0xe4, 0xc6, 0x94,
-	0x94, 0x62, 0xae, 0x65, 0x9b, 0x58, 0xbd, 0x64, 0x3a, 0x9c, 0x47, 0xcf, 0x3b, 0x7a, 0xa4, 0xea,
-	0xe5, 0x2b, 0x72, 0xc8, 0x79, 0x44, 0xbd, 0x50, 0x5a, 0x76, 0x81, 0xc1, 0x3f, 0x45, 0x97, 0xf3,
-	0x9f, 0x5d, 0x15, 0x79, 0x81, 0x9b, 0xdf, 0x10, 0x37, 0xd2, 0x84, 0xdc, 0x2a, 0x93, 0xf4, 0xfb,
-	0xf7, 0x02, 0xd7, 0xb2, 0xcb, 0x04, 0xdc, 0x41, 0x18, 0x86, 0xb1, 0x23, 0x22, 0x75, 0x24, 0xf2,
-	0x4d, 0x23, 0xdf, 0x06, 0x8c, 0x39, 0xc4, 0x34, 0x86, 0x86, 0x22, 0x52, 0x54, 0x09, 0x9a, 0xef,
-	0x3b, 0x96, 0x5d, 0xc1, 0xc5, 0x6d, 0x74, 0x05, 0x5a, 0x3f, 0x0b, 0xfa, 0xa1, 0xf0, 0x02, 0x25,
-	0x1b, 0x17, 0xa1, 0x27, 0x46, 0xa8, 0x4c, 0x8d, 0x17, 0x00, 0xcb, 0x9e, 0x62, 0xe0, 0x5f, 0xa3,
-	0xf5, 0x62, 0x54, 0xca, 0xc1, 0xb2, 0x3d, 0xe1, 0x41, 0x9a, 0x10, 0x32, 0x35, 0x96, 0x33, 0xd9,
-	0xaa, 0x15, 0xf0, 0x0b, 0x74, 0xbd, 0x28, 0x4c, 0x12, 0xae, 0x42, 0xc2, 0x7b, 0x69, 0x42, 0xee,
-	0x4c, 0xc9, 0x1a, 0x21, 0x67, 0x79, 0x98, 0xa2, 0xeb, 0xf0, 0xf1, 0x04, 0x5f, 0x6d, 0x94, 0x0a,
-	0x35, 0xe0, 0x11, 0xdc, 0x50, 0xd6, 0x9a, 0xf7, 0x76, 0x27, 0x5f, 0x58, 0xbb, 0x33, 0x20, 0x73,
-	0x6a, 0x1a, 0xcd, 0x96, 0x7d, 0x59, 0x43, 0x3f, 0x53, 0x4e, 0xff, 0xa5, 0x7e, 0xc6, 0xbf, 0x42,
-	0x57, 0x4d, 0xae, 0xf2, 0x42, 0xb8, 0x9f, 0xac, 0x35, 0xef, 0xce, 0x93, 0x57, 0x5e, 0xd8, 0xbe,
-	0x99, 0x26, 0xe4, 0x9a, 0x29, 0xae, 0xbc, 0xd0, 0xb2, 0xd7, 0x0a, 0xe9, 0x23, 0x2f, 0xc4, 0xaf,
-	0xd1, 0x35, 0x93, 0xf5, 0xa6, 0x45, 0x9b, 0x70, 0x2b, 0x59, 0x6b, 0x6e, 0xce, 0x53, 0xd6, 0x18,
-	0x73, 0x37, 0x9c, 0xb4, 0x1a, 0xda, 0xaf, 0x5a, 0xcd, 0x0a, 0xed, 0x16, 0x5c, 0x5a, 0x16, 0x6b,
-	0xb7, 0x2a, 0xb5, 0x5b, 0x25, 0xed, 0x16, 0xfe, 0x6b, 0x0d, 0x6d, 0x66, 0xc4, 0xf1, 0xc7, 0x30,
-	0xa5, 0x51, 0x8b, 0x7e, 0x4c, 0x5b, 0xb4, 0xc7, 0x15, 0x6b, 0xbc, 0xad, 0x81, 0xd3, 0xce, 0xac,
-	0x53, 0x35, 0xa1, 0x7d, 0x3f, 0x4d, 0xc8, 0xbd, 0xcc, 0xb5, 0x1a, 0x61, 0xd9, 0xeb, 0x5a, 0xe0,
-	0x75, 0x51, 0xb4, 0x5b, 0x1f, 0xb7, 0xda, 0x5c, 0x31, 0xfc, 0x15, 0xba, 0x99, 0x29, 0x67, 0x9f,
-	0xdd, 0x94, 0xbe, 0x79, 0x4a, 0x9f, 0xd0, 0x66, 0xe3, 0x1f, 0xe7, 0x20, 0xc2, 0xf6, 0x6c, 0x84,
-	0x32, 0xd0, 0x3c, 0xdb, 0xca, 0x15, 0xcb, 0xbe, 0xa2, 0x09, 0xfb, 0xd0, 0xf8, 0xea, 0xe9, 0x93,
-	0x26, 0xfe, 0x5d, 0x31, 0xd3, 0x9c, 0x6c, 0x68, 0xa0, 0xaf, 0x5f, 0xd7, 0xe7, 0x4d, 0x35, 0x03,
-	0x65, 0x4e, 0x35, 0xa3, 0x39, 0x9f, 0x6a, 0xfb, 0xba, 0x05, 0x7a, 0x33, 0x76, 0x38, 0x35, 0x1c,
-	0xfe, 0x3f, 0xd7, 0xe1, 0xb4, 0xda, 0xe1, 0x74, 0xc6, 0xe1, 0xf5, 0xd8, 0xe1, 0xef, 0xb5, 0xa5,
-	0x2e, 0x7c, 0x8d, 0xff, 0x5e, 0x04, 0xd3, 0x3d, 0xd3, 0x74, 0x09, 0x9e, 0x79, 0xaa, 0xf4, 0x8a,
-	0x1a, 0x15, 0x59, 0x51, 0x7f, 0x8b, 0x2f, 0x71, 0xd7, 0xfc, 0xa6, 0xb6, 0xc4, 0x51, 0xde, 0xf8,
-	0x5f, 0x16, 0xf0, 0xf1, 0xb2, 0x01, 0x81, 0x65, 0x6e, 0x80, 0x93, 0x78, 0xfa, 0xf8, 0x93, 0x96,
-	0x7d, 0xb6, 0x69, 0xfb, 0xe6, 0xdb, 0xff, 0x6c, 0xbd, 0xf7, 0xf6, 0xdd, 0x56, 0xed, 0x5f, 0xef,
-	0xb6, 0x6a, 0xff, 0x7e, 0xb7, 0x55, 0xfb, 0xe6, 0xdb, 0xad, 0xf7, 0x7a, 0xef, 0xc3, 0x3f, 0x36,
-	0xad, 0xef, 0x02, 0x00, 0x00, 0xff, 0xff, 0x25, 0x94, 0x69, 0xa1, 0xab, 0x12, 0x00, 0x00,
+	// 2518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xcf, 0x6a, 0x65, 0x79, 0xd5, 0xfa, 0x5a, 0xb5, 0x2d, 0x7b, 0xad, 0x18, 0x8d, 0x32, 0x76,
+	0x1c, 0xe7, 0xc3, 0x56, 0x22, 0x99, 0x50, 0xa4, 0xa0, 0x42, 0x56, 0x72, 0x40, 0xb2, 0x1c, 0x2d,
+	0xb3, 0x72, 0x5c, 0x40, 0x8a, 0xae, 0xd9, 0xd9, 0xd6, 0xec, 0x58, 0xb3, 0xd3, 0xc3, 0x74, 0x8f,
+	0xf0, 0x9a, 0xe2, 0x46, 0x15, 0x55, 0x1c, 0xa8, 0x70, 0xcb, 0x91, 0xe2, 0x4c, 0xf2, 0x0f, 0xc0,
+	0x1f, 0xe0, 0x70, 0xca, 0x11, 0x2e, 0x03, 0x98, 0x0b, 0xdf, 0x87, 0x29, 0x28, 0x2e, 0x1c, 0xa8,
+	0xfe, 0x98, 0xdd, 0x9e, 0xd9, 0xd1, 0x47, 0x38, 0x69, 0xb7, 0xfb, 0xf7, 0xfb, 0xbd, 0xd7, 0xaf,
+	0x5f, 0xbf, 0x7e, 0xdb, 0x02, 0x37, 0xba, 0x1d, 0x86, 0x29, 0xc3, 0x51, 0xd8, 0x59, 0x73, 0x48,
+	0x70, 0xe0, 0xb9, 0xc8, 0xf1, 0x3d, 0x1c, 0x30, 0xd4, 0xb7, 0x9d, 0x9e, 0x17, 0xe0, 0xdb, 0x61,
+	0x44, 0x18, 0x81, 0x60, 0x84, 0x5b, 0xbe, 0xe5, 0x7a, 0xac, 0x17, 0x77, 0x6e, 0x3b, 0xa4, 0xbf,
+	0xe6, 0x12, 0x97, 0xac, 0x09, 0x48, 0x27, 0x3e, 0x10, 0xdf, 0xc4, 0x17, 0xf1, 0x49, 0x52, 0x97,
+	0x97, 0x34, 0x13, 0x07, 0xbe, 0xed, 0xca, 0x61, 0xf3, 0x93, 0x45, 0xb0, 0xbc, 0x29, 0x2c, 0x6e,
+	0x0a, 0x83, 0xf7, 0xa5, 0xbd, 0xed, 0xc0, 0x63, 0x9e, 0xed, 0xc3, 0x37, 0x01, 0x68, 0xd9, 0xac,
+	0xd7, 0x8a, 0xf0, 0x81, 0xf7, 0xb8, 0x51, 0x59, 0xad, 0xdc, 0x9c, 0x6e, 0x5e, 0x4a, 0x13, 0x03,
+	0x0e, 0xec, 0xbe, 0xff, 0x96, 0x19, 0xda, 0xac, 0x87, 0x42, 0x31, 0x69, 0x5a, 0x1a, 0x12, 0xde,
+	0x02, 0xe7, 0x77, 0x89, 0xcb, 0x07, 0x1a, 0x13, 0x82, 0x74, 0x21, 0x4d, 0x8c, 0x05, 0x49, 0xf2,
+	0x89, 0x8b, 0x38, 0xd1, 0xb4, 0x32, 0x0c, 0x44, 0xe0, 0xb2, 0x34, 0xdf, 0x1e, 0x50, 0x86, 0xfb,
+	0xf7, 0x31, 0x8b, 0x3c, 0x87, 0x0a, 0x7a, 0x55, 0xd0, 0x5f, 0x4c, 0x13, 0xe3, 0x05, 0x49, 0x57,
+	0x81, 0xa1, 0x02, 0x89, 0xfa, 0x12, 0xaa, 0x04, 0x8f, 0x53, 0x81, 0x3f, 0xaa, 0x80, 0x6b, 0x25,
+	0x73, 0xdb, 0x01, 0x0f, 0x08, 0xf1, 0x6d, 0x86, 0xbb, 0xc2, 0xda, 0xa4, 0xb0, 0xb6, 0x9e, 0x26,
+	0xc6, 0xed, 0x93, 0xac, 0x79, 0x1a, 0x4f, 0x99, 0x3e, 0x8b, 0x3c, 0xfc, 0x49, 0x05, 0xbc, 0x28,
+	0x71, 0xbb, 0x36, 0xc3, 0x81, 0x33, 0xd8, 0xef, 0x45, 0x24, 0x76, 0x7b, 0x61, 0xcc, 0xf6, 0xbd,
+	0x3e, 0xa6, 0x38, 0xf2, 0xb0, 0x5c, 0xf6, 0x39, 0xe1, 0xc8, 0x9d, 0x34, 0x31, 0x5e, 0xcf, 0x39,
+	0xe2, 0x4b, 0x1e, 0x62, 0x43, 0x22, 0x62, 0x43, 0xa6, 0x72, 0xe5, 0x6c, 0x26, 0xe0, 0x0f, 0xc0,
+	0x6a, 0x0e, 0xb8, 0xe5, 0x51, 0x16, 0x79, 0x9d, 0x98, 0x79, 0x24, 0x78, 0xc7, 0xf7, 0x85, 0x1b,
+	0x53, 0xc2, 0x8d, 0xb5, 0x34, 0x31, 0x5e, 0x2d, 0x75, 0xa3, 0xab, 0x71, 0x90, 0xed, 0xfb, 0xca,
+	0x83, 0x53, 0x85, 0xe1, 0x87, 0x15, 0xf0, 0xd2, 0xb1, 0xa0, 0x16, 0x8e, 0x1c, 0x1c, 0x30, 0xcf,
+	0xc7, 0xc2, 0x89, 0xf3, 0xc2, 0x89, 0x37, 0xd3, 0xc4, 0x58, 0x3f, 0xdd, 0x89, 0x70, 0xc8, 0x55,
+	0xbe, 0x9c, 0xd5, 0x0c, 0xfc, 0x71, 0x05, 0x5c, 0x3f, 0x16, 0xdb, 0x8e, 0xfb, 0x7d, 0x3b, 0x1a,
+	0x08, 0x7f, 0x6a, 0xc2, 0x9f, 0x8d, 0x34, 0x31, 0xd6, 0x4e, 0xf7, 0x87, 0x4a, 0xa2, 0x72, 0xe6,
+	0x4c, 0x06, 0x60, 0x08, 0xae, 0xe6, 0x70, 0xcd, 0xc1, 0x3d, 0x3c, 0x78, 0x2f, 0xee, 0x77, 0x70,
+	0x24, 0x1c, 0x98, 0x16, 0x0e, 0xbc, 0x96, 0x26, 0xc6, 0xcd, 0x52, 0x07, 0x3a, 0x03, 0x74, 0x88,
+	0x07, 0x28, 0x10, 0x0c, 0x65, 0xf9, 0x44, 0x45, 0x38, 0x00, 0x46, 0x1b, 0x47, 0x47, 0x38, 0xda,
+	0xf2, 0xe8, 0x61, 0x3b, 0xb4, 0x1d, 0xfc, 0x80, 0xda, 0x2e, 0xd6, 0x57, 0x0d, 0x8a, 0xa9, 0x40,
+	0x05, 0x81, 0xaf, 0xf6, 0x10, 0x51, 0x4e, 0x41, 0x31, 0xe7, 0x14, 0x56, 0x7c, 0x9a, 0x2e, 0x0c,
+	0xb2, 0xc5, 0xb6, 0x31, 0xa5, 0x1e, 0x09, 0x36, 0x49, 0x40, 0x3d, 0x2a, 0xbc, 0x14, 0x76, 0x67,
+	0x84, 0xdd, 0x57, 0xd2, 0xc4, 0xb8, 0x91, 0x3f, 0x92, 0x12, 0x8e, 0x9c, 0x11, 0x3e, 0xbf, 0xd4,
+	0x72, 0xbd, 0x51, 0xad, 0x79, 0xd7, 0x8e, 0x7d, 0x71, 0x26, 0x7c, 0x2f, 0x90, 0x89, 0x36, 0x7b,
+	0x4c, 0xad, 0x39, 0xe0, 0x48, 0xc4, 0x14, 0x34, 0x5f, 0x6b, 0xc6, 0x54, 0x46, 0x06, 0x36, 0x23,
+	0x9b, 0xf6, 0x2c, 0xec, 0x90, 0x23, 0xac, 0x62, 0x38, 0x77, 0x8c, 0x01, 0x87, 0x23, 0x51, 0xa4,
+	0xa0, 0x79, 0x03, 0x63, 0x2a, 0x70, 0x0f, 0x40, 0xb5, 0xc2, 0xc0, 0x0e, 0x69, 0x8f, 0x30, 0xa1,
+	0x3d, 0x2f, 0xb4, 0x8d, 0x34, 0x31, 0x9e, 0xcf, 0xc7, 0x49, 0x81, 0x94, 0x6a, 0x09, 0x15, 0x76,
+	0x40, 0x43, 0xee, 0x52, 0x9b, 0xd9, 0x11, 0x8b, 0x43, 0x7d, 0xdb, 0x17, 0x84, 0xec, 0x8d, 0x34,
+	0x31, 0xcc, 0xdc, 0xb6, 0x53, 0x09, 0x2d, 0xec, 0xf6, 0xb1, 0x3a, 0xdc, 0x86, 0xca, 0x40, 0x6c,
+	0x77, 0x71, 0x94, 0x8b, 0x7b, 0xbd, 0x68, 0x23, 0xcb, 0x67, 0x01, 0x2d, 0x06, 0xfe, 0x58, 0x1d,
+	0xf8, 0x01, 0xb8, 0xf4, 0x75, 0x42, 0x5c, 0x1f, 0x6f, 0xfa, 0x24, 0xee, 0xb6, 0x22, 0xf2, 0x08,
+	0x3b, 0xec, 0x3d, 0xbb, 0x8f, 0x1b, 0x5d, 0x61, 0xe1, 0x7a, 0x9a, 0x18, 0xab, 0xd2, 0x82, 0x2b,
+	0x70, 0xc8, 0xe1, 0x40, 0x14, 0x4a, 0x24, 0x0a, 0xec, 0x3e, 0x36, 0xad, 0x63, 0x34, 0xe0, 0x01,
+	0xb8, 0xa2, 0xcd, 0xb4, 0x19, 0x89, 0x6c, 0x17, 0xdf, 0xc3, 0x32, 0x4c, 0x58, 0x18, 0xb8, 0x99,
+	0x26, 0xc6, 0xf5, 0x12, 0x03, 0x54, 0x82, 0xc5, 0xa9, 0x94, 0x8b, 0x38, 0x5e, 0x0a, 0xde, 0x01,
+	0x4b, 0xa5, 0x93, 0x8d, 0x03, 0x6e, 0xc3, 0x2a, 0x9f, 0x84, 0x04, 0x5c, 0x1d, 0x9f, 0x68, 0xc6,
+	0xce, 0x21, 0x96, 0x11, 0x70, 0x85, 0x83, 0xaf, 0xa6, 0x89, 0xf1, 0xd2, 0x09, 0x0e, 0x76, 0x04,
+	0x41, 0x05, 0xe2, 0x44, 0x41, 0x18, 0x83, 0x95, 0xf1, 0xf9, 0x76, 0xdc, 0xd9, 0xf2, 0x22, 0xec,
+	0x30, 0x12, 0x0d, 0x1a, 0x3d, 0x61, 0xf2, 0x56, 0x9a, 0x18, 0x2f, 0x9f, 0x60, 0x92, 0xc6, 0x1d,
+	0xd4, 0xcd, 0x38, 0xa6, 0x75, 0x8a, 0xa8, 0xf9, 0x9f, 0x29, 0x70, 0xad, 0xa4, 0x61, 0x69, 0xe2,
+	0xc0, 0xe9, 0xf5, 0xed, 0xe8, 0x70, 0x2f, 0xe4, 0xd5, 0x94, 0xc2, 0x6b, 0x60, 0x72, 0x7f, 0x10,
+	0x62, 0xd5, 0xb3, 0x2c, 0xa4, 0x89, 0x31, 0x23, 0x9d, 0x60, 0x83, 0x10, 0x9b, 0x96, 0x98, 0x84,
+	0x6f, 0x83, 0x39, 0x0b, 0x7f, 0x2f, 0xc6, 0x94, 0xc9, 0x5a, 0x28, 0x9a, 0x95, 0x6a, 0xf3, 0x4a,
+	0x9a, 0x18, 0x4b, 0x12, 0x1d, 0xc9, 0x69, 0x55, 0x4b, 0x4d, 0x2b, 0x8f, 0x87, 0xdf, 0x00, 0xf5,
+	0x4d, 0x12, 0x04, 0xd8, 0xe1, 0x46, 0x95, 0x46, 0x55, 0x68, 0x5c, 0x4d, 0x13, 0xa3, 0xa1, 0xb2,
+	0x79, 0x88, 0x18, 0xca, 0x8c, 0xb1, 0xe0, 0x57, 0xc0, 0xac, 0x5c, 0x90, 0x52, 0x99, 0x14, 0x2a,
+	0x8d, 0x34, 0x31, 0x2e, 0xe6, 0xce, 0x44, 0xa6, 0x90, 0x43, 0xc3, 0xef, 0x82, 0xcb, 0x23, 0x45,
+	0x7d, 0x86, 0x36, 0xce, 0xad, 0x56, 0x6f, 0x56, 0xf5, 0xd4, 0xd7, 0xdc, 0xc9, 0x69, 0x52, 0x5e,
+	0x72, 0xca, 0x45, 0xa0, 0x07, 0x96, 0x2d, 0x9b, 0xe1, 0x5d, 0xaf, 0xef, 0x31, 0x15, 0x01, 0xda,
+	0xc2, 0x51, 0x1b, 0x3b, 0x24, 0xe8, 0x8a, 0x2e, 0xa1, 0xda, 0x7c, 0x39, 0x4d, 0x8c, 0x17, 0x55,
+	0xd4, 0x6c, 0x86, 0x91, 0xcf, 0xc1, 0x48, 0x05, 0x90, 0xf2, 0x8b, 0x19, 0x51, 0x81, 0x37, 0xad,
+	0x13, 0xc4, 0x78, 0xeb, 0xd8, 0xb6, 0xfb, 0x22, 0xe1, 0xf9, 0xc5, 0x5f, 0xd3, 0x5b, 0x47, 0x6a,
+	0xf7, 0xc5, 0x21, 0x32, 0xad, 0x0c, 0x03, 0xbf, 0x0a, 0x66, 0xef, 0xe1, 0x41, 0xdb, 0x7b, 0x82,
+	0x9b, 0x03, 0x86, 0x69, 0xa3, 0x56, 0xdc, 0x41, 0x7e, 0xe6, 0xa8, 0xf7, 0x04, 0xa3, 0x0e, 0x9f,
+	0x37, 0xad, 0x1c, 0x1c, 0x6e, 0x82, 0xf9, 0xf7, 0x6d, 0x3f, 0xc6, 0x23, 0x81, 0x69, 0x21, 0xf0,
+	0x7c, 0x9a, 0x18, 0x97, 0xa5, 0xc0, 0x11, 0x9f, 0xcf, 0x49, 0x14, 0x28, 0x70, 0x03, 0x4c, 0xb7,
+	0x99, 0xed, 0x63, 0x0b, 0xdb, 0x5d, 0x71, 0x4f, 0xd6, 0x9a, 0x4b, 0x69, 0x62, 0x2c, 0x2a, 0xa7,
+	0xf9, 0x14, 0x8a, 0xb0, 0xdd, 0x35, 0xad, 0x11, 0x0e, 0x7e, 0x07, 0x5c, 0x12, 0xa5, 0x7d, 0xef,
+	0xe0, 0x80, 0x62, 0x76, 0xdf, 0xf3, 0x7d, 0x4f, 0x86, 0x47, 0xdc, 0x78, 0xd5, 0xe6, 0xb5, 0x34,
+	0x31, 0x0c, 0xb5, 0x63, 0x1c, 0x87, 0x88, 0x00, 0xa2, 0xfe, 0x08, 0x69, 0x5a, 0xc7, 0x48, 0x40,
+	0x0b, 0x5c, 0xc8, 0x2a, 0xfc, 0x7d, 0xcc, 0xb7, 0x70, 0x3b, 0xe8, 0xe2, 0xc7, 0xe2, 0x82, 0xab,
+	0x36, 0x57, 0xd3, 0xc4, 0xb8, 0xaa, 0x7c, 0x53, 0x20, 0xd4, 0x17, 0x28, 0xe4, 0x71, 0x98, 0x69,
+	0x95, 0x91, 0xcd, 0x64, 0x02, 0xbc, 0x70, 0xd2, 0xc9, 0x6b, 0x33, 0x1c, 0x52, 0x7e, 0x39, 0xf1,
+	0x0f, 0x6f, 0x88, 0x2b, 0x60, 0xcb, 0x66, 0x76, 0xc7, 0xa6, 0xf2, 0x14, 0xd6, 0xf4, 0xcb, 0x89,
+	0x72, 0x8c, 0xbc, 0x44, 0x50, 0x57, 0xa1, 0x4c, 0xab, 0x84, 0x2a, 0x96, 0xc2, 0x70, 0xb8, 0xde,
+	0x66, 0x11, 0xa6, 0x74, 0xa8, 0x38, 0x21, 0x14, 0xf5, 0xa5, 0x70, 0x10, 0xa2, 0x02, 0xa5, 0x49,
+	0x96, 0x91, 0xe1, 0x2e, 0x58, 0xe4, 0xc3, 0x1b, 0x6d, 0x46, 0xc2, 0xa1, 0x62, 0x55, 0x28, 0xae,
+	0xa4, 0x89, 0xb1, 0x3c, 0x52, 0xdc, 0xe0, 0x75, 0x2a, 0xd4, 0xf4, 0xc6, 0x89, 0xf0, 0x5d, 0xb0,
+	0xc0, 0x07, 0xef, 0x3c, 0x08, 0x7d, 0x62, 0x77, 0x77, 0x89, 0x4b, 0xc5, 0xe9, 0xad, 0xe9, 0x35,
+	0x80, 0x6b, 0xdd, 0x41, 0xb1, 0x40, 0x20, 0x9f, 0xb8, 0xd4, 0xb4, 0x8a, 0x24, 0xf3, 0x57, 0x93,
+	0xa0, 0x51, 0x12, 0x60, 0xd1, 0x61, 0x9c, 0xad, 0x9e, 0xdd, 0x03, 0x8b, 0xe3, 0xe9, 0x24, 0x6b,
+	0xda, 0x17, 0xd2, 0xc4, 0xb8, 0x22, 0x19, 0x65, 0x89, 0x34, 0xce, 0x83, 0x5f, 0x06, 0x33, 0x7a,
+	0xee, 0xc8, 0xb2, 0x76, 0x39, 0x4d, 0x8c, 0x0b, 0x52, 0x26, 0x9f, 0x32, 0x3a, 0x96, 0xef, 0xd9,
+	0xbe, 0x1d, 0xb9, 0x58, 0xcf, 0x1f, 0xcc, 0xa3, 0x52, 0xcd, 0xa7, 0x1f, 0x13, 0xa0, 0x5c, 0xf2,
+	0xf1, 0xf3, 0x55, 0x46, 0xe6, 0xa5, 0x76, 0x0b, 0xfb, 0xf6, 0x40, 0x5f, 0xda, 0xb9, 0x62, 0xa9,
+	0xed, 0x72, 0x44, 0x7e, 0x65, 0x63, 0x2c, 0x1e, 0xa5, 0x1d, 0x8f, 0x31, 0x1c, 0xe9, 0x52, 0x53,
+	0xc5, 0x28, 0x3d, 0x12, 0x90, 0x42, 0x94, 0xc6, 0x78, 0x3c, 0x4a, 0xbb, 0x84, 0x52, 0xf5, 0x5b,
+	0x42, 0x94, 0xac, 0x8a, 0x1e, 0x25, 0x9f, 0x50, 0x9a, 0xfd, 0x28, 0x31, 0x2d, 0x1d, 0xcb, 0xb3,
+	0xf0, 0x41, 0xe8, 0x46, 0x76, 0x17, 0x67, 0xa9, 0xb4, 0xbd, 0xa5, 0x7e, 0x5c, 0x68, 0x59, 0x18,
+	0x4b, 0xc8, 0x30, 0x05, 0x91, 0xc7, 0x1d, 0x19, 0x23, 0x9a, 0xff, 0xad, 0x80, 0x95, 0x92, 0xec,
+	0xd9, 0xf2, 0x6c, 0x37, 0x20, 0x94, 0x79, 0x0e, 0x2d, 0x4f, 0x8f, 0xca, 0xff, 0x99, 0x1e, 0x6f,
+	0x83, 0xb9, 0xfc, 0xee, 0x4e, 0xac, 0x56, 0xf3, 0x95, 0xb7, 0xb8, 0xad, 0x79, 0x3c, 0x5f, 0xfe,
+	0x66, 0xeb, 0x41, 0x2b, 0x22, 0x07, 0x9e, 0x8f, 0x65, 0xf1, 0xa7, 0x2a, 0xcb, 0xb4, 0xe5, 0x3b,
+	0x61, 0x8c, 0x42, 0x89, 0x51, 0xd7, 0x07, 0x35, 0xad, 0x71, 0xa2, 0xf9, 0xfb, 0x6a, 0x69, 0x75,
+	0xb2, 0x30, 0x25, 0x71, 0xe4, 0xc8, 0xcb, 0x46, 0x74, 0x05, 0x9b, 0xad, 0x07, 0x54, 0x2c, 0xba,
+	0xa2, 0x9f, 0x22, 0x27, 0x8c, 0xa9, 0x69, 0x89, 0x49, 0x95, 0xf8, 0x24, 0x1a, 0xc8, 0x0b, 0x61,
+	0xa2, 0x24, 0xf1, 0x49, 0x34, 0xc8, 0x2e, 0x03, 0x1d, 0x0b, 0x5f, 0x07, 0xb5, 0xed, 0xbd, 0x87,
+	0xd8, 0x73, 0x7b, 0x4c, 0x2c, 0x65, 0xb2, 0x79, 0x31, 0x4d, 0x8c, 0xba, 0xe4, 0x79, 0x04, 0x7d,
+	0x5f, 0x4c, 0x99, 0xd6, 0x10, 0x05, 0x1f, 0x82, 0x8b, 0xdb, 0x7b, 0xfc, 0x42, 0x10, 0x02, 0xa3,
+	0x3b, 0x75, 0xb2, 0x78, 0x09, 0x78, 0x44, 0xdc, 0x21, 0xd2, 0x6c, 0xee, 0x36, 0x2d, 0x15, 0x80,
+	0xdf, 0x06, 0x4b, 0xdb, 0x7b, 0x0f, 0x23, 0x8f, 0xe1, 0x82, 0xb2, 0x3c, 0x34, 0x5a, 0x43, 0xc0,
+	0xfd, 0xe2, 0xb8, 0x12, 0xe9, 0x72, 0x09, 0xf8, 0x25, 0x00, 0xa4, 0xcd, 0xed, 0xbd, 0x56, 0xbb,
+	0x31, 0x55, 0x0c, 0x50, 0xe6, 0xaa, 0x47, 0x42, 0x6a, 0x5a, 0x1a, 0x14, 0xbe, 0x05, 0x66, 0x94,
+	0xa2, 0x60, 0x9e, 0x2f, 0x36, 0x39, 0x43, 0x57, 0x24, 0x55, 0x07, 0x9b, 0xbf, 0x98, 0x00, 0x46,
+	0xc9, 0x0e, 0xdf, 0x7d, 0xcc, 0x22, 0x3b, 0xeb, 0xfa, 0x0a, 0x35, 0xab, 0xf2, 0x39, 0x6a, 0xd6,
+	0x0d, 0x70, 0xee, 0x5d, 0xdf, 0x76, 0x65, 0x1e, 0x4f, 0x37, 0xeb, 0x69, 0x62, 0xcc, 0x4a, 0x12,
+	0x7f, 0x2e, 0xa3, 0xa6, 0x25, 0xa7, 0xc5, 0x93, 0x58, 0x44, 0x1e, 0x0f, 0x24, 0xb8, 0xba, 0x5a,
+	0x2d, 0x3c, 0x89, 0xf1, 0x39, 0xa4, 0x28, 0x1a, 0x12, 0xae, 0x82, 0xea, 0xdd, 0xe0, 0x48, 0xd4,
+	0xc0, 0xe9, 0xe6, 0x7c, 0x9a, 0x18, 0x40, 0x12, 0x70, 0x70, 0x64, 0x5a, 0x7c, 0x0a, 0x36, 0xc1,
+	0xbc, 0x5c, 0xdf, 0x3e, 0xee, 0x87, 0xbe, 0xcd, 0xb0, 0x7a, 0x05, 0x5a, 0x4e, 0x13, 0xe3, 0xd2,
+	0xb0, 0x77, 0xe3, 0x8f, 0x83, 0x4c, 0x01, 0x4c, 0xab, 0xc0, 0x30, 0x7f, 0x5a, 0x2f, 0x0d, 0xd2,
+	0x3b, 0x2e, 0xff, 0x25, 0x49, 0x02, 0x16, 0x11, 0xf1, 0xa8, 0xa7, 0x15, 0x9c, 0xb1, 0x47, 0xbd,
+	0x5c, 0xa1, 0xd1, 0x90, 0xf0, 0x9b, 0xe0, 0x42, 0xf6, 0x6d, 0x0b, 0x53, 0x27, 0xf2, 0x44, 0xd0,
+	0xd5, 0x03, 0x9f, 0x76, 0xb7, 0x0f, 0x05, 0xba, 0x23, 0x94, 0x69, 0x95, 0x71, 0xf9, 0x7e, 0x65,
+	0xc3, 0xfb, 0xb6, 0xab, 0x1e, 0xfb, 0xb4, 0xfd, 0x1a, 0x4a, 0x31, 0xdb, 0x35, 0x2d, 0x1d, 0xcb,
+	0xfb, 0xc4, 0x16, 0xc6, 0xd1, 0x76, 0x8b, 0xaa, 0x98, 0x6a, 0x7d, 0x62, 0x88, 0xf9, 0x26, 0xf3,
+	0x0c, 0xca, 0x30, 0xf0, 0x6b, 0x60, 0x4e, 0x7d, 0x6c, 0xb3, 0xc8, 0x0b, 0xdc, 0xf1, 0xd8, 0x66,
+	0x24, 0xde, 0x43, 0x78, 0x81, 0x6b, 0x5a, 0x79, 0x02, 0x6c, 0x01, 0x28, 0xc2, 0xd8, 0x22, 0x11,
+	0xdb, 0x27, 0xaa, 0x53, 0x56, 0xc9, 0xaf, 0xdd, 0x69, 0x36, 0xc7, 0xa0, 0x90, 0x44, 0x0c, 0x31,
+	0x82, 0x54, 0xb3, 0x6d, 0x5a, 0x25, 0x5c, 0xbe, 0xe1, 0x62, 0xf4, 0x6e, 0xd0, 0x0d, 0x89, 0x17,
+	0x30, 0xda, 0x38, 0xbf, 0x5a, 0xcd, 0x3b, 0x25, 0xd5, 0x70, 0x06, 0x30, 0xad, 0x02, 0x03, 0x7e,
+	0x0b, 0x2c, 0x65, 0x51, 0xc9, 0x3b, 0x56, 0x2b, 0x16, 0x90, 0x61, 0x2c, 0xc7, 0x7c, 0x2b, 0x57,
+	0xe0, 0xd7, 0x45, 0x36, 0x31, 0xf2, 0x70, 0x5a, 0x78, 0xa8, 0x5d, 0x17, 0x43, 0x59, 0xcd, 0xc9,
+	0x71, 0x1e, 0xef, 0x0b, 0xd5, 0xa3, 0xf2, 0xa6, 0x1f, 0x53, 0x86, 0x23, 0xde, 0x3e, 0x8b, 0x66,
+	0xb9, 0xaa, 0xe7, 0x8e, 0x27, 0x31, 0xc8, 0x91, 0x20, 0xd1, 0x76, 0x9b, 0x56, 0x09, 0x15, 0xde,
+	0x05, 0x0b, 0x99, 0x95, 0xf7, 0x71, 0xc4, 0x5f, 0x7a, 0xd4, 0x53, 0x91, 0xd6, 0xba, 0x0f, 0x7d,
+	0x3b, 0x92, 0x08, 0xd3, 0x2a, 0x72, 0xe0, 0x07, 0x60, 0x2e, 0x1b, 0x92, 0x27, 0xfa, 0xd7, 0x3c,
+	0x31, 0x66, 0xd6, 0xaf, 0xdc, 0x1e, 0x3d, 0x98, 0xdf, 0xce, 0x21, 0x9a, 0xcb, 0x4f, 0x13, 0xe3,
+	0xb9, 0xcf, 0x12, 0xa3, 0x92, 0x26, 0xc6, 0xbc, 0x34, 0xf4, 0x9a, 0x17, 0xf8, 0x5e, 0x80, 0x4d,
+	0x2b, 0x2f, 0x06, 0x7f, 0x5e, 0x39, 0xd3, 0xaf, 0xd5, 0xc6, 0x9f, 0xcf, 0x0b, 0xa3, 0x6b, 0xba,
+	0xd1, 0x33, 0xf0, 0xf4, 0xd6, 0xa7, 0x93, 0xcd, 0x21, 0x22, 0x27, 0xf9, 0x9b, 0xf4, 0xe9, 0x12,
+	0xf0, 0xa3, 0xca, 0x19, 0xda, 0xfa, 0xc6, 0x5f, 0xa4, 0x83, 0xb7, 0xce, 0xea, 0xa0, 0x60, 0xe9,
+	0x89, 0x3c, 0x72, 0x8f, 0xb7, 0xc2, 0xd4, 0xb4, 0x4e, 0x37, 0x0a, 0x5b, 0x60, 0x4a, 0x34, 0xbf,
+	0xb4, 0xf1, 0x57, 0x7e, 0x30, 0x66, 0xd6, 0xaf, 0x9f, 0x62, 0x5e, 0xa0, 0x9b, 0x8b, 0x69, 0x62,
+	0xcc, 0xa9, 0xd2, 0x2d, 0xe8, 0xa6, 0xa5, 0x74, 0x20, 0x06, 0x33, 0x5a, 0x43, 0xd4, 0xf8, 0x9b,
+	0x94, 0x7d, 0xe5, 0x14, 0x59, 0x8d, 0x92, 0x2b, 0x94, 0xa3, 0x61, 0x5e, 0x9b, 0x46, 0xdf, 0x60,
+	0x04, 0xe6, 0xf3, 0x8d, 0x47, 0xe3, 0xef, 0x67, 0x8b, 0x5f, 0x9e, 0xa5, 0xc7, 0x2f, 0x52, 0x33,
+	0xf2, 0x67, 0x35, 0x2f, 0x04, 0x79, 0x2c, 0x7c, 0x04, 0x66, 0xf5, 0xab, 0xb0, 0xf1, 0x0f, 0x69,
+	0xf1, 0xd5, 0x53, 0x2c, 0xea, 0x1c, 0xfd, 0x26, 0xc6, 0x7c, 0x7c, 0x94, 0x4a, 0x39, 0x6d, 0xf8,
+	0x43, 0x00, 0xe5, 0xd5, 0x99, 0xb3, 0xf8, 0x4f, 0x19, 0xcd, 0xcf, 0x65, 0x51, 0x3b, 0xfa, 0xea,
+	0x6e, 0x2e, 0x18, 0x2e, 0x31, 0xb4, 0x33, 0x59, 0xeb, 0xd6, 0x1f, 0xed, 0x4c, 0xd5, 0x9e, 0x56,
+	0xea, 0x9f, 0x56, 0x76, 0xa6, 0x6a, 0x9f, 0x56, 0xea, 0xbf, 0xe1, 0x7f, 0x7f, 0x39, 0x51, 0xff,
+	0x78, 0x62, 0x67, 0xaa, 0xf6, 0xf1, 0x44, 0xfd, 0x13, 0xfe, 0xf7, 0xc3, 0x6a, 0xfd, 0x67, 0xd5,
+	0x9d, 0xa9, 0xda, 0xbf, 0xaa, 0xf5, 0x7f, 0xf3, 0xbf, 0xbf, 0x9d, 0xac, 0xff, 0x6e, 0xd2, 0x5a,
+	0xe4, 0x97, 0x33, 0x42, 0x98, 0x39, 0x5d, 0x84, 0x08, 0xeb, 0xe1, 0xc8, 0x5a, 0xd0, 0x87, 0x98,
+	0x17, 0x5a, 0x75, 0x7d, 0xe0, 0x68, 0x03, 0xad, 0x8f, 0x8d, 0x6c, 0xe4, 0x75, 0xb8, 0x93, 0x5d,
+	0x0b, 0x8c, 0x86, 0xac, 0xab, 0xf2, 0xf3, 0x13, 0x42, 0x0e, 0x31, 0xe6, 0x3d, 0x15, 0x8a, 0x36,
+	0xd0, 0x17, 0xd1, 0x06, 0xea, 0x60, 0x66, 0x5b, 0x0b, 0x85, 0x59, 0xeb, 0xa2, 0x1c, 0xe0, 0xaf,
+	0xd9, 0xb1, 0x8f, 0xd0, 0xd1, 0x1b, 0xe8, 0x75, 0xb4, 0x6e, 0xcd, 0xea, 0xa3, 0x99, 0x45, 0x47,
+	0x9a, 0x14, 0x3a, 0x6a, 0xe8, 0x89, 0x36, 0x94, 0x71, 0x62, 0xca, 0x48, 0xbf, 0x79, 0xf1, 0xe9,
+	0x1f, 0x57, 0x9e, 0x7b, 0xfa, 0x6c, 0xa5, 0xf2, 0xd9, 0xb3, 0x95, 0xca, 0x1f, 0x9e, 0xad, 0x54,
+	0x3e, 0xfa, 0xd3, 0xca, 0x73, 0x9d, 0x29, 0xf1, 0xdf, 0xbf, 0x8d, 0xff, 0x0d, 0x00, 0xcb, 0x0f,
+	0xef, 0x8a, 0x79, 0x1c, 0x00, 0x00,
 }
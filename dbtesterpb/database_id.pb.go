@@ -14,8 +14,10 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 // DatabaseID differentiates between major or minor releases (possibly different APIs)
-// of each database. Make sure to make accordingn changes in 'flag_*' whenever an ID
-// is added/removed.
+// of each database. Make sure to make accordingn changes in 'flag_*' whenever a database
+// family is added/removed. IDs without version ("etcd", "zookeeper", "consul") run any
+// release of the database, with the free-form 'database_version', and the agent
+// detects the actual version from the binary.
 type DatabaseID int32
 
 const (
@@ -24,14 +26,22 @@ const (
 	DatabaseID_etcd__tip   DatabaseID = 1
 	DatabaseID_etcd__v3_2  DatabaseID = 2
 	DatabaseID_etcd__v3_3  DatabaseID = 3
+	DatabaseID_etcd        DatabaseID = 4
+	// etcd server vendored in dbtester, which runs in the agent process
+	DatabaseID_etcd__embed DatabaseID = 5
 	// https://zookeeper.apache.org/releases.html
 	DatabaseID_zookeeper__r3_5_3_beta DatabaseID = 100
+	DatabaseID_zookeeper              DatabaseID = 101
 	// https://github.com/hashicorp/consul/releases
 	DatabaseID_consul__v1_0_2 DatabaseID = 200
+	DatabaseID_consul         DatabaseID = 201
 	// https://github.com/coreos/zetcd/releases
 	DatabaseID_zetcd__beta DatabaseID = 300
 	// https://github.com/coreos/cetcd/releases
 	DatabaseID_cetcd__beta DatabaseID = 400
+	// any server that speaks the etcd, Zookeeper or Consul client protocol,
+	// started with the command in 'flag__custom'
+	DatabaseID_custom DatabaseID = 500
 )
 
 var DatabaseID_name = map[int32]string{
@@ -39,20 +49,30 @@ var DatabaseID_name = map[int32]string{
 	1:   "etcd__tip",
 	2:   "etcd__v3_2",
 	3:   "etcd__v3_3",
+	4:   "etcd",
+	5:   "etcd__embed",
 	100: "zookeeper__r3_5_3_beta",
+	101: "zookeeper",
 	200: "consul__v1_0_2",
+	201: "consul",
 	300: "zetcd__beta",
 	400: "cetcd__beta",
+	500: "custom",
 }
 var DatabaseID_value = map[string]int32{
 	"etcd__other":            0,
 	"etcd__tip":              1,
 	"etcd__v3_2":             2,
 	"etcd__v3_3":             3,
+	"etcd":                   4,
+	"etcd__embed":            5,
 	"zookeeper__r3_5_3_beta": 100,
+	"zookeeper":              101,
 	"consul__v1_0_2":         200,
+	"consul":                 201,
 	"zetcd__beta":            300,
 	"cetcd__beta":            400,
+	"custom":                 500,
 }
 
 func (x DatabaseID) String() string {
@@ -67,20 +87,22 @@ func init() {
 func init() { proto.RegisterFile("dbtesterpb/database_id.proto", fileDescriptorDatabaseId) }
 
 var fileDescriptorDatabaseId = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x4d, 0x4a, 0xc4, 0x40,
-	0x10, 0x85, 0xd3, 0x33, 0x20, 0x58, 0x83, 0x63, 0xd3, 0x8a, 0x8b, 0x41, 0xfa, 0x00, 0x82, 0x13,
-	0x9d, 0xc6, 0x0b, 0xc8, 0x6c, 0x3c, 0x45, 0x91, 0x4e, 0x97, 0x99, 0xe0, 0x4f, 0x85, 0x4e, 0x65,
-	0x16, 0x73, 0x0a, 0x97, 0x6e, 0xdd, 0x7b, 0x90, 0x2c, 0x3d, 0x82, 0xc6, 0x8b, 0x88, 0x1d, 0x41,
-	0xdd, 0xd5, 0xf7, 0xd5, 0xab, 0x07, 0x05, 0xa7, 0xc1, 0x0b, 0xb5, 0x42, 0xb1, 0xf1, 0x79, 0x28,
-	0xa4, 0xf0, 0x45, 0x4b, 0x58, 0x87, 0x65, 0x13, 0x59, 0xd8, 0xc0, 0xef, 0x76, 0x71, 0x5e, 0xd5,
-	0xb2, 0xe9, 0xfc, 0xb2, 0xe4, 0x87, 0xbc, 0xe2, 0x8a, 0xf3, 0x14, 0xf1, 0xdd, 0x6d, 0xa2, 0x04,
-	0x69, 0x1a, 0x4f, 0xcf, 0x5e, 0x14, 0xc0, 0xfa, 0xa7, 0xf0, 0x66, 0x6d, 0x0e, 0x61, 0x46, 0x52,
-	0x06, 0x44, 0x96, 0x0d, 0x45, 0x9d, 0x99, 0x03, 0xd8, 0x1f, 0x85, 0xd4, 0x8d, 0x56, 0x66, 0x0e,
-	0x30, 0xe2, 0xd6, 0xe1, 0x4a, 0x4f, 0xfe, 0xb1, 0xd3, 0x53, 0xb3, 0x80, 0x93, 0x1d, 0xf3, 0x1d,
-	0x51, 0x43, 0x11, 0x31, 0x3a, 0xbc, 0x42, 0x87, 0x9e, 0xa4, 0xd0, 0xc1, 0x1c, 0xc1, 0xbc, 0xe4,
-	0xc7, 0xb6, 0xbb, 0x47, 0xdc, 0x5e, 0xe2, 0x05, 0xae, 0x74, 0xaf, 0x8c, 0x86, 0xd9, 0x6e, 0x6c,
-	0x48, 0xa9, 0xd7, 0xc9, 0xb7, 0x29, 0xff, 0x98, 0xa7, 0xe9, 0xf5, 0x71, 0xff, 0x61, 0xb3, 0x7e,
-	0xb0, 0xea, 0x6d, 0xb0, 0xea, 0x7d, 0xb0, 0xea, 0xf9, 0xd3, 0x66, 0x7e, 0x2f, 0x3d, 0xe0, 0xbe,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xf3, 0x61, 0x28, 0x1b, 0x01, 0x00, 0x00,
+	// 268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4d, 0xc3, 0x30,
+	0x14, 0x40, 0xe3, 0xa6, 0x54, 0xf0, 0x23, 0xca, 0x97, 0x41, 0x1c, 0x2a, 0x94, 0x01, 0x90, 0x68,
+	0xa0, 0x11, 0x0b, 0xa0, 0x5e, 0x98, 0xe2, 0x2b, 0x8e, 0x3f, 0x69, 0x04, 0xc1, 0x51, 0xe2, 0xf4,
+	0xd0, 0x29, 0x38, 0x32, 0x04, 0x83, 0x84, 0x1b, 0x23, 0x40, 0x10, 0x1b, 0x30, 0x00, 0x8a, 0x83,
+	0x28, 0xbd, 0xf9, 0xbd, 0xef, 0xff, 0x2c, 0x19, 0xce, 0xb4, 0xb2, 0x5c, 0x5b, 0xae, 0x4a, 0x15,
+	0xe9, 0xc4, 0x26, 0x2a, 0xa9, 0x99, 0x72, 0x3d, 0x2f, 0x2b, 0x63, 0x8d, 0x84, 0xed, 0x74, 0x76,
+	0x91, 0xe5, 0x76, 0xd5, 0xa8, 0x79, 0x6a, 0x8a, 0x28, 0x33, 0x99, 0x89, 0xdc, 0x15, 0xd5, 0xdc,
+	0x39, 0x72, 0xe0, 0x4e, 0xc3, 0xea, 0xf9, 0x97, 0x00, 0x58, 0xfe, 0x06, 0x6f, 0x97, 0xf2, 0x08,
+	0x02, 0xb6, 0xa9, 0x26, 0x32, 0x76, 0xc5, 0x15, 0x7a, 0xf2, 0x10, 0x0e, 0x06, 0x61, 0xf3, 0x12,
+	0x85, 0x9c, 0x02, 0x0c, 0xb8, 0x8e, 0x69, 0x81, 0xa3, 0x1d, 0x8e, 0xd1, 0x97, 0xfb, 0x30, 0xee,
+	0x19, 0xc7, 0xdb, 0x12, 0x17, 0x8a, 0x35, 0xee, 0xc9, 0x19, 0x9c, 0x6e, 0x8c, 0xb9, 0x67, 0x2e,
+	0xb9, 0x22, 0xaa, 0x62, 0xba, 0xa6, 0x98, 0x14, 0xdb, 0x04, 0x75, 0xff, 0xca, 0xdf, 0x0c, 0x59,
+	0x1e, 0xc3, 0x34, 0x35, 0x8f, 0x75, 0xf3, 0x40, 0xb4, 0xbe, 0xa2, 0x4b, 0x5a, 0x60, 0x2b, 0x64,
+	0x00, 0x93, 0x41, 0xe2, 0xab, 0x90, 0x08, 0xc1, 0x66, 0xc8, 0xbb, 0xc2, 0xcb, 0xa8, 0x37, 0xe9,
+	0x3f, 0xf3, 0xe4, 0xbb, 0x85, 0xa6, 0xb6, 0xa6, 0xc0, 0x6f, 0xff, 0xe6, 0xa4, 0xfd, 0x08, 0xbd,
+	0xb6, 0x0b, 0xc5, 0x5b, 0x17, 0x8a, 0xf7, 0x2e, 0x14, 0xcf, 0x9f, 0xa1, 0xa7, 0x26, 0xee, 0x13,
+	0xe2, 0x9f, 0x01, 0x00, 0xc1, 0x50, 0xce, 0xbe, 0x5f, 0x01, 0x00, 0x00,
 }
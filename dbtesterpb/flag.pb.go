@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dbtesterpb/flag.proto
+
+package dbtesterpb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// DatabaseFlags is the flags of the database, one message per database
+// family, keyed by the family (e.g. "etcd" for "etcd__v3_3"). Drivers
+// read the flags of their family, and switch on the version, if needed.
+type DatabaseFlags struct {
+	Flag_Etcd      *Flag_Etcd      `protobuf:"bytes,1,opt,name=flag__etcd,json=flagEtcd" json:"flag__etcd,omitempty" yaml:"etcd"`
+	Flag_Zookeeper *Flag_Zookeeper `protobuf:"bytes,2,opt,name=flag__zookeeper,json=flagZookeeper" json:"flag__zookeeper,omitempty" yaml:"zookeeper"`
+	Flag_Consul    *Flag_Consul    `protobuf:"bytes,3,opt,name=flag__consul,json=flagConsul" json:"flag__consul,omitempty" yaml:"consul"`
+	Flag_Zetcd     *Flag_Zetcd     `protobuf:"bytes,4,opt,name=flag__zetcd,json=flagZetcd" json:"flag__zetcd,omitempty" yaml:"zetcd"`
+	Flag_Cetcd     *Flag_Cetcd     `protobuf:"bytes,5,opt,name=flag__cetcd,json=flagCetcd" json:"flag__cetcd,omitempty" yaml:"cetcd"`
+	Flag_Custom    *Flag_Custom    `protobuf:"bytes,6,opt,name=flag__custom,json=flagCustom" json:"flag__custom,omitempty" yaml:"custom"`
+}
+
+func (m *DatabaseFlags) Reset()                    { *m = DatabaseFlags{} }
+func (m *DatabaseFlags) String() string            { return proto.CompactTextString(m) }
+func (*DatabaseFlags) ProtoMessage()               {}
+func (*DatabaseFlags) Descriptor() ([]byte, []int) { return fileDescriptorFlag, []int{0} }
+
+func init() {
+	proto.RegisterType((*DatabaseFlags)(nil), "dbtesterpb.DatabaseFlags")
+}
+func (m *DatabaseFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatabaseFlags) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Flag_Etcd != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFlag(dAtA, i, uint64(m.Flag_Etcd.Size()))
+		n1, err := m.Flag_Etcd.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Flag_Zookeeper != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFlag(dAtA, i, uint64(m.Flag_Zookeeper.Size()))
+		n2, err := m.Flag_Zookeeper.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Flag_Consul != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFlag(dAtA, i, uint64(m.Flag_Consul.Size()))
+		n3, err := m.Flag_Consul.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Flag_Zetcd != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFlag(dAtA, i, uint64(m.Flag_Zetcd.Size()))
+		n4, err := m.Flag_Zetcd.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Flag_Cetcd != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFlag(dAtA, i, uint64(m.Flag_Cetcd.Size()))
+		n5, err := m.Flag_Cetcd.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Flag_Custom != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFlag(dAtA, i, uint64(m.Flag_Custom.Size()))
+		n6, err := m.Flag_Custom.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
+func encodeVarintFlag(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *DatabaseFlags) Size() (n int) {
+	var l int
+	_ = l
+	if m.Flag_Etcd != nil {
+		l = m.Flag_Etcd.Size()
+		n += 1 + l + sovFlag(uint64(l))
+	}
+	if m.Flag_Zookeeper != nil {
+		l = m.Flag_Zookeeper.Size()
+		n += 1 + l + sovFlag(uint64(l))
+	}
+	if m.Flag_Consul != nil {
+		l = m.Flag_Consul.Size()
+		n += 1 + l + sovFlag(uint64(l))
+	}
+	if m.Flag_Zetcd != nil {
+		l = m.Flag_Zetcd.Size()
+		n += 1 + l + sovFlag(uint64(l))
+	}
+	if m.Flag_Cetcd != nil {
+		l = m.Flag_Cetcd.Size()
+		n += 1 + l + sovFlag(uint64(l))
+	}
+	if m.Flag_Custom != nil {
+		l = m.Flag_Custom.Size()
+		n += 1 + l + sovFlag(uint64(l))
+	}
+	return n
+}
+
+func sovFlag(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozFlag(x uint64) (n int) {
+	return sovFlag(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DatabaseFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFlag
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatabaseFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatabaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Etcd == nil {
+				m.Flag_Etcd = &Flag_Etcd{}
+			}
+			if err := m.Flag_Etcd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zookeeper == nil {
+				m.Flag_Zookeeper = &Flag_Zookeeper{}
+			}
+			if err := m.Flag_Zookeeper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Consul == nil {
+				m.Flag_Consul = &Flag_Consul{}
+			}
+			if err := m.Flag_Consul.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Zetcd == nil {
+				m.Flag_Zetcd = &Flag_Zetcd{}
+			}
+			if err := m.Flag_Zetcd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Cetcd == nil {
+				m.Flag_Cetcd = &Flag_Cetcd{}
+			}
+			if err := m.Flag_Cetcd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Custom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFlag
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flag_Custom == nil {
+				m.Flag_Custom = &Flag_Custom{}
+			}
+			if err := m.Flag_Custom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFlag(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFlag
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFlag(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFlag
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFlag
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthFlag
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowFlag
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipFlag(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthFlag = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFlag   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("dbtesterpb/flag.proto", fileDescriptorFlag) }
+
+var fileDescriptorFlag = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x4e, 0xc2, 0x40,
+	0x18, 0x85, 0xa9, 0x28, 0xd1, 0x01, 0x02, 0x36, 0xa8, 0x13, 0x30, 0xad, 0xe9, 0xca, 0x8d, 0x25,
+	0xd1, 0x9d, 0x4b, 0x10, 0x13, 0x17, 0x6e, 0xd8, 0xe9, 0xc6, 0xcc, 0x0c, 0x43, 0x35, 0x16, 0x87,
+	0xb4, 0xd3, 0x85, 0x9e, 0xc4, 0x23, 0xb1, 0xf4, 0x04, 0x8d, 0xd6, 0x78, 0x81, 0x9e, 0xc0, 0xf4,
+	0x9f, 0xd2, 0xd6, 0x56, 0x76, 0xf3, 0xff, 0xef, 0xcd, 0x97, 0xf7, 0x3a, 0x45, 0x07, 0x33, 0x2a,
+	0xb9, 0x2f, 0xb9, 0xb7, 0xa4, 0xc3, 0xb9, 0x4b, 0x1c, 0x7b, 0xe9, 0x09, 0x29, 0x74, 0x94, 0xaf,
+	0xfb, 0x67, 0xce, 0x93, 0x7c, 0x0c, 0xa8, 0xcd, 0xc4, 0x62, 0xe8, 0x08, 0x47, 0x0c, 0xc1, 0x42,
+	0x83, 0x39, 0x4c, 0x30, 0xc0, 0x49, 0x5d, 0xed, 0xf7, 0x4b, 0xc4, 0x07, 0x2e, 0xd9, 0x2c, 0xd5,
+	0xcc, 0xb2, 0xf6, 0x26, 0xc4, 0x33, 0xe7, 0x4b, 0xee, 0xa5, 0x86, 0xe3, 0xb2, 0x81, 0x89, 0x17,
+	0x3f, 0x70, 0x53, 0x75, 0x50, 0xb9, 0x5e, 0x60, 0x57, 0x44, 0x56, 0x10, 0xab, 0xdc, 0xc0, 0x97,
+	0x62, 0xa1, 0x54, 0xeb, 0xa7, 0x8e, 0xda, 0x57, 0x44, 0x12, 0x4a, 0x7c, 0x7e, 0xed, 0x12, 0xc7,
+	0xd7, 0x27, 0x08, 0x81, 0x0d, 0xc2, 0x63, 0xed, 0x44, 0x3b, 0x6d, 0x9e, 0x1f, 0xda, 0x39, 0xc4,
+	0xce, 0xd5, 0x51, 0x27, 0x0e, 0xcd, 0xe6, 0x2b, 0x59, 0xb8, 0x97, 0x56, 0x32, 0x5b, 0xd3, 0xdd,
+	0x44, 0x9c, 0x48, 0x36, 0xd3, 0xef, 0x50, 0x47, 0x19, 0xb3, 0x9e, 0x78, 0x0b, 0x58, 0x83, 0x2a,
+	0x2b, 0xb3, 0x8c, 0x7a, 0x71, 0x68, 0x76, 0x15, 0x30, 0x5b, 0x5a, 0xd3, 0x76, 0x62, 0xbb, 0x5f,
+	0xcf, 0xfa, 0x2d, 0x6a, 0xa9, 0x7b, 0xea, 0x0b, 0xe1, 0x3a, 0x70, 0x71, 0x95, 0xab, 0xf4, 0xd1,
+	0x7e, 0x1c, 0x9a, 0x6d, 0x05, 0x55, 0x1b, 0x6b, 0x0a, 0x15, 0xc7, 0x30, 0xe8, 0x37, 0xa8, 0x99,
+	0xc6, 0x80, 0xc6, 0xdb, 0x40, 0x3b, 0xfa, 0x27, 0x25, 0x54, 0xee, 0xc6, 0xa1, 0xd9, 0x4a, 0x13,
+	0xaa, 0xce, 0x7b, 0x90, 0x2e, 0x39, 0xe7, 0x28, 0x78, 0x00, 0xbc, 0xb3, 0x09, 0xc5, 0xca, 0x28,
+	0x56, 0x40, 0x8d, 0x01, 0x95, 0x97, 0x84, 0xe7, 0xc2, 0x8d, 0x8d, 0x25, 0x41, 0xff, 0x53, 0x12,
+	0x36, 0xeb, 0x92, 0x4a, 0xee, 0xad, 0xbe, 0x8c, 0xda, 0x2a, 0x32, 0xb4, 0x8f, 0xc8, 0xd0, 0x3e,
+	0x23, 0x43, 0x7b, 0xff, 0x36, 0x6a, 0xb4, 0x01, 0x3f, 0xc1, 0xc5, 0xef, 0x00, 0x2e, 0xc7, 0xd5,
+	0x97, 0x0b, 0x03, 0x00, 0x00,
+}
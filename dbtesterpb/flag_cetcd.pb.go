@@ -15,20 +15,20 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// Flag_Cetcd_Beta is cetcd-specific flags
+// flag__cetcd is cetcd-specific flags
 // (https://github.com/coreos/cetcd).
-type Flag_Cetcd_Beta struct {
+type Flag_Cetcd struct {
 }
 
-func (m *Flag_Cetcd_Beta) Reset()                    { *m = Flag_Cetcd_Beta{} }
-func (m *Flag_Cetcd_Beta) String() string            { return proto.CompactTextString(m) }
-func (*Flag_Cetcd_Beta) ProtoMessage()               {}
-func (*Flag_Cetcd_Beta) Descriptor() ([]byte, []int) { return fileDescriptorFlagCetcd, []int{0} }
+func (m *Flag_Cetcd) Reset()                    { *m = Flag_Cetcd{} }
+func (m *Flag_Cetcd) String() string            { return proto.CompactTextString(m) }
+func (*Flag_Cetcd) ProtoMessage()               {}
+func (*Flag_Cetcd) Descriptor() ([]byte, []int) { return fileDescriptorFlagCetcd, []int{0} }
 
 func init() {
-	proto.RegisterType((*Flag_Cetcd_Beta)(nil), "dbtesterpb.flag__cetcd__beta")
+	proto.RegisterType((*Flag_Cetcd)(nil), "dbtesterpb.flag__cetcd")
 }
-func (m *Flag_Cetcd_Beta) Marshal() (dAtA []byte, err error) {
+func (m *Flag_Cetcd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -38,7 +38,7 @@ func (m *Flag_Cetcd_Beta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Flag_Cetcd_Beta) MarshalTo(dAtA []byte) (int, error) {
+func (m *Flag_Cetcd) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -55,7 +55,7 @@ func encodeVarintFlagCetcd(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
-func (m *Flag_Cetcd_Beta) Size() (n int) {
+func (m *Flag_Cetcd) Size() (n int) {
 	var l int
 	_ = l
 	return n
@@ -74,7 +74,7 @@ func sovFlagCetcd(x uint64) (n int) {
 func sozFlagCetcd(x uint64) (n int) {
 	return sovFlagCetcd(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Flag_Cetcd_Beta) Unmarshal(dAtA []byte) error {
+func (m *Flag_Cetcd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -97,10 +97,10 @@ func (m *Flag_Cetcd_Beta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: flag__cetcd__beta: wiretype end group for non-group")
+			return fmt.Errorf("proto: flag__cetcd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: flag__cetcd__beta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: flag__cetcd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -232,14 +232,13 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_cetcd.proto", fileDescriptorFlagCetcd) }
 
 var fileDescriptorFlagCetcd = []byte{
-	// 130 bytes of a gzipped FileDescriptorProto
+	// 121 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x49, 0x2a, 0x49,
 	0x2d, 0x2e, 0x49, 0x2d, 0x2a, 0x48, 0xd2, 0x4f, 0xcb, 0x49, 0x4c, 0x8f, 0x4f, 0x4e, 0x2d, 0x49,
 	0x4e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0x48, 0x4a, 0xe9, 0xa6, 0x67, 0x96,
 	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa7, 0xe7, 0xa7, 0xe7, 0xeb, 0x83, 0x95, 0x24,
-	0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0x24, 0xcc, 0x25, 0x08, 0x36, 0x0e,
-	0x62, 0x5e, 0x7c, 0x7c, 0x52, 0x6a, 0x49, 0xa2, 0x93, 0xc8, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x49,
-	0x6c, 0x60, 0x1d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcf, 0x7e, 0x95, 0x6e, 0x8b, 0x00,
-	0x00, 0x00,
+	0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0xc4, 0xcb, 0xc5, 0x0d, 0x36, 0x0e,
+	0x62, 0x9e, 0x93, 0xc8, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
+	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x49, 0x6c, 0x60, 0xb5, 0xc6, 0x80, 0x01,
+	0x00, 0xb7, 0x2b, 0x19, 0xcb, 0x85, 0x00, 0x00, 0x00,
 }
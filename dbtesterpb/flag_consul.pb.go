@@ -16,18 +16,32 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 // See https://github.com/hashicorp/consul for more.
-type Flag_Consul_V1_0_2 struct {
+// Each member writes them to a JSON configuration file.
+type Flag_Consul struct {
+	// RaftMultiplier is 'performance.raft_multiplier', which scales the Raft
+	// timing between 1 (production) and 10. If zero, Consul uses 5, which is
+	// tuned for development.
+	// See https://www.consul.io/docs/guides/performance.html for more.
+	RaftMultiplier uint32 `protobuf:"varint,1,opt,name=RaftMultiplier,proto3" json:"RaftMultiplier,omitempty" yaml:"raft_multiplier"`
+	// RaftSnapshotThreshold is the minimum number of commits between snapshots.
+	RaftSnapshotThreshold int64 `protobuf:"varint,2,opt,name=RaftSnapshotThreshold,proto3" json:"RaftSnapshotThreshold,omitempty" yaml:"raft_snapshot_threshold"`
+	// RaftSnapshotInterval is the interval to check whether to snapshot (e.g. "30s").
+	RaftSnapshotInterval string `protobuf:"bytes,3,opt,name=RaftSnapshotInterval,proto3" json:"RaftSnapshotInterval,omitempty" yaml:"raft_snapshot_interval"`
+	// LeaveOnTerminate is true to leave the cluster gracefully on SIGTERM.
+	LeaveOnTerminate bool `protobuf:"varint,4,opt,name=LeaveOnTerminate,proto3" json:"LeaveOnTerminate,omitempty" yaml:"leave_on_terminate"`
+	// Datacenter is the datacenter name, "dc1" by default.
+	Datacenter string `protobuf:"bytes,5,opt,name=Datacenter,proto3" json:"Datacenter,omitempty" yaml:"datacenter"`
 }
 
-func (m *Flag_Consul_V1_0_2) Reset()                    { *m = Flag_Consul_V1_0_2{} }
-func (m *Flag_Consul_V1_0_2) String() string            { return proto.CompactTextString(m) }
-func (*Flag_Consul_V1_0_2) ProtoMessage()               {}
-func (*Flag_Consul_V1_0_2) Descriptor() ([]byte, []int) { return fileDescriptorFlagConsul, []int{0} }
+func (m *Flag_Consul) Reset()                    { *m = Flag_Consul{} }
+func (m *Flag_Consul) String() string            { return proto.CompactTextString(m) }
+func (*Flag_Consul) ProtoMessage()               {}
+func (*Flag_Consul) Descriptor() ([]byte, []int) { return fileDescriptorFlagConsul, []int{0} }
 
 func init() {
-	proto.RegisterType((*Flag_Consul_V1_0_2)(nil), "dbtesterpb.flag__consul__v1_0_2")
+	proto.RegisterType((*Flag_Consul)(nil), "dbtesterpb.flag__consul")
 }
-func (m *Flag_Consul_V1_0_2) Marshal() (dAtA []byte, err error) {
+func (m *Flag_Consul) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -37,11 +51,43 @@ func (m *Flag_Consul_V1_0_2) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Flag_Consul_V1_0_2) MarshalTo(dAtA []byte) (int, error) {
+func (m *Flag_Consul) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.RaftMultiplier != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(m.RaftMultiplier))
+	}
+	if m.RaftSnapshotThreshold != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(m.RaftSnapshotThreshold))
+	}
+	if len(m.RaftSnapshotInterval) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(len(m.RaftSnapshotInterval)))
+		i += copy(dAtA[i:], m.RaftSnapshotInterval)
+	}
+	if m.LeaveOnTerminate {
+		dAtA[i] = 0x20
+		i++
+		if m.LeaveOnTerminate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Datacenter) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFlagConsul(dAtA, i, uint64(len(m.Datacenter)))
+		i += copy(dAtA[i:], m.Datacenter)
+	}
 	return i, nil
 }
 
@@ -54,9 +100,26 @@ func encodeVarintFlagConsul(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
-func (m *Flag_Consul_V1_0_2) Size() (n int) {
+func (m *Flag_Consul) Size() (n int) {
 	var l int
 	_ = l
+	if m.RaftMultiplier != 0 {
+		n += 1 + sovFlagConsul(uint64(m.RaftMultiplier))
+	}
+	if m.RaftSnapshotThreshold != 0 {
+		n += 1 + sovFlagConsul(uint64(m.RaftSnapshotThreshold))
+	}
+	l = len(m.RaftSnapshotInterval)
+	if l > 0 {
+		n += 1 + l + sovFlagConsul(uint64(l))
+	}
+	if m.LeaveOnTerminate {
+		n += 2
+	}
+	l = len(m.Datacenter)
+	if l > 0 {
+		n += 1 + l + sovFlagConsul(uint64(l))
+	}
 	return n
 }
 
@@ -73,7 +136,7 @@ func sovFlagConsul(x uint64) (n int) {
 func sozFlagConsul(x uint64) (n int) {
 	return sovFlagConsul(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Flag_Consul_V1_0_2) Unmarshal(dAtA []byte) error {
+func (m *Flag_Consul) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -96,12 +159,128 @@ func (m *Flag_Consul_V1_0_2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: flag__consul__v1_0_2: wiretype end group for non-group")
+			return fmt.Errorf("proto: flag__consul: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: flag__consul__v1_0_2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: flag__consul: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftMultiplier", wireType)
+			}
+			m.RaftMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftMultiplier |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftSnapshotThreshold", wireType)
+			}
+			m.RaftSnapshotThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaftSnapshotThreshold |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftSnapshotInterval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagConsul
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RaftSnapshotInterval = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaveOnTerminate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeaveOnTerminate = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datacenter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFlagConsul
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFlagConsul
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Datacenter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFlagConsul(dAtA[iNdEx:])
@@ -231,14 +410,26 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_consul.proto", fileDescriptorFlagConsul) }
 
 var fileDescriptorFlagConsul = []byte{
-	// 133 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x49, 0x2a, 0x49,
-	0x2d, 0x2e, 0x49, 0x2d, 0x2a, 0x48, 0xd2, 0x4f, 0xcb, 0x49, 0x4c, 0x8f, 0x4f, 0xce, 0xcf, 0x2b,
-	0x2e, 0xcd, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0xc8, 0x4a, 0xe9, 0xa6, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa7, 0xe7, 0xa7, 0xe7, 0xeb, 0x83, 0x95,
-	0x24, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0x24, 0xc6, 0x25, 0x02, 0x36,
-	0x0f, 0x6a, 0x60, 0x7c, 0x7c, 0x99, 0x61, 0xbc, 0x41, 0xbc, 0x91, 0x93, 0xc8, 0x89, 0x87, 0x72,
-	0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7,
-	0x72, 0x0c, 0x49, 0x6c, 0x60, 0x4d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xe5, 0xaa,
-	0xa0, 0x8f, 0x00, 0x00, 0x00,
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x4d, 0x4a, 0xf3, 0x40,
+	0x1c, 0xc6, 0x3b, 0x6f, 0x5f, 0x45, 0x07, 0x15, 0x0d, 0xad, 0xc4, 0x62, 0x93, 0x38, 0xab, 0x6c,
+	0x6c, 0x17, 0xe2, 0xc6, 0x65, 0x70, 0x53, 0x50, 0x84, 0x58, 0xc1, 0x5d, 0x98, 0xb4, 0x93, 0x0f,
+	0x98, 0xcc, 0x84, 0xc9, 0x3f, 0x05, 0x0f, 0xe0, 0x1d, 0x3c, 0x52, 0x97, 0x9e, 0x20, 0x68, 0xbd,
+	0x41, 0x4e, 0x20, 0x9d, 0x7e, 0x58, 0xb4, 0xbb, 0x79, 0xe6, 0xf9, 0x3d, 0x3f, 0x06, 0x06, 0x9f,
+	0x8f, 0x43, 0x60, 0x05, 0x30, 0x95, 0x87, 0xfd, 0x88, 0xd3, 0x38, 0x18, 0x49, 0x51, 0x94, 0xbc,
+	0x97, 0x2b, 0x09, 0xd2, 0xc0, 0x3f, 0x6d, 0xe7, 0x32, 0x4e, 0x21, 0x29, 0xc3, 0xde, 0x48, 0x66,
+	0xfd, 0x58, 0xc6, 0xb2, 0xaf, 0x91, 0xb0, 0x8c, 0x74, 0xd2, 0x41, 0x9f, 0x16, 0x53, 0xf2, 0xda,
+	0xc4, 0x07, 0x5a, 0xb8, 0x34, 0x1a, 0x1e, 0x3e, 0xf2, 0x69, 0x04, 0xf7, 0x25, 0x87, 0x34, 0xe7,
+	0x29, 0x53, 0x26, 0x72, 0x90, 0x7b, 0xe8, 0x75, 0xea, 0xca, 0x3e, 0x7d, 0xa1, 0x19, 0xbf, 0x21,
+	0x8a, 0x46, 0x10, 0x64, 0x6b, 0x80, 0xf8, 0xbf, 0x16, 0xc6, 0x33, 0x6e, 0xcf, 0x6f, 0x1e, 0x05,
+	0xcd, 0x8b, 0x44, 0xc2, 0x30, 0x51, 0xac, 0x48, 0x24, 0x1f, 0x9b, 0xff, 0x1c, 0xe4, 0x36, 0x3d,
+	0x52, 0x57, 0xb6, 0xb5, 0xa1, 0x2a, 0x96, 0x5c, 0x00, 0x2b, 0x90, 0xf8, 0xdb, 0x05, 0xc6, 0x13,
+	0x6e, 0x6d, 0x16, 0x03, 0x01, 0x4c, 0x4d, 0x28, 0x37, 0x9b, 0x0e, 0x72, 0xf7, 0xbd, 0x8b, 0xba,
+	0xb2, 0xbb, 0xdb, 0xc4, 0xe9, 0x92, 0x23, 0xfe, 0xd6, 0xb9, 0x31, 0xc0, 0xc7, 0x77, 0x8c, 0x4e,
+	0xd8, 0x83, 0x18, 0x32, 0x95, 0xa5, 0x82, 0x02, 0x33, 0xff, 0x3b, 0xc8, 0xdd, 0xf3, 0xba, 0x75,
+	0x65, 0x9f, 0x2d, 0x94, 0x7c, 0x4e, 0x04, 0x52, 0x04, 0xb0, 0x62, 0x88, 0xff, 0x67, 0x66, 0x5c,
+	0x63, 0x7c, 0x4b, 0x81, 0x8e, 0xd8, 0xdc, 0x6d, 0xee, 0xe8, 0x77, 0xb5, 0xeb, 0xca, 0x3e, 0x59,
+	0x48, 0xc6, 0xeb, 0x8e, 0xf8, 0x1b, 0xa0, 0xd7, 0x9a, 0x7e, 0x5a, 0x8d, 0xe9, 0xcc, 0x42, 0xef,
+	0x33, 0x0b, 0x7d, 0xcc, 0x2c, 0xf4, 0xf6, 0x65, 0x35, 0xc2, 0x5d, 0xfd, 0x49, 0x57, 0xdf, 0x03,
+	0x00, 0x5d, 0x0d, 0x47, 0xfd, 0xff, 0x01, 0x00, 0x00,
 }
@@ -15,20 +15,20 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-// Flag_Zetcd_Beta is zetcd-specific flags
+// flag__zetcd is zetcd-specific flags
 // (https://github.com/coreos/zetcd).
-type Flag_Zetcd_Beta struct {
+type Flag_Zetcd struct {
 }
 
-func (m *Flag_Zetcd_Beta) Reset()                    { *m = Flag_Zetcd_Beta{} }
-func (m *Flag_Zetcd_Beta) String() string            { return proto.CompactTextString(m) }
-func (*Flag_Zetcd_Beta) ProtoMessage()               {}
-func (*Flag_Zetcd_Beta) Descriptor() ([]byte, []int) { return fileDescriptorFlagZetcd, []int{0} }
+func (m *Flag_Zetcd) Reset()                    { *m = Flag_Zetcd{} }
+func (m *Flag_Zetcd) String() string            { return proto.CompactTextString(m) }
+func (*Flag_Zetcd) ProtoMessage()               {}
+func (*Flag_Zetcd) Descriptor() ([]byte, []int) { return fileDescriptorFlagZetcd, []int{0} }
 
 func init() {
-	proto.RegisterType((*Flag_Zetcd_Beta)(nil), "dbtesterpb.flag__zetcd__beta")
+	proto.RegisterType((*Flag_Zetcd)(nil), "dbtesterpb.flag__zetcd")
 }
-func (m *Flag_Zetcd_Beta) Marshal() (dAtA []byte, err error) {
+func (m *Flag_Zetcd) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -38,7 +38,7 @@ func (m *Flag_Zetcd_Beta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Flag_Zetcd_Beta) MarshalTo(dAtA []byte) (int, error) {
+func (m *Flag_Zetcd) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -55,7 +55,7 @@ func encodeVarintFlagZetcd(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
-func (m *Flag_Zetcd_Beta) Size() (n int) {
+func (m *Flag_Zetcd) Size() (n int) {
 	var l int
 	_ = l
 	return n
@@ -74,7 +74,7 @@ func sovFlagZetcd(x uint64) (n int) {
 func sozFlagZetcd(x uint64) (n int) {
 	return sovFlagZetcd(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Flag_Zetcd_Beta) Unmarshal(dAtA []byte) error {
+func (m *Flag_Zetcd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -97,10 +97,10 @@ func (m *Flag_Zetcd_Beta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: flag__zetcd__beta: wiretype end group for non-group")
+			return fmt.Errorf("proto: flag__zetcd: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: flag__zetcd__beta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: flag__zetcd: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -232,14 +232,13 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_zetcd.proto", fileDescriptorFlagZetcd) }
 
 var fileDescriptorFlagZetcd = []byte{
-	// 130 bytes of a gzipped FileDescriptorProto
+	// 121 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0x49, 0x2a, 0x49,
 	0x2d, 0x2e, 0x49, 0x2d, 0x2a, 0x48, 0xd2, 0x4f, 0xcb, 0x49, 0x4c, 0x8f, 0xaf, 0x4a, 0x2d, 0x49,
 	0x4e, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0x48, 0x4a, 0xe9, 0xa6, 0x67, 0x96,
 	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xa7, 0xe7, 0xa7, 0xe7, 0xeb, 0x83, 0x95, 0x24,
-	0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0x24, 0xcc, 0x25, 0x08, 0x36, 0x0e,
-	0x62, 0x5e, 0x7c, 0x7c, 0x52, 0x6a, 0x49, 0xa2, 0x93, 0xc8, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x49,
-	0x6c, 0x60, 0x1d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xb7, 0x0d, 0x03, 0x8b, 0x00,
-	0x00, 0x00,
+	0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0xaa, 0xc4, 0xcb, 0xc5, 0x0d, 0x36, 0x0e,
+	0x62, 0x9e, 0x93, 0xc8, 0x89, 0x87, 0x72, 0x0c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
+	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x8c, 0xc7, 0x72, 0x0c, 0x49, 0x6c, 0x60, 0xb5, 0xc6, 0x80, 0x01,
+	0x00, 0x2d, 0xbe, 0x5b, 0x5d, 0x85, 0x00, 0x00, 0x00,
 }
@@ -15,7 +15,7 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
-type Flag_Zookeeper_R3_5_3Beta struct {
+type Flag_Zookeeper struct {
 	// JavaDJuteMaxBuffer is for '-Djute.maxbuffer' flag.
 	// It is the maximum size, in bytes, of a request or response.
 	// See http://zookeeper.apache.org/doc/trunk/zookeeperAdmin.html#Unsafe+Options for more.
@@ -42,17 +42,15 @@ type Flag_Zookeeper_R3_5_3Beta struct {
 	MaxClientConnections int64 `protobuf:"varint,106,opt,name=MaxClientConnections,proto3" json:"MaxClientConnections,omitempty" yaml:"max_client_connections"`
 }
 
-func (m *Flag_Zookeeper_R3_5_3Beta) Reset()         { *m = Flag_Zookeeper_R3_5_3Beta{} }
-func (m *Flag_Zookeeper_R3_5_3Beta) String() string { return proto.CompactTextString(m) }
-func (*Flag_Zookeeper_R3_5_3Beta) ProtoMessage()    {}
-func (*Flag_Zookeeper_R3_5_3Beta) Descriptor() ([]byte, []int) {
-	return fileDescriptorFlagZookeeper, []int{0}
-}
+func (m *Flag_Zookeeper) Reset()                    { *m = Flag_Zookeeper{} }
+func (m *Flag_Zookeeper) String() string            { return proto.CompactTextString(m) }
+func (*Flag_Zookeeper) ProtoMessage()               {}
+func (*Flag_Zookeeper) Descriptor() ([]byte, []int) { return fileDescriptorFlagZookeeper, []int{0} }
 
 func init() {
-	proto.RegisterType((*Flag_Zookeeper_R3_5_3Beta)(nil), "dbtesterpb.flag__zookeeper__r3_5_3_beta")
+	proto.RegisterType((*Flag_Zookeeper)(nil), "dbtesterpb.flag__zookeeper")
 }
-func (m *Flag_Zookeeper_R3_5_3Beta) Marshal() (dAtA []byte, err error) {
+func (m *Flag_Zookeeper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -62,7 +60,7 @@ func (m *Flag_Zookeeper_R3_5_3Beta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Flag_Zookeeper_R3_5_3Beta) MarshalTo(dAtA []byte) (int, error) {
+func (m *Flag_Zookeeper) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -145,7 +143,7 @@ func encodeVarintFlagZookeeper(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
-func (m *Flag_Zookeeper_R3_5_3Beta) Size() (n int) {
+func (m *Flag_Zookeeper) Size() (n int) {
 	var l int
 	_ = l
 	if m.JavaDJuteMaxBuffer != 0 {
@@ -196,7 +194,7 @@ func sovFlagZookeeper(x uint64) (n int) {
 func sozFlagZookeeper(x uint64) (n int) {
 	return sovFlagZookeeper(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Flag_Zookeeper_R3_5_3Beta) Unmarshal(dAtA []byte) error {
+func (m *Flag_Zookeeper) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -219,10 +217,10 @@ func (m *Flag_Zookeeper_R3_5_3Beta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: flag__zookeeper__r3_5_3_beta: wiretype end group for non-group")
+			return fmt.Errorf("proto: flag__zookeeper: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: flag__zookeeper__r3_5_3_beta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: flag__zookeeper: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -564,32 +562,31 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/flag_zookeeper.proto", fileDescriptorFlagZookeeper) }
 
 var fileDescriptorFlagZookeeper = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8e, 0xd3, 0x30,
-	0x14, 0x87, 0xc7, 0x4c, 0x05, 0x8c, 0x25, 0x04, 0x98, 0x22, 0x59, 0x08, 0x92, 0x92, 0x55, 0x37,
-	0x33, 0x45, 0xaa, 0xd8, 0xb0, 0x4c, 0x67, 0x33, 0x23, 0x2a, 0x41, 0x18, 0x24, 0x76, 0x96, 0xe3,
-	0x3a, 0x19, 0xb7, 0xb5, 0x1d, 0x25, 0x2f, 0xa3, 0x94, 0x93, 0x70, 0x07, 0x2e, 0x32, 0x4b, 0x4e,
-	0x50, 0x41, 0xb9, 0x41, 0x4e, 0x80, 0xe2, 0x68, 0x92, 0x0a, 0xca, 0xee, 0xfd, 0xf9, 0xbe, 0x5f,
-	0x14, 0x3d, 0x63, 0x7f, 0x11, 0x83, 0x2c, 0x40, 0xe6, 0x59, 0x3c, 0x49, 0xd6, 0x3c, 0x65, 0x5f,
-	0xad, 0x5d, 0x49, 0x99, 0xc9, 0xfc, 0x2c, 0xcb, 0x2d, 0x58, 0x82, 0x7b, 0xe0, 0xc5, 0x69, 0xaa,
-	0xe0, 0xba, 0x8c, 0xcf, 0x84, 0xd5, 0x93, 0xd4, 0xa6, 0x76, 0xe2, 0x90, 0xb8, 0x4c, 0x5c, 0xe7,
-	0x1a, 0x57, 0xb5, 0x6a, 0xf0, 0x7d, 0x80, 0x5f, 0xba, 0xcc, 0x3e, 0x94, 0xb1, 0x7c, 0xca, 0xde,
-	0xb2, 0x29, 0x8b, 0x25, 0x70, 0xf2, 0x11, 0x93, 0x4b, 0x7e, 0xc3, 0xcf, 0x2f, 0x4b, 0x90, 0x73,
-	0x5e, 0x85, 0x65, 0x92, 0xc8, 0x9c, 0xa2, 0x11, 0x1a, 0x0f, 0xc2, 0xd7, 0xf5, 0xd6, 0x7f, 0xb5,
-	0xe1, 0x7a, 0xfd, 0x2e, 0x58, 0xf2, 0x1b, 0xce, 0x16, 0x6c, 0x59, 0x82, 0x64, 0x9a, 0x57, 0x2c,
-	0x76, 0x5c, 0x10, 0x1d, 0x90, 0xc9, 0x29, 0x7e, 0xd0, 0x4c, 0xbf, 0xe8, 0x82, 0xde, 0x1b, 0xa1,
-	0xf1, 0x49, 0xf8, 0xac, 0xde, 0xfa, 0x8f, 0xf7, 0x72, 0x2a, 0x5d, 0x04, 0xd1, 0x1d, 0xd3, 0xe3,
-	0x15, 0x3d, 0xfe, 0x0f, 0x5e, 0x75, 0x78, 0x45, 0x08, 0x1e, 0xcc, 0x37, 0x17, 0xe7, 0x74, 0x31,
-	0x42, 0xe3, 0x47, 0x91, 0xab, 0x89, 0x87, 0xf1, 0x6c, 0xad, 0xa4, 0x81, 0x0f, 0x36, 0x07, 0x2a,
-	0x47, 0x68, 0x7c, 0x1c, 0xed, 0x4d, 0xc8, 0x1b, 0xfc, 0xf0, 0x4a, 0x89, 0xd5, 0x95, 0xd2, 0x92,
-	0x26, 0xcd, 0x36, 0x1c, 0xd6, 0x5b, 0xff, 0x49, 0xfb, 0x0d, 0x50, 0x62, 0xc5, 0x40, 0x69, 0x19,
-	0x44, 0x1d, 0x45, 0xa6, 0xf8, 0xe4, 0xc2, 0x28, 0x78, 0xaf, 0xb4, 0x02, 0x9a, 0x3a, 0xe5, 0x79,
-	0xbd, 0xf5, 0x9f, 0xb6, 0x8a, 0x32, 0x0a, 0xd8, 0xba, 0xd9, 0x05, 0x51, 0xcf, 0x35, 0xd2, 0xa7,
-	0x8d, 0x11, 0xad, 0x74, 0xfd, 0xb7, 0x54, 0x6c, 0x8c, 0xe8, 0xa4, 0x8e, 0x73, 0x92, 0xe1, 0xd9,
-	0xcc, 0x96, 0x06, 0xa8, 0xfa, 0x47, 0x32, 0x3c, 0x63, 0xa2, 0xd9, 0x35, 0xd2, 0x1d, 0x47, 0x3e,
-	0xe3, 0xe1, 0x9c, 0x57, 0xed, 0x1f, 0xce, 0xac, 0x31, 0x52, 0x80, 0xb2, 0xa6, 0xa0, 0x4b, 0xe7,
-	0xef, 0xdd, 0xad, 0xb9, 0x95, 0x70, 0x18, 0x13, 0x3d, 0x17, 0x44, 0x07, 0xf5, 0x70, 0x78, 0xfb,
-	0xcb, 0x3b, 0xba, 0xdd, 0x79, 0xe8, 0xc7, 0xce, 0x43, 0x3f, 0x77, 0x1e, 0xfa, 0xf6, 0xdb, 0x3b,
-	0x8a, 0xef, 0xbb, 0xa7, 0x34, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x63, 0xdf, 0x89, 0x1b, 0xa8,
-	0x02, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0x67, 0x56, 0x01, 0xb3, 0x84, 0x06, 0xa6, 0x48, 0x16, 0x12, 0x49, 0xf1, 0xa9, 0x97,
+	0xad, 0x48, 0xbb, 0x71, 0x4c, 0x77, 0xd9, 0x44, 0x25, 0x30, 0x43, 0xe2, 0x66, 0x39, 0xae, 0x93,
+	0xb9, 0xad, 0xed, 0x28, 0x71, 0xa6, 0x94, 0x27, 0xe1, 0xc4, 0xf3, 0xec, 0xc8, 0x13, 0x44, 0x50,
+	0xde, 0x20, 0x4f, 0x80, 0xe2, 0x88, 0xa4, 0x82, 0x72, 0xfb, 0xfd, 0xf9, 0x7c, 0xbe, 0x51, 0xf4,
+	0x33, 0x0c, 0x97, 0xb1, 0x93, 0x85, 0x93, 0x79, 0x16, 0xcf, 0x92, 0x0d, 0x4f, 0xd9, 0x17, 0x6b,
+	0xd7, 0x52, 0x66, 0x32, 0x3f, 0xcf, 0x72, 0xeb, 0x2c, 0x82, 0x03, 0xf0, 0xf2, 0x2c, 0x55, 0xee,
+	0xb6, 0x8c, 0xcf, 0x85, 0xd5, 0xb3, 0xd4, 0xa6, 0x76, 0xe6, 0x91, 0xb8, 0x4c, 0x7c, 0xe7, 0x1b,
+	0x5f, 0x75, 0x2a, 0xf9, 0x36, 0x82, 0xa7, 0x3e, 0x73, 0x08, 0x45, 0x1f, 0x20, 0xba, 0xe6, 0x77,
+	0xfc, 0xf2, 0xba, 0x74, 0x72, 0xc1, 0xab, 0xa8, 0x4c, 0x12, 0x99, 0x63, 0x30, 0x01, 0xd3, 0x51,
+	0xf4, 0xba, 0xa9, 0xc3, 0x57, 0x5b, 0xae, 0x37, 0x6f, 0xc9, 0x8a, 0xdf, 0x71, 0xb6, 0x64, 0xab,
+	0xd2, 0x49, 0xa6, 0x79, 0xc5, 0x62, 0xcf, 0x11, 0x7a, 0x40, 0x46, 0x67, 0xf0, 0x51, 0x3b, 0xfd,
+	0xac, 0x0b, 0xfc, 0x60, 0x02, 0xa6, 0x27, 0xd1, 0xf3, 0xa6, 0x0e, 0x4f, 0xf7, 0x72, 0x2a, 0x5d,
+	0x10, 0xfa, 0x87, 0x19, 0xf0, 0x0a, 0x1f, 0xff, 0x07, 0xaf, 0x7a, 0xbc, 0x42, 0x08, 0x8e, 0x16,
+	0xdb, 0xab, 0x4b, 0xbc, 0x9c, 0x80, 0xe9, 0x13, 0xea, 0x6b, 0x14, 0x40, 0x38, 0xdf, 0x28, 0x69,
+	0xdc, 0x7b, 0x9b, 0x3b, 0x2c, 0x27, 0x60, 0x7a, 0x4c, 0xf7, 0x26, 0xe8, 0x0d, 0x7c, 0x7c, 0xa3,
+	0xc4, 0xfa, 0x46, 0x69, 0x89, 0x93, 0x76, 0x1b, 0x8d, 0x9b, 0x3a, 0x7c, 0xda, 0x7d, 0xc3, 0x29,
+	0xb1, 0x66, 0x4e, 0x69, 0x49, 0x68, 0x4f, 0xa1, 0x0b, 0x78, 0x72, 0x65, 0x94, 0x7b, 0xa7, 0xb4,
+	0x72, 0x38, 0xf5, 0xca, 0x8b, 0xa6, 0x0e, 0x9f, 0x75, 0x8a, 0x32, 0xca, 0xb1, 0x4d, 0xbb, 0x23,
+	0x74, 0xe0, 0x5a, 0xe9, 0xe3, 0xd6, 0x88, 0x4e, 0xba, 0xfd, 0x5b, 0x2a, 0xb6, 0x46, 0xf4, 0x52,
+	0xcf, 0x79, 0xc9, 0xf0, 0x6c, 0x6e, 0x4b, 0xe3, 0xb0, 0xfa, 0x47, 0x32, 0x3c, 0x63, 0xa2, 0xdd,
+	0x11, 0x3a, 0x70, 0xe8, 0x13, 0x1c, 0x2f, 0x78, 0xd5, 0xfd, 0xe1, 0xdc, 0x1a, 0x23, 0x85, 0x53,
+	0xd6, 0x14, 0x78, 0xe5, 0xfd, 0xbd, 0xbb, 0xb5, 0xb7, 0x12, 0x1e, 0x63, 0x62, 0xe0, 0x08, 0x3d,
+	0xa8, 0x47, 0xe3, 0xfb, 0x9f, 0xc1, 0xd1, 0xfd, 0x2e, 0x00, 0xdf, 0x77, 0x01, 0xf8, 0xb1, 0x0b,
+	0xc0, 0xd7, 0x5f, 0xc1, 0x51, 0xfc, 0xd0, 0xbf, 0x9e, 0x8b, 0xdf, 0x03, 0x00, 0xd4, 0xd8, 0xde,
+	0x8f, 0x9b, 0x02, 0x00, 0x00,
 }
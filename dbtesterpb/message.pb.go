@@ -11,6 +11,8 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
+import binary "encoding/binary"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -24,17 +26,74 @@ const (
 	Operation_Start     Operation = 0
 	Operation_Stop      Operation = 1
 	Operation_Heartbeat Operation = 2
+	// Kill sends SIGKILL to the database process.
+	Operation_Kill Operation = 3
+	// Restart starts the database again, with the same data directory.
+	Operation_Restart Operation = 4
+	// Pause sends SIGSTOP to the database process.
+	Operation_Pause Operation = 5
+	// Resume sends SIGCONT to the paused database process.
+	Operation_Resume Operation = 6
+	// ApplyNetworkFault applies 'NetworkFault' between this member and its peers.
+	Operation_ApplyNetworkFault Operation = 7
+	// RemoveNetworkFault removes all network faults applied by this agent.
+	Operation_RemoveNetworkFault Operation = 8
+	// Join adds this member to the running cluster of 'ClusterIPIndexes',
+	// and starts the database with an empty data directory.
+	Operation_Join Operation = 9
+	// Leave removes this member from the cluster of 'ClusterIPIndexes',
+	// and stops the database.
+	Operation_Leave Operation = 10
+	// Upgrade stops the database, and restarts it with the binary of
+	// 'UpgradeDatabaseID' on the existing data directory.
+	Operation_Upgrade Operation = 11
+	// SnapshotSave saves the snapshot of the database on the agent machine.
+	Operation_SnapshotSave Operation = 12
+	// SnapshotRecover wipes the data directory of this member, and recovers
+	// it in the running cluster. Zookeeper starts the member from the saved
+	// snapshot and transaction logs. Consul restores the saved snapshot
+	// through the leader, which replaces the state of the whole cluster.
+	// etcd does not support it, since a member restored from a snapshot
+	// file cannot rejoin the running cluster.
+	Operation_SnapshotRecover Operation = 13
+	// CaptureDiagnostics captures the profiles (Go) or thread dumps (Java)
+	// of the running database, to be uploaded with the database log.
+	Operation_CaptureDiagnostics Operation = 14
 )
 
 var Operation_name = map[int32]string{
-	0: "Start",
-	1: "Stop",
-	2: "Heartbeat",
+	0:  "Start",
+	1:  "Stop",
+	2:  "Heartbeat",
+	3:  "Kill",
+	4:  "Restart",
+	5:  "Pause",
+	6:  "Resume",
+	7:  "ApplyNetworkFault",
+	8:  "RemoveNetworkFault",
+	9:  "Join",
+	10: "Leave",
+	11: "Upgrade",
+	12: "SnapshotSave",
+	13: "SnapshotRecover",
+	14: "CaptureDiagnostics",
 }
 var Operation_value = map[string]int32{
-	"Start":     0,
-	"Stop":      1,
-	"Heartbeat": 2,
+	"Start":              0,
+	"Stop":               1,
+	"Heartbeat":          2,
+	"Kill":               3,
+	"Restart":            4,
+	"Pause":              5,
+	"Resume":             6,
+	"ApplyNetworkFault":  7,
+	"RemoveNetworkFault": 8,
+	"Join":               9,
+	"Leave":              10,
+	"Upgrade":            11,
+	"SnapshotSave":       12,
+	"SnapshotRecover":    13,
+	"CaptureDiagnostics": 14,
 }
 
 func (x Operation) String() string {
@@ -42,6 +101,24 @@ func (x Operation) String() string {
 }
 func (Operation) EnumDescriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
 
+// NetworkFault defines network faults from a member to its peers.
+type NetworkFault struct {
+	// Partition drops all packets from and to the target peers.
+	Partition bool `protobuf:"varint,1,opt,name=Partition,proto3" json:"Partition,omitempty"`
+	// DelayMillisecond, JitterMillisecond and LossPercent
+	// are applied to the packets to the target peers with 'tc netem'.
+	DelayMillisecond  int64   `protobuf:"varint,2,opt,name=DelayMillisecond,proto3" json:"DelayMillisecond,omitempty"`
+	JitterMillisecond int64   `protobuf:"varint,3,opt,name=JitterMillisecond,proto3" json:"JitterMillisecond,omitempty"`
+	LossPercent       float64 `protobuf:"fixed64,4,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	// TargetIPIndexes are the indexes of target peers in 'PeerIPsString'.
+	TargetIPIndexes []uint32 `protobuf:"varint,5,rep,packed,name=TargetIPIndexes" json:"TargetIPIndexes,omitempty"`
+}
+
+func (m *NetworkFault) Reset()                    { *m = NetworkFault{} }
+func (m *NetworkFault) String() string            { return proto.CompactTextString(m) }
+func (*NetworkFault) ProtoMessage()               {}
+func (*NetworkFault) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
+
 type Request struct {
 	Operation        Operation  `protobuf:"varint,1,opt,name=Operation,proto3,enum=dbtesterpb.Operation" json:"Operation,omitempty"`
 	TriggerLogUpload bool       `protobuf:"varint,2,opt,name=TriggerLogUpload,proto3" json:"TriggerLogUpload,omitempty"`
@@ -54,34 +131,68 @@ type Request struct {
 	IPIndex                    uint32                      `protobuf:"varint,6,opt,name=IPIndex,proto3" json:"IPIndex,omitempty"`
 	CurrentClientNumber        int64                       `protobuf:"varint,7,opt,name=CurrentClientNumber,proto3" json:"CurrentClientNumber,omitempty"`
 	ConfigClientMachineInitial *ConfigClientMachineInitial `protobuf:"bytes,8,opt,name=ConfigClientMachineInitial" json:"ConfigClientMachineInitial,omitempty"`
-	Flag_Etcd_Other            *Flag_Etcd_Other            `protobuf:"bytes,100,opt,name=flag__etcd__other,json=flagEtcdOther" json:"flag__etcd__other,omitempty"`
-	Flag_Etcd_Tip              *Flag_Etcd_Tip              `protobuf:"bytes,101,opt,name=flag__etcd__tip,json=flagEtcdTip" json:"flag__etcd__tip,omitempty"`
-	Flag_Etcd_V3_2             *Flag_Etcd_V3_2             `protobuf:"bytes,102,opt,name=flag__etcd__v3_2,json=flagEtcdV32" json:"flag__etcd__v3_2,omitempty"`
-	Flag_Etcd_V3_3             *Flag_Etcd_V3_3             `protobuf:"bytes,103,opt,name=flag__etcd__v3_3,json=flagEtcdV33" json:"flag__etcd__v3_3,omitempty"`
-	Flag_Zookeeper_R3_5_3Beta  *Flag_Zookeeper_R3_5_3Beta  `protobuf:"bytes,200,opt,name=flag__zookeeper__r3_5_3_beta,json=flagZookeeperR353Beta" json:"flag__zookeeper__r3_5_3_beta,omitempty"`
-	Flag_Consul_V1_0_2         *Flag_Consul_V1_0_2         `protobuf:"bytes,300,opt,name=flag__consul__v1_0_2,json=flagConsulV102" json:"flag__consul__v1_0_2,omitempty"`
-	Flag_Cetcd_Beta            *Flag_Cetcd_Beta            `protobuf:"bytes,400,opt,name=flag__cetcd__beta,json=flagCetcdBeta" json:"flag__cetcd__beta,omitempty"`
-	Flag_Zetcd_Beta            *Flag_Zetcd_Beta            `protobuf:"bytes,500,opt,name=flag__zetcd__beta,json=flagZetcdBeta" json:"flag__zetcd__beta,omitempty"`
+	NetworkFault               *NetworkFault               `protobuf:"bytes,9,opt,name=NetworkFault" json:"NetworkFault,omitempty"`
+	// ClusterIPIndexes are the indexes in 'PeerIPsString' of the members
+	// in the cluster, after the operation. If empty, all peers are members.
+	ClusterIPIndexes []uint32 `protobuf:"varint,10,rep,packed,name=ClusterIPIndexes" json:"ClusterIPIndexes,omitempty"`
+	// UpgradeDatabaseID is the database to upgrade to.
+	UpgradeDatabaseID DatabaseID `protobuf:"varint,11,opt,name=UpgradeDatabaseID,proto3,enum=dbtesterpb.DatabaseID" json:"UpgradeDatabaseID,omitempty"`
+	// CPUProfileSeconds is the duration of CPU profile that
+	// 'CaptureDiagnostics' takes on Go databases. If zero, none is taken.
+	CPUProfileSeconds int64 `protobuf:"varint,12,opt,name=CPUProfileSeconds,proto3" json:"CPUProfileSeconds,omitempty"`
+	// ResourceLimits are the cgroup limits of the database process, if any.
+	ResourceLimits *ConfigClientMachineResourceLimits `protobuf:"bytes,13,opt,name=ResourceLimits" json:"ResourceLimits,omitempty"`
+	// ExtraOptions are the extra options of the member, merged from
+	// the database and member options.
+	ExtraOptions *ConfigClientMachineExtraOptions `protobuf:"bytes,14,opt,name=ExtraOptions" json:"ExtraOptions,omitempty"`
+	// DatabaseVersion is the expected version of the database, if any,
+	// which the agent checks against the version of the binary.
+	DatabaseVersion string `protobuf:"bytes,15,opt,name=DatabaseVersion,proto3" json:"DatabaseVersion,omitempty"`
+	// DatabaseFlags is the flags of the database family, with the member
+	// specific values (e.g. Zookeeper 'MyID') set.
+	DatabaseFlags `protobuf:"bytes,700,opt,name=DatabaseFlags,embedded=DatabaseFlags" json:"DatabaseFlags"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
 func (m *Request) String() string            { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()               {}
-func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{0} }
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
 
 type Response struct {
 	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
 	// DiskSpaceUsageBytes is the data size of the database on disk in bytes.
-	// It measures after database is requested to stop.
+	// It measures after database is requested to stop. It is the apparent size,
+	// and DiskSpaceAllocatedBytes is the size of the allocated blocks.
 	DiskSpaceUsageBytes int64 `protobuf:"varint,2,opt,name=DiskSpaceUsageBytes,proto3" json:"DiskSpaceUsageBytes,omitempty"`
+	// SnapshotSizeBytes and SnapshotMillisecond are the size of saved snapshot
+	// and the time it took, in response to 'SnapshotSave'.
+	SnapshotSizeBytes   int64 `protobuf:"varint,3,opt,name=SnapshotSizeBytes,proto3" json:"SnapshotSizeBytes,omitempty"`
+	SnapshotMillisecond int64 `protobuf:"varint,4,opt,name=SnapshotMillisecond,proto3" json:"SnapshotMillisecond,omitempty"`
+	// RecoverMillisecond is the time to wipe and recover the member,
+	// in response to 'SnapshotRecover'.
+	RecoverMillisecond int64 `protobuf:"varint,5,opt,name=RecoverMillisecond,proto3" json:"RecoverMillisecond,omitempty"`
+	// ReadyMillisecond is the time from the process start until the database
+	// serves client requests, and LeaderMillisecond is the time until
+	// the member knows the leader, in response to 'Start'.
+	ReadyMillisecond        int64 `protobuf:"varint,6,opt,name=ReadyMillisecond,proto3" json:"ReadyMillisecond,omitempty"`
+	LeaderMillisecond       int64 `protobuf:"varint,7,opt,name=LeaderMillisecond,proto3" json:"LeaderMillisecond,omitempty"`
+	DiskSpaceAllocatedBytes int64 `protobuf:"varint,8,opt,name=DiskSpaceAllocatedBytes,proto3" json:"DiskSpaceAllocatedBytes,omitempty"`
+	// ResourceLimits are the cgroup limits read back after applying them
+	// (e.g. "cpu.max=200000 100000, memory.max=4294967296"), in response
+	// to 'Start'. Empty if not limited.
+	ResourceLimits string `protobuf:"bytes,9,opt,name=ResourceLimits,proto3" json:"ResourceLimits,omitempty"`
+	// DatabaseVersion is the version of the database binary
+	// (e.g. "3.3.1"), in response to 'Start'.
+	DatabaseVersion string `protobuf:"bytes,10,opt,name=DatabaseVersion,proto3" json:"DatabaseVersion,omitempty"`
 }
 
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{1} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{2} }
 
 func init() {
+	proto.RegisterType((*NetworkFault)(nil), "dbtesterpb.NetworkFault")
 	proto.RegisterType((*Request)(nil), "dbtesterpb.Request")
 	proto.RegisterType((*Response)(nil), "dbtesterpb.Response")
 	proto.RegisterEnum("dbtesterpb.Operation", Operation_name, Operation_value)
@@ -159,6 +270,67 @@ var _Transporter_serviceDesc = grpc.ServiceDesc{
 	Metadata: "dbtesterpb/message.proto",
 }
 
+func (m *NetworkFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkFault) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Partition {
+		dAtA[i] = 0x8
+		i++
+		if m.Partition {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DelayMillisecond != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DelayMillisecond))
+	}
+	if m.JitterMillisecond != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.JitterMillisecond))
+	}
+	if m.LossPercent != 0 {
+		dAtA[i] = 0x21
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.LossPercent))))
+		i += 8
+	}
+	if len(m.TargetIPIndexes) > 0 {
+		dAtA2 := make([]byte, len(m.TargetIPIndexes)*10)
+		var j1 int
+		for _, num := range m.TargetIPIndexes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
+	return i, nil
+}
+
 func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -220,108 +392,85 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.ConfigClientMachineInitial.Size()))
-		n1, err := m.ConfigClientMachineInitial.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if m.Flag_Etcd_Other != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Other.Size()))
-		n2, err := m.Flag_Etcd_Other.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if m.Flag_Etcd_Tip != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_Tip.Size()))
-		n3, err := m.Flag_Etcd_Tip.MarshalTo(dAtA[i:])
+		n3, err := m.ConfigClientMachineInitial.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n3
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		dAtA[i] = 0xb2
+	if m.NetworkFault != nil {
+		dAtA[i] = 0x4a
 		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_2.Size()))
-		n4, err := m.Flag_Etcd_V3_2.MarshalTo(dAtA[i:])
+		i = encodeVarintMessage(dAtA, i, uint64(m.NetworkFault.Size()))
+		n4, err := m.NetworkFault.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n4
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Etcd_V3_3.Size()))
-		n5, err := m.Flag_Etcd_V3_3.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.ClusterIPIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.ClusterIPIndexes)*10)
+		var j5 int
+		for _, num := range m.ClusterIPIndexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i += n5
-	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		dAtA[i] = 0xc2
+		dAtA[i] = 0x52
 		i++
-		dAtA[i] = 0xc
+		i = encodeVarintMessage(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if m.UpgradeDatabaseID != 0 {
+		dAtA[i] = 0x58
 		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zookeeper_R3_5_3Beta.Size()))
-		n6, err := m.Flag_Zookeeper_R3_5_3Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+		i = encodeVarintMessage(dAtA, i, uint64(m.UpgradeDatabaseID))
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		dAtA[i] = 0xe2
+	if m.CPUProfileSeconds != 0 {
+		dAtA[i] = 0x60
 		i++
-		dAtA[i] = 0x12
+		i = encodeVarintMessage(dAtA, i, uint64(m.CPUProfileSeconds))
+	}
+	if m.ResourceLimits != nil {
+		dAtA[i] = 0x6a
 		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Consul_V1_0_2.Size()))
-		n7, err := m.Flag_Consul_V1_0_2.MarshalTo(dAtA[i:])
+		i = encodeVarintMessage(dAtA, i, uint64(m.ResourceLimits.Size()))
+		n7, err := m.ResourceLimits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n7
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		dAtA[i] = 0x82
+	if m.ExtraOptions != nil {
+		dAtA[i] = 0x72
 		i++
-		dAtA[i] = 0x19
-		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Cetcd_Beta.Size()))
-		n8, err := m.Flag_Cetcd_Beta.MarshalTo(dAtA[i:])
+		i = encodeVarintMessage(dAtA, i, uint64(m.ExtraOptions.Size()))
+		n8, err := m.ExtraOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
 		i += n8
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1f
+	if len(m.DatabaseVersion) > 0 {
+		dAtA[i] = 0x7a
 		i++
-		i = encodeVarintMessage(dAtA, i, uint64(m.Flag_Zetcd_Beta.Size()))
-		n9, err := m.Flag_Zetcd_Beta.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseVersion)))
+		i += copy(dAtA[i:], m.DatabaseVersion)
+	}
+	dAtA[i] = 0xe2
+	i++
+	dAtA[i] = 0x2b
+	i++
+	i = encodeVarintMessage(dAtA, i, uint64(m.DatabaseFlags.Size()))
+	n9, err := m.DatabaseFlags.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n9
 	return i, nil
 }
 
@@ -355,6 +504,48 @@ func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessage(dAtA, i, uint64(m.DiskSpaceUsageBytes))
 	}
+	if m.SnapshotSizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.SnapshotSizeBytes))
+	}
+	if m.SnapshotMillisecond != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.SnapshotMillisecond))
+	}
+	if m.RecoverMillisecond != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.RecoverMillisecond))
+	}
+	if m.ReadyMillisecond != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReadyMillisecond))
+	}
+	if m.LeaderMillisecond != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.LeaderMillisecond))
+	}
+	if m.DiskSpaceAllocatedBytes != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(m.DiskSpaceAllocatedBytes))
+	}
+	if len(m.ResourceLimits) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ResourceLimits)))
+		i += copy(dAtA[i:], m.ResourceLimits)
+	}
+	if len(m.DatabaseVersion) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.DatabaseVersion)))
+		i += copy(dAtA[i:], m.DatabaseVersion)
+	}
 	return i, nil
 }
 
@@ -367,6 +558,31 @@ func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
+func (m *NetworkFault) Size() (n int) {
+	var l int
+	_ = l
+	if m.Partition {
+		n += 2
+	}
+	if m.DelayMillisecond != 0 {
+		n += 1 + sovMessage(uint64(m.DelayMillisecond))
+	}
+	if m.JitterMillisecond != 0 {
+		n += 1 + sovMessage(uint64(m.JitterMillisecond))
+	}
+	if m.LossPercent != 0 {
+		n += 9
+	}
+	if len(m.TargetIPIndexes) > 0 {
+		l = 0
+		for _, e := range m.TargetIPIndexes {
+			l += sovMessage(uint64(e))
+		}
+		n += 1 + sovMessage(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *Request) Size() (n int) {
 	var l int
 	_ = l
@@ -397,38 +613,37 @@ func (m *Request) Size() (n int) {
 		l = m.ConfigClientMachineInitial.Size()
 		n += 1 + l + sovMessage(uint64(l))
 	}
-	if m.Flag_Etcd_Other != nil {
-		l = m.Flag_Etcd_Other.Size()
-		n += 2 + l + sovMessage(uint64(l))
-	}
-	if m.Flag_Etcd_Tip != nil {
-		l = m.Flag_Etcd_Tip.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.NetworkFault != nil {
+		l = m.NetworkFault.Size()
+		n += 1 + l + sovMessage(uint64(l))
 	}
-	if m.Flag_Etcd_V3_2 != nil {
-		l = m.Flag_Etcd_V3_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if len(m.ClusterIPIndexes) > 0 {
+		l = 0
+		for _, e := range m.ClusterIPIndexes {
+			l += sovMessage(uint64(e))
+		}
+		n += 1 + sovMessage(uint64(l)) + l
 	}
-	if m.Flag_Etcd_V3_3 != nil {
-		l = m.Flag_Etcd_V3_3.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.UpgradeDatabaseID != 0 {
+		n += 1 + sovMessage(uint64(m.UpgradeDatabaseID))
 	}
-	if m.Flag_Zookeeper_R3_5_3Beta != nil {
-		l = m.Flag_Zookeeper_R3_5_3Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.CPUProfileSeconds != 0 {
+		n += 1 + sovMessage(uint64(m.CPUProfileSeconds))
 	}
-	if m.Flag_Consul_V1_0_2 != nil {
-		l = m.Flag_Consul_V1_0_2.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.ResourceLimits != nil {
+		l = m.ResourceLimits.Size()
+		n += 1 + l + sovMessage(uint64(l))
 	}
-	if m.Flag_Cetcd_Beta != nil {
-		l = m.Flag_Cetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	if m.ExtraOptions != nil {
+		l = m.ExtraOptions.Size()
+		n += 1 + l + sovMessage(uint64(l))
 	}
-	if m.Flag_Zetcd_Beta != nil {
-		l = m.Flag_Zetcd_Beta.Size()
-		n += 2 + l + sovMessage(uint64(l))
+	l = len(m.DatabaseVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
 	}
+	l = m.DatabaseFlags.Size()
+	n += 2 + l + sovMessage(uint64(l))
 	return n
 }
 
@@ -441,6 +656,32 @@ func (m *Response) Size() (n int) {
 	if m.DiskSpaceUsageBytes != 0 {
 		n += 1 + sovMessage(uint64(m.DiskSpaceUsageBytes))
 	}
+	if m.SnapshotSizeBytes != 0 {
+		n += 1 + sovMessage(uint64(m.SnapshotSizeBytes))
+	}
+	if m.SnapshotMillisecond != 0 {
+		n += 1 + sovMessage(uint64(m.SnapshotMillisecond))
+	}
+	if m.RecoverMillisecond != 0 {
+		n += 1 + sovMessage(uint64(m.RecoverMillisecond))
+	}
+	if m.ReadyMillisecond != 0 {
+		n += 1 + sovMessage(uint64(m.ReadyMillisecond))
+	}
+	if m.LeaderMillisecond != 0 {
+		n += 1 + sovMessage(uint64(m.LeaderMillisecond))
+	}
+	if m.DiskSpaceAllocatedBytes != 0 {
+		n += 1 + sovMessage(uint64(m.DiskSpaceAllocatedBytes))
+	}
+	l = len(m.ResourceLimits)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.DatabaseVersion)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	return n
 }
 
@@ -457,6 +698,187 @@ func sovMessage(x uint64) (n int) {
 func sozMessage(x uint64) (n int) {
 	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *NetworkFault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkFault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkFault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partition = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMillisecond", wireType)
+			}
+			m.DelayMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JitterMillisecond", wireType)
+			}
+			m.JitterMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JitterMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LossPercent", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.LossPercent = float64(math.Float64frombits(v))
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetIPIndexes = append(m.TargetIPIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetIPIndexes = append(m.TargetIPIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetIPIndexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -673,9 +1095,9 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 100:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Other", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkFault", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -699,51 +1121,80 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Etcd_Other == nil {
-				m.Flag_Etcd_Other = &Flag_Etcd_Other{}
+			if m.NetworkFault == nil {
+				m.NetworkFault = &NetworkFault{}
 			}
-			if err := m.Flag_Etcd_Other.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NetworkFault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 101:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_Tip", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMessage
+		case 10:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ClusterIPIndexes = append(m.ClusterIPIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClusterIPIndexes = append(m.ClusterIPIndexes, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterIPIndexes", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_Tip == nil {
-				m.Flag_Etcd_Tip = &Flag_Etcd_Tip{}
-			}
-			if err := m.Flag_Etcd_Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 102:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_2", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeDatabaseID", wireType)
 			}
-			var msglen int
+			m.UpgradeDatabaseID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -753,30 +1204,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.UpgradeDatabaseID |= (DatabaseID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_V3_2 == nil {
-				m.Flag_Etcd_V3_2 = &Flag_Etcd_V3_2{}
-			}
-			if err := m.Flag_Etcd_V3_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Etcd_V3_3", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUProfileSeconds", wireType)
 			}
-			var msglen int
+			m.CPUProfileSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -786,28 +1223,14 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CPUProfileSeconds |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Flag_Etcd_V3_3 == nil {
-				m.Flag_Etcd_V3_3 = &Flag_Etcd_V3_3{}
-			}
-			if err := m.Flag_Etcd_V3_3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 200:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zookeeper_R3_5_3Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -831,16 +1254,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zookeeper_R3_5_3Beta == nil {
-				m.Flag_Zookeeper_R3_5_3Beta = &Flag_Zookeeper_R3_5_3Beta{}
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ConfigClientMachineResourceLimits{}
 			}
-			if err := m.Flag_Zookeeper_R3_5_3Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 300:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Consul_V1_0_2", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -864,18 +1287,18 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Consul_V1_0_2 == nil {
-				m.Flag_Consul_V1_0_2 = &Flag_Consul_V1_0_2{}
+			if m.ExtraOptions == nil {
+				m.ExtraOptions = &ConfigClientMachineExtraOptions{}
 			}
-			if err := m.Flag_Consul_V1_0_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExtraOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 400:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Cetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -885,28 +1308,24 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Cetcd_Beta == nil {
-				m.Flag_Cetcd_Beta = &Flag_Cetcd_Beta{}
-			}
-			if err := m.Flag_Cetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DatabaseVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 500:
+		case 700:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag_Zetcd_Beta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -930,10 +1349,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Flag_Zetcd_Beta == nil {
-				m.Flag_Zetcd_Beta = &Flag_Zetcd_Beta{}
-			}
-			if err := m.Flag_Zetcd_Beta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatabaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1026,6 +1442,178 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotSizeBytes", wireType)
+			}
+			m.SnapshotSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotMillisecond", wireType)
+			}
+			m.SnapshotMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoverMillisecond", wireType)
+			}
+			m.RecoverMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoverMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyMillisecond", wireType)
+			}
+			m.ReadyMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderMillisecond", wireType)
+			}
+			m.LeaderMillisecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderMillisecond |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskSpaceAllocatedBytes", wireType)
+			}
+			m.DiskSpaceAllocatedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskSpaceAllocatedBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceLimits = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatabaseVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatabaseVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -1155,50 +1743,74 @@ var (
 func init() { proto.RegisterFile("dbtesterpb/message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdf, 0x4e, 0x1b, 0x47,
-	0x14, 0xc6, 0xbd, 0x98, 0x3f, 0xf6, 0x58, 0xa6, 0xee, 0x00, 0xd5, 0xc8, 0x50, 0x77, 0x85, 0x2a,
-	0x64, 0x21, 0xd5, 0x06, 0xaf, 0x68, 0xaf, 0x8b, 0x69, 0x8b, 0xa5, 0x26, 0xa0, 0xb1, 0xe1, 0x82,
-	0x9b, 0xd1, 0xec, 0xfa, 0x78, 0x59, 0x61, 0xef, 0x6c, 0x66, 0xc7, 0x28, 0xe1, 0x2e, 0x6f, 0x90,
-	0xcb, 0x3c, 0x44, 0x1e, 0x84, 0xcb, 0x3c, 0x42, 0x42, 0x5e, 0x21, 0x0f, 0x10, 0xed, 0xac, 0x17,
-	0x0f, 0xd8, 0x4e, 0xee, 0x7c, 0xbe, 0xef, 0x9b, 0xdf, 0xce, 0x1f, 0x9f, 0x83, 0x48, 0xdf, 0x55,
-	0x10, 0x2b, 0x90, 0x91, 0xdb, 0x1c, 0x41, 0x1c, 0x73, 0x1f, 0x1a, 0x91, 0x14, 0x4a, 0x60, 0x34,
-	0x75, 0xaa, 0x7f, 0xf8, 0x81, 0xba, 0x1e, 0xbb, 0x0d, 0x4f, 0x8c, 0x9a, 0xbe, 0xf0, 0x45, 0x53,
-	0x47, 0xdc, 0xf1, 0x40, 0x57, 0xba, 0xd0, 0xbf, 0xd2, 0xa5, 0xd5, 0x1d, 0x03, 0xda, 0xe7, 0x8a,
-	0xbb, 0x3c, 0x06, 0x16, 0xf4, 0x27, 0x6e, 0xd5, 0x70, 0x07, 0x43, 0xee, 0x33, 0x50, 0x5e, 0xe6,
-	0xfd, 0xf6, 0xdc, 0xbb, 0x13, 0xe2, 0x06, 0x20, 0x02, 0x39, 0x07, 0xad, 0x03, 0x9e, 0x08, 0xe3,
-	0xf1, 0x70, 0xe2, 0x6e, 0xcf, 0x2c, 0x37, 0xd8, 0x33, 0xa6, 0x67, 0x98, 0x7b, 0x86, 0xe9, 0x89,
-	0x70, 0x10, 0xf8, 0xcc, 0x1b, 0x06, 0x10, 0x2a, 0x36, 0xe2, 0xde, 0x75, 0x10, 0x4e, 0x6e, 0x65,
-	0xf7, 0x6d, 0x01, 0xad, 0x51, 0x78, 0x35, 0x86, 0x58, 0x61, 0x07, 0x15, 0xcf, 0x22, 0x90, 0x5c,
-	0x05, 0x22, 0x24, 0x96, 0x6d, 0xd5, 0xd7, 0x5b, 0x5b, 0x8d, 0x29, 0xa7, 0xf1, 0x68, 0xd2, 0x69,
-	0x0e, 0xef, 0xa3, 0x4a, 0x4f, 0x06, 0xbe, 0x0f, 0xf2, 0x7f, 0xe1, 0x5f, 0x44, 0x43, 0xc1, 0xfb,
-	0x64, 0xc9, 0xb6, 0xea, 0x05, 0x3a, 0xa3, 0xe3, 0x3f, 0x11, 0x3a, 0x99, 0x5c, 0x5f, 0xe7, 0x84,
-	0xe4, 0xf5, 0x17, 0x7e, 0x31, 0xbf, 0x30, 0x75, 0xa9, 0x91, 0xc4, 0x36, 0x2a, 0x65, 0x55, 0x8f,
-	0xfb, 0x64, 0xd9, 0xb6, 0xea, 0x45, 0x6a, 0x4a, 0xf8, 0x77, 0x54, 0x3e, 0x07, 0x90, 0x9d, 0xf3,
-	0xb8, 0xab, 0x64, 0x10, 0xfa, 0x64, 0x45, 0x67, 0x9e, 0x8a, 0x98, 0xa0, 0xb5, 0xce, 0x79, 0x27,
-	0xec, 0xc3, 0x6b, 0xb2, 0x6a, 0x5b, 0xf5, 0x32, 0xcd, 0x4a, 0x7c, 0x80, 0x36, 0xda, 0x63, 0x29,
-	0x21, 0x54, 0x6d, 0x7d, 0x4b, 0x2f, 0xc7, 0x23, 0x17, 0x24, 0x59, 0xb3, 0xad, 0x7a, 0x9e, 0xce,
-	0xb3, 0xf0, 0x00, 0x55, 0xdb, 0xfa, 0x5e, 0x53, 0xf5, 0x45, 0x7a, 0xab, 0x9d, 0x30, 0x50, 0x01,
-	0x1f, 0x92, 0x82, 0x6d, 0xd5, 0x4b, 0xad, 0x3d, 0xf3, 0x6c, 0x8b, 0xd3, 0xf4, 0x3b, 0x24, 0xfc,
-	0x1f, 0xfa, 0x59, 0x3f, 0xae, 0xfe, 0x57, 0x31, 0x26, 0xd4, 0x35, 0x48, 0xd2, 0xd7, 0xf8, 0x5f,
-	0x4d, 0xfc, 0x4c, 0x88, 0x96, 0x13, 0xe9, 0x1f, 0xe5, 0xf5, 0xcf, 0x92, 0x12, 0xff, 0x8d, 0x7e,
-	0x32, 0x33, 0x2a, 0x88, 0x08, 0x68, 0xcc, 0xf6, 0x22, 0x8c, 0x0a, 0x22, 0x5a, 0xca, 0x20, 0xbd,
-	0x20, 0xc2, 0x6d, 0x54, 0x31, 0xfd, 0x5b, 0x87, 0xb5, 0xc8, 0x40, 0x33, 0x76, 0x16, 0x31, 0x92,
-	0xcc, 0x14, 0x72, 0xe9, 0xb4, 0xe6, 0x40, 0x1c, 0xe2, 0xff, 0x10, 0xe2, 0x98, 0x10, 0x07, 0x0f,
-	0xd0, 0x4e, 0x1a, 0x78, 0xec, 0x27, 0xc6, 0xa4, 0xc3, 0x8e, 0x98, 0xc3, 0x5c, 0x50, 0x9c, 0xdc,
-	0x5b, 0x9a, 0x58, 0x9f, 0x25, 0xce, 0x5f, 0x40, 0xb7, 0x12, 0xf7, 0x2a, 0xf3, 0xa8, 0x73, 0xe4,
-	0x1c, 0x83, 0xe2, 0xf8, 0x0c, 0x6d, 0xa6, 0xcb, 0xd2, 0xb6, 0x64, 0xec, 0xf6, 0x90, 0x1d, 0xb0,
-	0x16, 0xf9, 0xb0, 0xa4, 0xf9, 0xf6, 0x2c, 0xff, 0x69, 0x90, 0xae, 0x27, 0x6a, 0x5b, 0x6b, 0x97,
-	0x87, 0x07, 0x2d, 0x7c, 0x9a, 0x3d, 0xa7, 0x97, 0x1e, 0x4d, 0xef, 0xf6, 0x5d, 0x7e, 0xd1, 0x7b,
-	0x1a, 0xa9, 0xf4, 0x3d, 0xdb, 0x89, 0xa0, 0xb7, 0xf6, 0x48, 0xba, 0x33, 0x48, 0x5f, 0x17, 0x92,
-	0xee, 0x9e, 0x93, 0xae, 0x32, 0xd2, 0xee, 0x25, 0x2a, 0x50, 0x88, 0x23, 0x11, 0xc6, 0x90, 0xb4,
-	0x48, 0x77, 0xec, 0x79, 0x10, 0xc7, 0x7a, 0x02, 0x14, 0x68, 0x56, 0x26, 0x2d, 0x72, 0x12, 0xc4,
-	0x37, 0xdd, 0x88, 0x7b, 0x70, 0x91, 0xcc, 0xd5, 0xe3, 0x37, 0x0a, 0x62, 0xdd, 0xeb, 0x79, 0x3a,
-	0xcf, 0xda, 0x6f, 0x1a, 0xf3, 0x04, 0x17, 0xd1, 0x4a, 0x57, 0x71, 0xa9, 0x2a, 0x39, 0x5c, 0x40,
-	0xcb, 0x5d, 0x25, 0xa2, 0x8a, 0x85, 0xcb, 0xa8, 0x78, 0x0a, 0x5c, 0x2a, 0x17, 0xb8, 0xaa, 0x2c,
-	0xb5, 0xfe, 0x45, 0xa5, 0x9e, 0xe4, 0x61, 0x1c, 0x09, 0xa9, 0x40, 0xe2, 0xbf, 0x50, 0x41, 0x97,
-	0x03, 0x90, 0x78, 0xc3, 0x3c, 0xd1, 0x64, 0x60, 0x55, 0x37, 0x9f, 0x8a, 0xe9, 0x11, 0x76, 0x73,
-	0xc7, 0x9b, 0xf7, 0x9f, 0x6b, 0xb9, 0xfb, 0x87, 0x9a, 0xf5, 0xf1, 0xa1, 0x66, 0x7d, 0x7a, 0xa8,
-	0x59, 0xef, 0xbf, 0xd4, 0x72, 0xee, 0xaa, 0x9e, 0x78, 0xce, 0xb7, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x9b, 0x7a, 0x8c, 0xc2, 0x23, 0x06, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcd, 0x72, 0xdb, 0x36,
+	0x17, 0x35, 0x2d, 0x59, 0xa6, 0x60, 0xcb, 0x86, 0x91, 0x9f, 0x8f, 0x9f, 0x27, 0xe3, 0x68, 0x3c,
+	0x9d, 0x8c, 0x26, 0x6d, 0x9c, 0xd4, 0x9e, 0xfe, 0x2c, 0xba, 0x49, 0xec, 0x66, 0x6a, 0xd5, 0x49,
+	0x34, 0x90, 0xdd, 0x2d, 0x07, 0x22, 0xaf, 0x69, 0x34, 0x14, 0xc1, 0x02, 0xa0, 0x9b, 0xe4, 0x29,
+	0xda, 0x5d, 0x1f, 0xa2, 0xe9, 0x13, 0x74, 0xdd, 0x71, 0x3a, 0x5d, 0x64, 0xba, 0x6a, 0x37, 0x99,
+	0xd6, 0x5d, 0x77, 0xd7, 0xee, 0x3b, 0x00, 0x29, 0x8b, 0x94, 0x94, 0x64, 0x45, 0xe1, 0x9c, 0x7b,
+	0x0f, 0x78, 0x89, 0x73, 0x2f, 0x84, 0xbc, 0x70, 0xa0, 0x41, 0x69, 0x90, 0xe9, 0xe0, 0xf6, 0x10,
+	0x94, 0x62, 0x11, 0x6c, 0xa5, 0x52, 0x68, 0x41, 0xd0, 0x98, 0x59, 0xbf, 0x15, 0x71, 0x7d, 0x92,
+	0x0d, 0xb6, 0x02, 0x31, 0xbc, 0x1d, 0x89, 0x48, 0xdc, 0xb6, 0x21, 0x83, 0xec, 0xd8, 0xae, 0xec,
+	0xc2, 0xfe, 0xca, 0x53, 0xd7, 0xaf, 0x95, 0x44, 0x43, 0xa6, 0xd9, 0x80, 0x29, 0xf0, 0x79, 0x58,
+	0xb0, 0x57, 0x4a, 0xec, 0x71, 0xcc, 0xa2, 0x02, 0xbe, 0x51, 0x82, 0x03, 0x91, 0x1c, 0xf3, 0xc8,
+	0x0f, 0x62, 0x0e, 0x89, 0xf6, 0x87, 0x2c, 0x38, 0xe1, 0x49, 0xf1, 0x5e, 0x9b, 0xbf, 0x3a, 0x68,
+	0xf9, 0x21, 0xe8, 0xaf, 0x85, 0x7c, 0x7c, 0x9f, 0x65, 0xb1, 0x26, 0xd7, 0x50, 0xb3, 0xc7, 0xa4,
+	0xe6, 0x9a, 0x8b, 0xc4, 0x73, 0xda, 0x4e, 0xc7, 0xa5, 0x63, 0x80, 0xdc, 0x44, 0x78, 0x0f, 0x62,
+	0xf6, 0xf4, 0x01, 0x8f, 0x63, 0xae, 0x20, 0x10, 0x49, 0xe8, 0xcd, 0xb7, 0x9d, 0x4e, 0x8d, 0x4e,
+	0xe1, 0xe4, 0x3d, 0xb4, 0xd6, 0xe5, 0x5a, 0x83, 0x2c, 0x07, 0xd7, 0x6c, 0xf0, 0x34, 0x41, 0xda,
+	0x68, 0xe9, 0x40, 0x28, 0xd5, 0x03, 0x19, 0x40, 0xa2, 0xbd, 0x7a, 0xdb, 0xe9, 0x38, 0xb4, 0x0c,
+	0x91, 0x0e, 0x5a, 0x3d, 0x64, 0x32, 0x02, 0xbd, 0xdf, 0xdb, 0x4f, 0x42, 0x78, 0x02, 0xca, 0x5b,
+	0x68, 0xd7, 0x3a, 0x2d, 0x3a, 0x09, 0x6f, 0xfe, 0xd4, 0x44, 0x8b, 0x14, 0xbe, 0xca, 0x40, 0x69,
+	0xb2, 0x83, 0x9a, 0x8f, 0x52, 0x90, 0xec, 0xa2, 0x9e, 0x95, 0xed, 0x2b, 0x5b, 0xe3, 0x8f, 0xb3,
+	0x75, 0x41, 0xd2, 0x71, 0x9c, 0x29, 0xf3, 0x50, 0xf2, 0x28, 0x02, 0x79, 0x20, 0xa2, 0xa3, 0x34,
+	0x16, 0x2c, 0x2f, 0xd3, 0xa5, 0x53, 0x38, 0xf9, 0x10, 0xa1, 0xbd, 0xe2, 0x54, 0xf6, 0xf7, 0x6c,
+	0x7d, 0x2b, 0xdb, 0x57, 0xcb, 0x3b, 0x8c, 0x59, 0x5a, 0x8a, 0x34, 0x05, 0x8f, 0x56, 0x87, 0x2c,
+	0xb2, 0x05, 0x37, 0x69, 0x19, 0x22, 0xef, 0xa0, 0x56, 0x0f, 0x40, 0xee, 0xf7, 0x54, 0x5f, 0x4b,
+	0x9e, 0x44, 0xde, 0x82, 0x8d, 0xa9, 0x82, 0xc4, 0x43, 0x8b, 0x45, 0xe5, 0x5e, 0xa3, 0xed, 0x74,
+	0x5a, 0x74, 0xb4, 0x24, 0x77, 0xd0, 0xa5, 0xdd, 0x4c, 0x4a, 0x48, 0xf4, 0xae, 0x3d, 0xfa, 0x87,
+	0xd9, 0x70, 0x00, 0xd2, 0x5b, 0xb4, 0x47, 0x30, 0x8b, 0x22, 0xc7, 0x68, 0x7d, 0xd7, 0x9a, 0x25,
+	0x47, 0x1f, 0xe4, 0x56, 0xd9, 0x4f, 0xb8, 0xe6, 0x2c, 0xf6, 0xdc, 0xb6, 0xd3, 0x59, 0xda, 0xbe,
+	0x51, 0xae, 0xed, 0xf5, 0xd1, 0xf4, 0x0d, 0x4a, 0xe4, 0x93, 0xaa, 0xe9, 0xbc, 0xa6, 0x55, 0xf6,
+	0xca, 0xca, 0x65, 0x9e, 0x56, 0x2d, 0x7a, 0x13, 0xe1, 0xdd, 0x38, 0x33, 0x71, 0x63, 0x27, 0x20,
+	0xeb, 0x84, 0x29, 0x9c, 0xec, 0xa1, 0xb5, 0xa3, 0x34, 0x92, 0x2c, 0x84, 0xd2, 0x21, 0x2d, 0xbd,
+	0xf1, 0x90, 0xa6, 0x13, 0x8c, 0x95, 0x77, 0x7b, 0x47, 0x3d, 0x29, 0x8e, 0x79, 0x0c, 0x7d, 0x6b,
+	0x58, 0xe5, 0x2d, 0xe7, 0x56, 0x9e, 0x22, 0xc8, 0x11, 0x5a, 0xa1, 0xa0, 0x44, 0x26, 0x03, 0x38,
+	0xe0, 0x43, 0xae, 0x95, 0xd7, 0xb2, 0xf5, 0xdd, 0x7a, 0xcb, 0x97, 0xab, 0x26, 0xd1, 0x09, 0x11,
+	0xf2, 0x08, 0x2d, 0x7f, 0xfa, 0x44, 0x4b, 0xf6, 0x28, 0x35, 0x1e, 0x55, 0xde, 0x8a, 0x15, 0x7d,
+	0xf7, 0x2d, 0xa2, 0xe5, 0x14, 0x5a, 0x11, 0x30, 0x0d, 0x35, 0xaa, 0xf1, 0x0b, 0x90, 0xca, 0x34,
+	0xc8, 0xaa, 0x75, 0xd8, 0x24, 0x4c, 0xba, 0xa8, 0x35, 0x82, 0xee, 0xc7, 0x2c, 0x52, 0xde, 0x8f,
+	0x0b, 0x76, 0xf3, 0xff, 0xcf, 0xfa, 0x84, 0x36, 0xe2, 0x9e, 0x7b, 0xf6, 0xea, 0xfa, 0xdc, 0xcb,
+	0x57, 0xd7, 0x1d, 0x5a, 0x4d, 0xed, 0xd6, 0xdd, 0x10, 0x7f, 0xd9, 0x6d, 0xb8, 0x67, 0x0e, 0x7e,
+	0xe1, 0x74, 0x1b, 0xee, 0x0b, 0x07, 0xff, 0x6c, 0x9e, 0xdf, 0xcf, 0xe3, 0xe7, 0xf3, 0xdd, 0x86,
+	0xfb, 0x7c, 0x1e, 0xff, 0x60, 0x9e, 0xdf, 0xd4, 0xf0, 0xb7, 0xb5, 0x6e, 0xc3, 0xfd, 0xa7, 0x86,
+	0xff, 0x35, 0xcf, 0xdf, 0xea, 0xf8, 0xf7, 0x3a, 0x5d, 0x33, 0x33, 0xce, 0xf7, 0x41, 0x07, 0xa1,
+	0xef, 0x0b, 0x7d, 0x02, 0x92, 0xae, 0x96, 0x21, 0xcd, 0x53, 0x8a, 0xcb, 0xc0, 0xe9, 0x8e, 0xbf,
+	0x3d, 0x85, 0xec, 0x54, 0x75, 0x60, 0x38, 0x80, 0x90, 0xa2, 0x31, 0x44, 0xaf, 0xe5, 0xbf, 0x9f,
+	0x09, 0xf1, 0x18, 0x20, 0x05, 0xe9, 0xfb, 0x72, 0xc7, 0xff, 0xc0, 0xdf, 0xf1, 0x07, 0xa0, 0x19,
+	0x5d, 0x9d, 0x60, 0xe9, 0xe5, 0x1c, 0x08, 0x44, 0xa2, 0xb2, 0xd8, 0xf7, 0x4f, 0xdf, 0xf7, 0xef,
+	0xf8, 0xdb, 0x74, 0xb9, 0x8c, 0x8e, 0x76, 0x0c, 0xf2, 0x2d, 0xad, 0x4e, 0x01, 0x3d, 0x2b, 0x41,
+	0xa3, 0x9c, 0x4c, 0x69, 0x31, 0xdc, 0xfc, 0xa5, 0x86, 0x5c, 0x0a, 0x2a, 0x15, 0x89, 0x02, 0xd3,
+	0xe8, 0xfd, 0x2c, 0x08, 0x40, 0xa9, 0x62, 0x2e, 0x8f, 0x96, 0xa6, 0xd1, 0xf7, 0xb8, 0x7a, 0xdc,
+	0x4f, 0x59, 0x00, 0x47, 0xe6, 0xd2, 0xb9, 0xf7, 0x54, 0x83, 0x2a, 0x06, 0xf3, 0x2c, 0xca, 0x18,
+	0xba, 0x9f, 0xb0, 0x54, 0x9d, 0x08, 0xdd, 0xe7, 0xcf, 0x8a, 0xf8, 0x62, 0x36, 0x4f, 0x11, 0x46,
+	0x7f, 0x04, 0x96, 0x67, 0x79, 0x3d, 0xd7, 0x9f, 0x41, 0x91, 0x2d, 0x44, 0x28, 0x04, 0xe2, 0xb4,
+	0x3a, 0xfc, 0x17, 0x6c, 0xc2, 0x0c, 0xc6, 0xb4, 0x34, 0x05, 0x16, 0x56, 0xee, 0x95, 0x46, 0x7e,
+	0xaf, 0x4c, 0xe2, 0xe6, 0xdd, 0x0f, 0x80, 0x85, 0x55, 0xe9, 0x7c, 0xa8, 0x4d, 0x13, 0xe4, 0x63,
+	0xf4, 0xbf, 0x8b, 0x0f, 0x70, 0x37, 0x8e, 0x45, 0xc0, 0x34, 0x84, 0x79, 0xbd, 0xae, 0xcd, 0x79,
+	0x1d, 0x4d, 0x6e, 0x4c, 0xb5, 0x71, 0xd3, 0x76, 0xc7, 0x64, 0x5f, 0xce, 0x68, 0x23, 0x34, 0xb3,
+	0x8d, 0x6e, 0xfe, 0xed, 0x94, 0x2e, 0x23, 0xd2, 0x44, 0x0b, 0x7d, 0xcd, 0xa4, 0xc6, 0x73, 0xc4,
+	0x45, 0xf5, 0xbe, 0x16, 0x29, 0x76, 0x48, 0x0b, 0x35, 0x3f, 0x03, 0x26, 0xf5, 0x00, 0x98, 0xc6,
+	0xf3, 0x86, 0xf8, 0x9c, 0xc7, 0x31, 0xae, 0x91, 0x25, 0x73, 0xa5, 0x29, 0x1b, 0x5f, 0x37, 0xa9,
+	0x3d, 0x96, 0x29, 0xc0, 0x0b, 0x04, 0xa1, 0x06, 0x05, 0x95, 0x0d, 0x01, 0x37, 0xc8, 0x15, 0xb4,
+	0x76, 0x37, 0x4d, 0xe3, 0xa7, 0xe5, 0x69, 0x89, 0x17, 0xc9, 0x55, 0x73, 0x18, 0x43, 0x71, 0x0a,
+	0x15, 0xdc, 0x35, 0xe2, 0x5d, 0xc1, 0x13, 0xdc, 0x34, 0x7a, 0x07, 0xc0, 0x4e, 0x01, 0x23, 0xb3,
+	0x4f, 0x31, 0xff, 0xf0, 0x12, 0xc1, 0x68, 0xf9, 0xc2, 0x0d, 0x86, 0x5e, 0x26, 0x97, 0xd0, 0xea,
+	0x08, 0x29, 0x8e, 0x11, 0xb7, 0xcc, 0x06, 0xbb, 0x2c, 0xd5, 0x99, 0x84, 0x3d, 0xce, 0xa2, 0x44,
+	0x28, 0xcd, 0x03, 0x85, 0x57, 0xb6, 0xef, 0xa3, 0xa5, 0x43, 0xc9, 0x12, 0x95, 0x0a, 0xa9, 0x41,
+	0x92, 0x8f, 0x90, 0x6b, 0x97, 0xc7, 0x20, 0xc9, 0xa5, 0xf2, 0xe4, 0x28, 0xee, 0xea, 0xf5, 0xcb,
+	0x55, 0x30, 0xf7, 0xfd, 0xe6, 0xdc, 0xbd, 0xcb, 0x67, 0x7f, 0x6e, 0xcc, 0x9d, 0x9d, 0x6f, 0x38,
+	0x2f, 0xcf, 0x37, 0x9c, 0x3f, 0xce, 0x37, 0x9c, 0xef, 0xfe, 0xda, 0x98, 0x1b, 0x34, 0xec, 0x3f,
+	0x98, 0x9d, 0xff, 0x06, 0x00, 0x25, 0xcc, 0xb5, 0xdc, 0x75, 0x09, 0x00, 0x00,
 }
@@ -0,0 +1,165 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtesterpb
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Peer is a database member, with the ports to serve clients and peers.
+// Members on the same host must have different ports.
+type Peer struct {
+	Host       string
+	ClientPort int64
+	PeerPort   int64
+}
+
+// PeerSeparator separates the peers in 'PeerIPsString'.
+const PeerSeparator = "___"
+
+// DefaultPeerPorts returns the default client and peer ports of the database.
+// zetcd and cetcd serve clients on the proxy port, and their backing etcd
+// serves clients on the port right below the peer port.
+func DefaultPeerPorts(id DatabaseID) (clientPort, peerPort int64) {
+	switch DatabaseFamily(id.String()) {
+	case "zetcd":
+		return 2181, 2380
+	case "cetcd":
+		return 8500, 2380
+	case "zookeeper":
+		return 2181, 2888
+	case "consul":
+		return 8500, 8300
+	default:
+		return 2379, 2380
+	}
+}
+
+// ParsePeer parses "host" or "host:clientPort:peerPort".
+// Ports default to the given ones when omitted.
+func ParsePeer(s string, clientPort, peerPort int64) (Peer, error) {
+	ss := strings.Split(strings.TrimSpace(s), ":")
+	switch len(ss) {
+	case 1:
+		if ss[0] == "" {
+			return Peer{}, fmt.Errorf("empty peer %q", s)
+		}
+		return Peer{Host: ss[0], ClientPort: clientPort, PeerPort: peerPort}, nil
+	case 3:
+		if ss[0] == "" {
+			return Peer{}, fmt.Errorf("peer %q has empty host", s)
+		}
+		cp, err := strconv.ParseInt(ss[1], 10, 64)
+		if err != nil || cp <= 0 || cp > 65535 {
+			return Peer{}, fmt.Errorf("peer %q has invalid client port %q", s, ss[1])
+		}
+		pp, err := strconv.ParseInt(ss[2], 10, 64)
+		if err != nil || pp <= 0 || pp > 65535 {
+			return Peer{}, fmt.Errorf("peer %q has invalid peer port %q", s, ss[2])
+		}
+		if cp == pp {
+			return Peer{}, fmt.Errorf("peer %q has the same client and peer port", s)
+		}
+		return Peer{Host: ss[0], ClientPort: cp, PeerPort: pp}, nil
+	default:
+		return Peer{}, fmt.Errorf("peer %q is not 'host' or 'host:clientPort:peerPort'", s)
+	}
+}
+
+// ParsePeers parses 'PeerIPsString' of the database.
+func ParsePeers(id DatabaseID, peerIPsString string) ([]Peer, error) {
+	cp, pp := DefaultPeerPorts(id)
+	ss := strings.Split(peerIPsString, PeerSeparator)
+	peers := make([]Peer, len(ss))
+	for i, s := range ss {
+		p, err := ParsePeer(s, cp, pp)
+		if err != nil {
+			return nil, err
+		}
+		peers[i] = p
+	}
+	return peers, nil
+}
+
+// PeerHosts returns the hosts of the peers.
+func PeerHosts(peers []Peer) []string {
+	hosts := make([]string, len(peers))
+	for i := range peers {
+		hosts[i] = peers[i].Host
+	}
+	return hosts
+}
+
+// String returns "host:clientPort:peerPort".
+func (p Peer) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.Host, p.ClientPort, p.PeerPort)
+}
+
+// ClientAddr returns the address to serve clients.
+func (p Peer) ClientAddr() string {
+	return p.Addr(p.ClientPort)
+}
+
+// PeerAddr returns the address to serve peers.
+func (p Peer) PeerAddr() string {
+	return p.Addr(p.PeerPort)
+}
+
+// Addr returns the address of the port on the host
+// (e.g. the etcd client port of zetcd and cetcd).
+func (p Peer) Addr(port int64) string {
+	return net.JoinHostPort(p.Host, strconv.FormatInt(port, 10))
+}
+
+// EtcdClientPort returns the client port of etcd. zetcd and cetcd
+// are backed by etcd serving clients on the port right below the peer port.
+func (p Peer) EtcdClientPort(id DatabaseID) int64 {
+	switch DatabaseFamily(id.String()) {
+	case "zetcd", "cetcd":
+		return p.PeerPort - 1
+	default:
+		return p.ClientPort
+	}
+}
+
+// ZookeeperElectionPort returns the Zookeeper leader election port,
+// 1000 above the quorum port (e.g. 3888 for 2888).
+func (p Peer) ZookeeperElectionPort() int64 {
+	return p.PeerPort + 1000
+}
+
+// ConsulSerfPorts returns the Consul Serf LAN and WAN ports,
+// right above the server RPC port (e.g. 8301 and 8302 for 8300).
+func (p Peer) ConsulSerfPorts() (lan, wan int64) {
+	return p.PeerPort + 1, p.PeerPort + 2
+}
+
+// Ports returns all the ports that the member binds.
+func (p Peer) Ports(id DatabaseID) []int64 {
+	switch DatabaseFamily(id.String()) {
+	case "zetcd", "cetcd":
+		return []int64{p.ClientPort, p.EtcdClientPort(id), p.PeerPort}
+	case "zookeeper":
+		return []int64{p.ClientPort, p.PeerPort, p.ZookeeperElectionPort()}
+	case "consul":
+		lan, wan := p.ConsulSerfPorts()
+		return []int64{p.ClientPort, p.PeerPort, lan, wan}
+	default:
+		return []int64{p.ClientPort, p.PeerPort}
+	}
+}
@@ -17,6 +17,7 @@ package dbtesterpb
 import (
 	"image/color"
 	"sort"
+	"strings"
 
 	"gonum.org/v1/plot/plotutil"
 )
@@ -37,6 +38,15 @@ func GetAllDatabaseIDs() []string {
 	return ids
 }
 
+// DatabaseFamily returns the family of the database id, which is the part
+// before the version (e.g. "etcd" for "etcd__v3_3").
+func DatabaseFamily(id string) string {
+	if i := strings.Index(id, "__"); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
+
 func GetRGBI(databaseID string, i int) color.Color {
 	switch databaseID {
 	case "etcd__other":
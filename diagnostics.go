@@ -0,0 +1,87 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// CaptureDiagnostics requests agents to capture the diagnostics of
+// the databases (e.g. CPU profiles, thread dumps) at the configured
+// offsets from now, which are uploaded with the database logs.
+// Diagnostics whose offsets have not been reached are dropped when
+// 'stopc' is closed. Failed captures are logged, but not returned,
+// so as not to fail the benchmark.
+func (cfg *Config) CaptureDiagnostics(databaseID string, stopc <-chan struct{}) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	diags := make([]dbtesterpb.ConfigClientMachineDiagnostics, len(gcfg.Diagnostics))
+	for i := range gcfg.Diagnostics {
+		diags[i] = *gcfg.Diagnostics[i]
+	}
+	sort.SliceStable(diags, func(i, j int) bool { return diags[i].OffsetMillisecond < diags[j].OffsetMillisecond })
+
+	now := time.Now()
+	for _, dg := range diags {
+		select {
+		case <-time.After(time.Until(now.Add(time.Duration(dg.OffsetMillisecond) * time.Millisecond))):
+		case <-stopc:
+			cfg.lg.Warn("benchmark finished before diagnostics", zap.Int64("offset-ms", dg.OffsetMillisecond))
+			continue
+		}
+
+		idxs := make([]int, 0, len(gcfg.AgentEndpoints))
+		if len(dg.MemberIndexes) == 0 {
+			for i := range gcfg.AgentEndpoints {
+				idxs = append(idxs, i)
+			}
+		} else {
+			for _, i := range dg.MemberIndexes {
+				idxs = append(idxs, int(i))
+			}
+		}
+
+		cfg.lg.Info("capturing diagnostics", zap.Ints("member-indexes", idxs), zap.Int64("cpu-profile-seconds", dg.CPUProfileSeconds))
+		var wg sync.WaitGroup
+		wg.Add(len(idxs))
+		for _, idx := range idxs {
+			go func(idx int) {
+				defer wg.Done()
+				req, err := cfg.ToRequest(databaseID, dbtesterpb.Operation_CaptureDiagnostics, idx)
+				if err == nil {
+					req.CPUProfileSeconds = dg.CPUProfileSeconds
+					_, err = cfg.sendRequest(idx, gcfg.AgentEndpoints[idx], req)
+				}
+				if err != nil {
+					cfg.lg.Warn("failed to capture diagnostics", zap.Int("member-index", idx), zap.Error(err))
+				}
+			}(idx)
+		}
+		wg.Wait()
+	}
+
+	<-stopc
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"go.uber.org/zap"
+)
+
+// driver implements the client side of a database family: configuration
+// defaults, clients, request handlers and cluster status. Database IDs
+// map to drivers by family (e.g. "etcd" for "etcd__v3_3"), and drivers
+// switch on the version, if needed.
+type driver interface {
+	// setDefaults fills the ports and flags that are not configured.
+	setDefaults(gcfg *dbtesterpb.ConfigClientMachineAgentControl)
+	// setRequestFlags sets the flags of the member at 'idx' in the agent request.
+	setRequestFlags(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, req *dbtesterpb.Request) error
+
+	// writeRequest and readRequest return the requests for the handlers.
+	writeRequest(key string, value []byte) request
+	readRequest(key string, staleRead bool) request
+
+	// put writes a key with a new client.
+	put(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, value []byte) error
+
+	// newWriteHandlers, newReadHandlers and newSessionHandlers return
+	// one handler per client, and 'done' to close the clients, or nil.
+	newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func())
+	newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func())
+	newSessionHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string, cnt *sessionCounter) (rhs []ReqHandler, done func())
+	// newReadOneshotHandlers returns handlers that create a client per request.
+	newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler
+	// newKeyCheckers returns 'n' functions that return true if the key exists,
+	// reading from the leader or quorum, and 'done' to close the clients.
+	newKeyCheckers(gcfg dbtesterpb.ConfigClientMachineAgentControl, n int) (exists []func(key string) (bool, error), done func())
+
+	// totalKeys returns the number of keys in each endpoint.
+	totalKeys(lg *zap.Logger, endpoints []string) map[string]int64
+	// memberStatus returns the leader and progress that the member at 'idx' reports.
+	memberStatus(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, timeout time.Duration) (memberLeaderState, error)
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]driver)
+)
+
+// registerDriver registers the driver of the database family.
+// It panics if the family is registered twice.
+func registerDriver(family string, d driver) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if _, ok := drivers[family]; ok {
+		panic(fmt.Sprintf("driver %q is registered twice", family))
+	}
+	drivers[family] = d
+}
+
+// configuredDriver is implemented by drivers whose clients depend on
+// the configuration (e.g. the client protocol of "custom").
+type configuredDriver interface {
+	driver
+	// configure validates the configuration, and returns the driver for it.
+	configure(gcfg dbtesterpb.ConfigClientMachineAgentControl) (driver, error)
+}
+
+// familyDriver returns the registered driver of the database family.
+func familyDriver(family string) (driver, bool) {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+	d, ok := drivers[family]
+	return d, ok
+}
+
+// getDriver returns the driver of the database configuration.
+func getDriver(gcfg dbtesterpb.ConfigClientMachineAgentControl) (driver, error) {
+	d, ok := familyDriver(dbtesterpb.DatabaseFamily(gcfg.DatabaseID))
+	if !ok {
+		return nil, fmt.Errorf("%q is unknown database ID", gcfg.DatabaseID)
+	}
+	if cd, ok := d.(configuredDriver); ok {
+		return cd.configure(gcfg)
+	}
+	return d, nil
+}
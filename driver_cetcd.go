@@ -0,0 +1,37 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func init() { registerDriver("cetcd", cetcdDriver{}) }
+
+// cetcdDriver is the driver of cetcd, which serves Consul clients
+// with the etcd cluster on the same machines.
+type cetcdDriver struct{ consulDriver }
+
+func (cetcdDriver) setDefaults(gcfg *dbtesterpb.ConfigClientMachineAgentControl) {}
+
+func (cetcdDriver) setRequestFlags(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, req *dbtesterpb.Request) error {
+	return nil
+}
+
+func (cetcdDriver) memberStatus(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, timeout time.Duration) (memberLeaderState, error) {
+	return etcdDriver{}.memberStatus(gcfg, idx, timeout)
+}
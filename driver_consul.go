@@ -0,0 +1,182 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	consulapi "github.com/hashicorp/consul/api"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const defaultConsulClientPort int64 = 8500
+
+func init() { registerDriver("consul", consulDriver{}) }
+
+// consulDriver is the driver of Consul.
+type consulDriver struct{}
+
+func (consulDriver) setDefaults(gcfg *dbtesterpb.ConfigClientMachineAgentControl) {
+	if gcfg.AgentPortToConnect == 0 {
+		gcfg.AgentPortToConnect = defaultAgentPort
+	}
+	if gcfg.DatabasePortToConnect == 0 {
+		gcfg.DatabasePortToConnect = defaultConsulClientPort
+	}
+}
+
+func (consulDriver) setRequestFlags(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, req *dbtesterpb.Request) error {
+	fl := gcfg.Flag_Consul
+	if fl == nil {
+		return nil
+	}
+	req.Flag_Consul = &dbtesterpb.Flag_Consul{
+		RaftMultiplier:        fl.RaftMultiplier,
+		RaftSnapshotThreshold: fl.RaftSnapshotThreshold,
+		RaftSnapshotInterval:  fl.RaftSnapshotInterval,
+		LeaveOnTerminate:      fl.LeaveOnTerminate,
+		Datacenter:            fl.Datacenter,
+	}
+	return nil
+}
+
+func (consulDriver) writeRequest(key string, value []byte) request {
+	return request{consulOp: consulOp{key: key, value: value}}
+}
+
+func (consulDriver) readRequest(key string, staleRead bool) request {
+	return request{consulOp: consulOp{key: key, staleRead: staleRead}}
+}
+
+func (consulDriver) put(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, value []byte) error {
+	clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
+	_, err := clients[0].Put(&consulapi.KVPair{Key: key, Value: value}, nil)
+	return err
+}
+
+func (consulDriver) newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+	for i := range conns {
+		rhs[i] = newPutConsul(conns[i])
+	}
+	return rhs, nil
+}
+
+func (consulDriver) newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+	for i := range conns {
+		rhs[i] = newGetConsul(conns[i])
+	}
+	return rhs, nil
+}
+
+func (consulDriver) newSessionHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string, cnt *sessionCounter) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, len(keys))
+	conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 2*int64(len(keys)))
+	for i := range rhs {
+		w, r := newSessionConsul(conns[2*i], conns[2*i+1], gcfg.ConfigClientMachineBenchmarkOptions.StaleRead)
+		rhs[i] = newSessionHandler(keys[i], gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes, w, r, cnt)
+	}
+	return rhs, nil
+}
+
+func (consulDriver) newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
+	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	for i := range rhs {
+		rhs[i] = func(ctx context.Context, req *request) error {
+			conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
+			return newGetConsul(conns[0])(ctx, req)
+		}
+	}
+	return rhs
+}
+
+func (consulDriver) newKeyCheckers(gcfg dbtesterpb.ConfigClientMachineAgentControl, n int) (exists []func(key string) (bool, error), done func()) {
+	conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, int64(n))
+	for i := range conns {
+		conn := conns[i]
+		exists = append(exists, func(key string) (bool, error) {
+			kv, _, err := conn.Get(key, &consulapi.QueryOptions{RequireConsistent: true})
+			if err != nil {
+				return false, err
+			}
+			return kv != nil, nil
+		})
+	}
+	return exists, nil
+}
+
+func (consulDriver) totalKeys(lg *zap.Logger, endpoints []string) map[string]int64 {
+	return getTotalKeysConsul(lg, endpoints)
+}
+
+func (consulDriver) memberStatus(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, timeout time.Duration) (memberLeaderState, error) {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = gcfg.DatabaseEndpoints[idx]
+	cli, err := consulapi.NewClient(dcfg)
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	leader, err := cli.Status().Leader() // x.x.x.x:8300
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	did := dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[gcfg.DatabaseID])
+	peers, err := dbtesterpb.ParsePeers(did, gcfg.PeerIPsString)
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	// the leader is the server RPC address of the member
+	st := memberLeaderState{id: peers[idx].PeerAddr(), leader: leader}
+	raft, err := consulRaftStats(gcfg.DatabaseEndpoints[idx])
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	s, _ := raft["term"].(string)
+	if st.term, err = strconv.ParseInt(s, 10, 64); err != nil {
+		return memberLeaderState{}, err
+	}
+	s, _ = raft["applied_index"].(string)
+	if st.index, err = strconv.ParseInt(s, 10, 64); err != nil {
+		return memberLeaderState{}, err
+	}
+	return st, nil
+}
+
+// consulRaftStats returns the raft stats of the Consul agent.
+func consulRaftStats(ep string) (map[string]interface{}, error) {
+	dcfg := consulapi.DefaultConfig()
+	dcfg.Address = ep
+	cli, err := consulapi.NewClient(dcfg)
+	if err != nil {
+		return nil, err
+	}
+	self, err := cli.Agent().Self()
+	if err != nil {
+		return nil, err
+	}
+	raft, ok := self["Stats"]["raft"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no raft stats in %q", ep)
+	}
+	return raft, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// customProtocolClientPorts are the default client ports of the
+// protocols that "custom" databases speak.
+var customProtocolClientPorts = map[string]int64{
+	"etcd":      defaultEtcdClientPort,
+	"zookeeper": defaultZookeeperClientPort,
+	"consul":    defaultConsulClientPort,
+}
+
+func init() { registerDriver("custom", customDriver{}) }
+
+// customDriver is the driver of any server that the agent starts with
+// the configured command. Clients are those of the configured protocol.
+type customDriver struct{ driver }
+
+func (customDriver) configure(gcfg dbtesterpb.ConfigClientMachineAgentControl) (driver, error) {
+	fl := gcfg.Flag_Custom
+	if fl == nil {
+		return nil, fmt.Errorf("%q needs 'protocol' and 'command' in %q", gcfg.DatabaseID, "custom")
+	}
+	if strings.TrimSpace(fl.Command) == "" {
+		return nil, fmt.Errorf("%q has empty command", gcfg.DatabaseID)
+	}
+	if _, ok := customProtocolClientPorts[fl.Protocol]; !ok {
+		return nil, fmt.Errorf("%q has unknown protocol %q (must be 'etcd', 'zookeeper' or 'consul')", gcfg.DatabaseID, fl.Protocol)
+	}
+	d, ok := familyDriver(fl.Protocol)
+	if !ok {
+		return nil, fmt.Errorf("%q protocol has no driver", fl.Protocol)
+	}
+	return customDriver{driver: d}, nil
+}
+
+func (customDriver) setDefaults(gcfg *dbtesterpb.ConfigClientMachineAgentControl) {
+	if gcfg.AgentPortToConnect == 0 {
+		gcfg.AgentPortToConnect = defaultAgentPort
+	}
+	if gcfg.DatabasePortToConnect == 0 {
+		gcfg.DatabasePortToConnect = customProtocolClientPorts[gcfg.Flag_Custom.Protocol]
+	}
+}
+
+func (customDriver) setRequestFlags(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, req *dbtesterpb.Request) error {
+	req.Flag_Custom = &dbtesterpb.Flag_Custom{
+		Protocol: gcfg.Flag_Custom.Protocol,
+		Command:  gcfg.Flag_Custom.Command,
+	}
+	return nil
+}
+
+// clientFamily returns the family of the client protocol of the database
+// (e.g. "etcd" for "etcd__v3_3", or "custom" with the etcd protocol).
+func clientFamily(gcfg dbtesterpb.ConfigClientMachineAgentControl) string {
+	family := dbtesterpb.DatabaseFamily(gcfg.DatabaseID)
+	if family == "custom" && gcfg.Flag_Custom != nil {
+		return gcfg.Flag_Custom.Protocol
+	}
+	return family
+}
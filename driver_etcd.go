@@ -0,0 +1,228 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/coreos/etcd/clientv3"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultEtcdClientPort     int64 = 2379
+	defaultEtcdSnapshotCount  int64 = 100000
+	defaultEtcdQuotaSizeBytes int64 = 8000000000
+
+	maxEtcdQuotaSize = 8000000000
+)
+
+func init() { registerDriver("etcd", etcdDriver{}) }
+
+// etcdDriver is the driver of etcd v3.
+type etcdDriver struct{}
+
+// etcdFlags returns the etcd flags, which are allocated if nil.
+func etcdFlags(gcfg *dbtesterpb.ConfigClientMachineAgentControl) *dbtesterpb.Flag_Etcd {
+	if gcfg.Flag_Etcd == nil {
+		gcfg.Flag_Etcd = &dbtesterpb.Flag_Etcd{}
+	}
+	return gcfg.Flag_Etcd
+}
+
+func (etcdDriver) setDefaults(gcfg *dbtesterpb.ConfigClientMachineAgentControl) {
+	if gcfg.AgentPortToConnect == 0 {
+		gcfg.AgentPortToConnect = defaultAgentPort
+	}
+	if gcfg.DatabasePortToConnect == 0 {
+		gcfg.DatabasePortToConnect = defaultEtcdClientPort
+	}
+	fl := etcdFlags(gcfg)
+	if fl.SnapshotCount == 0 {
+		fl.SnapshotCount = defaultEtcdSnapshotCount
+	}
+	if fl.QuotaSizeBytes == 0 {
+		fl.QuotaSizeBytes = defaultEtcdQuotaSizeBytes
+	}
+}
+
+func (etcdDriver) setRequestFlags(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, req *dbtesterpb.Request) error {
+	fl := etcdFlags(&gcfg)
+	if fl.QuotaSizeBytes > maxEtcdQuotaSize {
+		return fmt.Errorf("maximum etcd quota is 8 GB (%d), got %d", maxEtcdQuotaSize, fl.QuotaSizeBytes)
+	}
+	req.Flag_Etcd = &dbtesterpb.Flag_Etcd{SnapshotCount: fl.SnapshotCount, QuotaSizeBytes: fl.QuotaSizeBytes}
+	return nil
+}
+
+func (etcdDriver) writeRequest(key string, value []byte) request {
+	return request{etcdv3Op: clientv3.OpPut(key, string(value))}
+}
+
+func (etcdDriver) readRequest(key string, staleRead bool) request {
+	opts := []clientv3.OpOption{clientv3.WithRange("")}
+	if staleRead {
+		opts = append(opts, clientv3.WithSerializable())
+	}
+	return request{etcdv3Op: clientv3.OpGet(key, opts...)}
+}
+
+func (etcdDriver) put(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, value []byte) error {
+	clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   1,
+		totalClients: 1,
+	})
+	defer clients[0].Close()
+	_, err := clients[0].Do(context.Background(), clientv3.OpPut(key, string(value)))
+	return err
+}
+
+func (etcdDriver) newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	etcdClients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+	})
+	for i := range etcdClients {
+		rhs[i] = newPutEtcd3(etcdClients[i])
+	}
+
+	done = func() {
+		// this "done" function may decrease throughput at the end
+		for i := range etcdClients {
+			go func(idx int) {
+				etcdClients[idx].Close()
+			}(i)
+		}
+	}
+	return rhs, done
+}
+
+func (etcdDriver) newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+		totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
+		totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
+	})
+	for i := range clients {
+		rhs[i] = newGetEtcd3(clients[i].KV)
+	}
+	done = func() {
+		for i := range clients {
+			clients[i].Close()
+		}
+	}
+	return rhs, done
+}
+
+func (etcdDriver) newSessionHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string, cnt *sessionCounter) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, len(keys))
+	conns := mustCreateConnsEtcdv3(gcfg.DatabaseEndpoints, 2*int64(len(keys)))
+	for i := range rhs {
+		w, r := newSessionEtcd3(conns[2*i], conns[2*i+1], gcfg.ConfigClientMachineBenchmarkOptions.StaleRead)
+		rhs[i] = newSessionHandler(keys[i], gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes, w, r, cnt)
+	}
+	done = func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}
+	return rhs, done
+}
+
+func (etcdDriver) newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
+	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	for i := range rhs {
+		rhs[i] = func(ctx context.Context, req *request) error {
+			conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
+				totalConns:   1,
+				totalClients: 1,
+			})
+			defer conns[0].Close()
+			return newGetEtcd3(conns[0])(ctx, req)
+		}
+	}
+	return rhs
+}
+
+func (etcdDriver) newKeyCheckers(gcfg dbtesterpb.ConfigClientMachineAgentControl, n int) (exists []func(key string) (bool, error), done func()) {
+	clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{totalConns: int64(n), totalClients: int64(n)})
+	for i := range clients {
+		cli := clients[i]
+		exists = append(exists, func(key string) (bool, error) {
+			resp, err := cli.Get(context.Background(), key, clientv3.WithCountOnly())
+			if err != nil {
+				return false, err
+			}
+			return resp.Count > 0, nil
+		})
+	}
+	done = func() {
+		for i := range clients {
+			clients[i].Close()
+		}
+	}
+	return exists, done
+}
+
+func (etcdDriver) totalKeys(lg *zap.Logger, endpoints []string) map[string]int64 {
+	return getTotalKeysEtcdv3(lg, endpoints)
+}
+
+func (etcdDriver) memberStatus(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, timeout time.Duration) (memberLeaderState, error) {
+	ep, err := etcdEndpoint(gcfg, idx)
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{ep}, DialTimeout: timeout})
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	resp, err := cli.Status(ctx, ep)
+	cancel()
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	st := memberLeaderState{
+		id:    fmt.Sprintf("%x", resp.Header.MemberId),
+		term:  int64(resp.RaftTerm),
+		index: int64(resp.RaftIndex),
+	}
+	if resp.Leader != 0 {
+		st.leader = fmt.Sprintf("%x", resp.Leader)
+	}
+	return st, nil
+}
+
+// etcdEndpoint returns the etcd client endpoint of the member,
+// which is the etcd behind the proxy for zetcd and cetcd.
+func etcdEndpoint(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) (string, error) {
+	did := dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[gcfg.DatabaseID])
+	peers, err := dbtesterpb.ParsePeers(did, gcfg.PeerIPsString)
+	if err != nil {
+		return "", err
+	}
+	if idx < 0 || idx >= len(peers) {
+		return "", fmt.Errorf("member index %d is out of range [0, %d)", idx, len(peers))
+	}
+	return peers[idx].Addr(peers[idx].EtcdClientPort(did)), nil
+}
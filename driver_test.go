@@ -0,0 +1,112 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestGetDriver(t *testing.T) {
+	for _, id := range dbtesterpb.GetAllDatabaseIDs() {
+		gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+			DatabaseID:    id,
+			DatabaseFlags: dbtesterpb.DatabaseFlags{Flag_Custom: &dbtesterpb.Flag_Custom{Protocol: "etcd", Command: "kv-server"}},
+		}
+		if _, err := getDriver(gcfg); err != nil {
+			t.Fatalf("%q has no driver (%v)", id, err)
+		}
+	}
+	if _, err := getDriver(dbtesterpb.ConfigClientMachineAgentControl{DatabaseID: "unknown__v1"}); err == nil {
+		t.Fatal("expected error for unknown database ID")
+	}
+}
+
+func TestGetDriverCustom(t *testing.T) {
+	tests := []struct {
+		fl   *dbtesterpb.Flag_Custom
+		want driver
+		port int64
+	}{
+		{&dbtesterpb.Flag_Custom{Protocol: "etcd", Command: "kv-server"}, etcdDriver{}, defaultEtcdClientPort},
+		{&dbtesterpb.Flag_Custom{Protocol: "zookeeper", Command: "kv-server"}, zookeeperDriver{}, defaultZookeeperClientPort},
+		{&dbtesterpb.Flag_Custom{Protocol: "consul", Command: "kv-server"}, consulDriver{}, defaultConsulClientPort},
+		{&dbtesterpb.Flag_Custom{Protocol: "redis", Command: "kv-server"}, nil, 0},
+		{&dbtesterpb.Flag_Custom{Protocol: "etcd", Command: " "}, nil, 0},
+		{nil, nil, 0},
+	}
+	for i, tt := range tests {
+		gcfg := dbtesterpb.ConfigClientMachineAgentControl{DatabaseID: "custom", DatabaseFlags: dbtesterpb.DatabaseFlags{Flag_Custom: tt.fl}}
+		d, err := getDriver(gcfg)
+		if tt.want == nil {
+			if err == nil {
+				t.Fatalf("#%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		cd, ok := d.(customDriver)
+		if !ok || cd.driver != tt.want {
+			t.Fatalf("#%d: expected custom driver with %T, got %#v", i, tt.want, d)
+		}
+		d.setDefaults(&gcfg)
+		if gcfg.DatabasePortToConnect != tt.port {
+			t.Fatalf("#%d: expected client port %d, got %d", i, tt.port, gcfg.DatabasePortToConnect)
+		}
+		if clientFamily(gcfg) != tt.fl.Protocol {
+			t.Fatalf("#%d: expected client family %q, got %q", i, tt.fl.Protocol, clientFamily(gcfg))
+		}
+	}
+}
+
+func TestToRequestDatabaseFamily(t *testing.T) {
+	gcfg := dbtesterpb.ConfigClientMachineAgentControl{
+		DatabaseID:      "zookeeper",
+		DatabaseVersion: "3.6",
+		PeerIPsString:   "10.0.0.1:2181:2888___10.0.0.2:2181:2888",
+		DatabaseFlags: dbtesterpb.DatabaseFlags{
+			Flag_Zookeeper: &dbtesterpb.Flag_Zookeeper{TickTime: 3000},
+		},
+
+		ConfigClientMachineBenchmarkOptions: &dbtesterpb.ConfigClientMachineBenchmarkOptions{},
+		ConfigClientMachineBenchmarkSteps:   &dbtesterpb.ConfigClientMachineBenchmarkSteps{},
+	}
+	dr, err := getDriver(gcfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dr.setDefaults(&gcfg)
+	cfg := &Config{
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{"zookeeper": gcfg},
+	}
+
+	req, err := cfg.ToRequest("zookeeper", dbtesterpb.Operation_Start, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.DatabaseID != dbtesterpb.DatabaseID_zookeeper {
+		t.Fatalf("expected %v, got %v", dbtesterpb.DatabaseID_zookeeper, req.DatabaseID)
+	}
+	if req.DatabaseVersion != "3.6" {
+		t.Fatalf("expected version %q, got %q", "3.6", req.DatabaseVersion)
+	}
+	fl := req.Flag_Zookeeper
+	if fl == nil || fl.MyID != 2 || fl.TickTime != 3000 || fl.SnapCount != defaultZookeeperSnapCount {
+		t.Fatalf("unexpected flags %+v", fl)
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/samuel/go-zookeeper/zk"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultZookeeperClientPort           int64 = 2181
+	defaultZookeeperSnapCount            int64 = 100000
+	defaultZookeeperTickTime             int64 = 2000
+	defaultZookeeperInitLimit            int64 = 5
+	defaultZookeeperSyncLimit            int64 = 5
+	defaultZookeeperMaxClientConnections int64 = 5000
+)
+
+func init() { registerDriver("zookeeper", zookeeperDriver{}) }
+
+// zookeeperDriver is the driver of Zookeeper.
+type zookeeperDriver struct{}
+
+// zookeeperFlags returns the Zookeeper flags, which are allocated if nil.
+func zookeeperFlags(gcfg *dbtesterpb.ConfigClientMachineAgentControl) *dbtesterpb.Flag_Zookeeper {
+	if gcfg.Flag_Zookeeper == nil {
+		gcfg.Flag_Zookeeper = &dbtesterpb.Flag_Zookeeper{}
+	}
+	return gcfg.Flag_Zookeeper
+}
+
+func (zookeeperDriver) setDefaults(gcfg *dbtesterpb.ConfigClientMachineAgentControl) {
+	if gcfg.AgentPortToConnect == 0 {
+		gcfg.AgentPortToConnect = defaultAgentPort
+	}
+	if gcfg.DatabasePortToConnect == 0 {
+		gcfg.DatabasePortToConnect = defaultZookeeperClientPort
+	}
+	fl := zookeeperFlags(gcfg)
+	fl.ClientPort = gcfg.DatabasePortToConnect
+	if fl.TickTime == 0 {
+		fl.TickTime = defaultZookeeperTickTime
+	}
+	if fl.InitLimit == 0 {
+		fl.InitLimit = defaultZookeeperInitLimit
+	}
+	if fl.SyncLimit == 0 {
+		fl.SyncLimit = defaultZookeeperSyncLimit
+	}
+	if fl.SnapCount == 0 {
+		fl.SnapCount = defaultZookeeperSnapCount
+	}
+	if fl.MaxClientConnections == 0 {
+		fl.MaxClientConnections = defaultZookeeperMaxClientConnections
+	}
+}
+
+func (zookeeperDriver) setRequestFlags(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, req *dbtesterpb.Request) error {
+	fl := zookeeperFlags(&gcfg)
+	req.Flag_Zookeeper = &dbtesterpb.Flag_Zookeeper{
+		JavaDJuteMaxBuffer:   fl.JavaDJuteMaxBuffer,
+		JavaXms:              fl.JavaXms,
+		JavaXmx:              fl.JavaXmx,
+		MyID:                 uint32(idx + 1),
+		ClientPort:           fl.ClientPort,
+		TickTime:             fl.TickTime,
+		InitLimit:            fl.InitLimit,
+		SyncLimit:            fl.SyncLimit,
+		SnapCount:            fl.SnapCount,
+		MaxClientConnections: fl.MaxClientConnections,
+	}
+	return nil
+}
+
+func (zookeeperDriver) writeRequest(key string, value []byte) request {
+	return request{zkOp: zkOp{key: "/" + key, value: value}}
+}
+
+func (zookeeperDriver) readRequest(key string, staleRead bool) request {
+	return request{zkOp: zkOp{key: key, staleRead: staleRead}}
+}
+
+func (zookeeperDriver) put(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, value []byte) error {
+	conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, 1)
+	defer conns[0].Close()
+	_, err := conns[0].Create("/"+key, value, zkCreateFlags, zkCreateACL)
+	return err
+}
+
+func (zookeeperDriver) newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
+		valueBts := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
+		lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+		var err error
+		for i := 0; i < 7; i++ {
+			conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+			_, err = conns[0].Create("/"+key, valueBts, zkCreateFlags, zkCreateACL)
+			if err != nil {
+				continue
+			}
+			for j := range conns {
+				conns[j].Close()
+			}
+			lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+			break
+		}
+		if err != nil {
+			lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+			os.Exit(1)
+		}
+	}
+
+	conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+	for i := range conns {
+		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+			rhs[i] = newPutOverwriteZK(conns[i])
+		} else {
+			rhs[i] = newPutCreateZK(conns[i])
+		}
+	}
+	done = func() {
+		for i := range conns {
+			go func(idx int) {
+				conns[idx].Close()
+			}(i)
+		}
+	}
+	return rhs, done
+}
+
+func (zookeeperDriver) newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+	for i := range conns {
+		rhs[i] = newGetZK(conns[i])
+	}
+	done = func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}
+	return rhs, done
+}
+
+func (zookeeperDriver) newSessionHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string, cnt *sessionCounter) (rhs []ReqHandler, done func()) {
+	rhs = make([]ReqHandler, len(keys))
+	conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, 2*int64(len(keys)))
+	for i := range rhs {
+		w, r := newSessionZK(conns[2*i], conns[2*i+1], gcfg.ConfigClientMachineBenchmarkOptions.StaleRead)
+		rhs[i] = newSessionHandler(keys[i], gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes, w, r, cnt)
+	}
+	done = func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}
+	return rhs, done
+}
+
+func (zookeeperDriver) newReadOneshotHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
+	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	for i := range rhs {
+		rhs[i] = func(ctx context.Context, req *request) error {
+			conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+			defer conns[0].Close()
+			return newGetZK(conns[0])(ctx, req)
+		}
+	}
+	return rhs
+}
+
+func (zookeeperDriver) newKeyCheckers(gcfg dbtesterpb.ConfigClientMachineAgentControl, n int) (exists []func(key string) (bool, error), done func()) {
+	conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, int64(n))
+	for i := range conns {
+		conn := conns[i]
+		exists = append(exists, func(key string) (bool, error) {
+			if _, err := conn.Sync(key); err != nil {
+				return false, err
+			}
+			ok, _, err := conn.Exists(key)
+			return ok, err
+		})
+	}
+	done = func() {
+		for i := range conns {
+			conns[i].Close()
+		}
+	}
+	return exists, done
+}
+
+func (zookeeperDriver) totalKeys(lg *zap.Logger, endpoints []string) map[string]int64 {
+	return getTotalKeysZk(lg, endpoints)
+}
+
+func (zookeeperDriver) memberStatus(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, timeout time.Duration) (memberLeaderState, error) {
+	stats, ok := zk.FLWSrvr([]string{gcfg.DatabaseEndpoints[idx]}, timeout)
+	if !ok || len(stats) != 1 {
+		return memberLeaderState{}, fmt.Errorf("srvr failed on %q", gcfg.DatabaseEndpoints[idx])
+	}
+	if stats[0].Error != nil {
+		return memberLeaderState{}, stats[0].Error
+	}
+	// followers do not tell the leader, so only the leader votes,
+	// and zxid is the epoch in high 32 bits and the counter in low 32 bits
+	st := memberLeaderState{
+		id:    gcfg.DatabaseEndpoints[idx],
+		term:  int64(stats[0].Epoch),
+		index: int64(stats[0].Epoch)<<32 | int64(uint32(stats[0].Counter)),
+	}
+	if stats[0].Mode == zk.ModeLeader {
+		st.leader = st.id
+	}
+	return st, nil
+}
@@ -0,0 +1,269 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// faultTypeToOperation maps fault type in configuration to agent operation.
+var faultTypeToOperation = map[string]dbtesterpb.Operation{
+	"kill":        dbtesterpb.Operation_Kill,
+	"restart":     dbtesterpb.Operation_Restart,
+	"pause":       dbtesterpb.Operation_Pause,
+	"resume":      dbtesterpb.Operation_Resume,
+	"kill-leader": dbtesterpb.Operation_Kill,
+	"partition":   dbtesterpb.Operation_ApplyNetworkFault,
+	"netem":       dbtesterpb.Operation_ApplyNetworkFault,
+	"heal":        dbtesterpb.Operation_RemoveNetworkFault,
+
+	"member-add":    dbtesterpb.Operation_Join,
+	"member-remove": dbtesterpb.Operation_Leave,
+
+	"upgrade": dbtesterpb.Operation_Upgrade,
+}
+
+// faultEvent is a fault that has been injected.
+type faultEvent struct {
+	unixNano    int64
+	typ         string
+	memberIndex int
+	endpoint    string
+	err         error
+
+	// unavailable is the longest duration without any successful
+	// client request, from this fault until the next one.
+	unavailable time.Duration
+	// catchUp is the duration for the added member to catch up.
+	catchUp time.Duration
+
+	// term is the leader term right after this fault, to count
+	// the leader elections until the next fault.
+	term      int64
+	elections int64
+	// stats is of the requests from this fault until the next one.
+	stats windowStats
+}
+
+// InjectFaults injects the configured faults at their offsets from now.
+// Faults whose offsets have not been reached are dropped when 'stopc'
+// is closed. 'stopc' must be closed after the benchmark finishes. Then
+// the injected faults are saved to 'ClientFaultTimelinePath', with the
+// unavailability windows that clients observed.
+func (cfg *Config) InjectFaults(databaseID string, stopc <-chan struct{}) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	faults := make([]dbtesterpb.ConfigClientMachineFault, len(gcfg.Faults))
+	for i := range gcfg.Faults {
+		faults[i] = *gcfg.Faults[i]
+	}
+	sort.SliceStable(faults, func(i, j int) bool { return faults[i].OffsetMillisecond < faults[j].OffsetMillisecond })
+
+	var events []faultEvent
+	members := newClusterMembers(gcfg)
+	now, lastIdx := time.Now(), -1
+	for _, ft := range faults {
+		select {
+		case <-time.After(time.Until(now.Add(time.Duration(ft.OffsetMillisecond) * time.Millisecond))):
+		case <-stopc:
+			cfg.lg.Warn("benchmark finished before fault", zap.String("type", ft.Type), zap.Int64("offset-ms", ft.OffsetMillisecond))
+			continue
+		}
+
+		idx := int(ft.MemberIndex)
+		switch {
+		case ft.Type == "kill-leader":
+			var err error
+			idx, err = findLeader(gcfg)
+			if err != nil {
+				cfg.lg.Warn("failed to find leader", zap.Error(err))
+				events = append(events, faultEvent{unixNano: time.Now().UnixNano(), typ: ft.Type, memberIndex: -1, err: err})
+				continue
+			}
+		case idx == -1:
+			idx = lastIdx
+		}
+		if idx < 0 || idx >= len(gcfg.DatabaseEndpoints) {
+			err := fmt.Errorf("no member to inject %q (index %d)", ft.Type, idx)
+			cfg.lg.Warn("failed to inject fault", zap.Error(err))
+			events = append(events, faultEvent{unixNano: time.Now().UnixNano(), typ: ft.Type, memberIndex: idx, err: err})
+			continue
+		}
+		lastIdx = idx
+
+		ev := faultEvent{unixNano: time.Now().UnixNano(), typ: ft.Type, memberIndex: idx, endpoint: gcfg.DatabaseEndpoints[idx]}
+
+		// cluster is the membership after the fault
+		var cluster []uint32
+		switch {
+		case ft.Type == "member-add" && members[idx]:
+			ev.err = fmt.Errorf("member %d is already in cluster %v", idx, members.ipIndexes())
+		case ft.Type == "member-remove" && !members[idx]:
+			ev.err = fmt.Errorf("member %d is not in cluster %v", idx, members.ipIndexes())
+		case ft.Type == "member-add":
+			members[idx] = true
+			cluster = members.ipIndexes()
+			delete(members, idx)
+		case ft.Type == "member-remove":
+			delete(members, idx)
+			cluster = members.ipIndexes()
+			members[idx] = true
+		}
+		if ev.err != nil {
+			cfg.lg.Warn("failed to inject fault", zap.Error(ev.err))
+			events = append(events, ev)
+			continue
+		}
+
+		cfg.lg.Info("injecting fault", zap.String("type", ft.Type), zap.Int("member-index", idx), zap.String("endpoint", ev.endpoint))
+		if ev.err = cfg.injectFault(databaseID, gcfg, ft, idx, cluster); ev.err != nil {
+			cfg.lg.Warn("failed to inject fault", zap.String("type", ft.Type), zap.Int("member-index", idx), zap.Error(ev.err))
+		}
+		ev.term = clusterTerm(gcfg)
+		if ev.err == nil {
+			switch ft.Type {
+			case "member-add":
+				others := members.ipIndexes()
+				members[idx] = true
+				ev.catchUp, ev.err = waitCatchUp(gcfg, idx, others, time.Unix(0, ev.unixNano))
+				cfg.lg.Info("member caught up", zap.Int("member-index", idx), zap.Duration("took", ev.catchUp), zap.Error(ev.err))
+			case "member-remove":
+				delete(members, idx)
+			}
+		}
+		events = append(events, ev)
+	}
+
+	<-stopc
+	end, endTerm := time.Now().UnixNano(), clusterTerm(gcfg)
+	for i := range events {
+		nextTerm := endTerm
+		if i+1 < len(events) {
+			nextTerm = events[i+1].term
+		}
+		if events[i].term > 0 && nextTerm > events[i].term {
+			events[i].elections = nextTerm - events[i].term
+		}
+	}
+	if cfg.avail != nil {
+		for i := range events {
+			to := end
+			if i+1 < len(events) {
+				to = events[i+1].unixNano
+			} else if last := cfg.avail.last(); last > events[i].unixNano {
+				// do not count the time to finish the benchmark
+				to = last + 1
+			}
+			events[i].unavailable = cfg.avail.unavailable(events[i].unixNano, to)
+			events[i].stats = cfg.avail.stats(events[i].unixNano, to)
+		}
+	}
+
+	return cfg.saveFaultTimeline(events)
+}
+
+func (cfg *Config) injectFault(databaseID string, gcfg dbtesterpb.ConfigClientMachineAgentControl, ft dbtesterpb.ConfigClientMachineFault, idx int, cluster []uint32) error {
+	req, err := cfg.ToRequest(databaseID, faultTypeToOperation[ft.Type], idx)
+	if err != nil {
+		return err
+	}
+	if cluster != nil {
+		req.ClusterIPIndexes = cluster
+	}
+	if ft.Type == "upgrade" {
+		ureq, err := cfg.ToRequest(ft.UpgradeDatabaseID, dbtesterpb.Operation_Upgrade, idx)
+		if err != nil {
+			return err
+		}
+		req.UpgradeDatabaseID = ureq.DatabaseID
+		req.DatabaseVersion = ureq.DatabaseVersion
+		req.DatabaseFlags = ureq.DatabaseFlags
+	}
+	if ft.Type == "partition" || ft.Type == "netem" {
+		nf := &dbtesterpb.NetworkFault{Partition: ft.Type == "partition"}
+		if ft.Type == "netem" {
+			nf.DelayMillisecond = ft.DelayMillisecond
+			nf.JitterMillisecond = ft.JitterMillisecond
+			nf.LossPercent = ft.LossPercent
+		}
+		if len(ft.TargetMemberIndexes) == 0 {
+			for i := range gcfg.PeerIPs {
+				if i != idx {
+					nf.TargetIPIndexes = append(nf.TargetIPIndexes, uint32(i))
+				}
+			}
+		} else {
+			for _, i := range ft.TargetMemberIndexes {
+				nf.TargetIPIndexes = append(nf.TargetIPIndexes, uint32(i))
+			}
+		}
+		req.NetworkFault = nf
+	}
+	_, err = cfg.sendRequest(idx, gcfg.AgentEndpoints[idx], req)
+	return err
+}
+
+func (cfg *Config) saveFaultTimeline(events []faultEvent) error {
+	c1 := dataframe.NewColumn("UNIX-SECOND")
+	c2 := dataframe.NewColumn("UNIX-NANOSECOND")
+	c3 := dataframe.NewColumn("FAULT")
+	c4 := dataframe.NewColumn("MEMBER-INDEX")
+	c5 := dataframe.NewColumn("DATABASE-ENDPOINT")
+	c6 := dataframe.NewColumn("ERROR")
+	c7 := dataframe.NewColumn("UNAVAILABLE-MILLISECOND")
+	c8 := dataframe.NewColumn("CATCH-UP-MILLISECOND")
+	c9 := dataframe.NewColumn("LEADER-ELECTIONS")
+	c10 := dataframe.NewColumn("REQUESTS")
+	c11 := dataframe.NewColumn("ERRORS")
+	c12 := dataframe.NewColumn("AVG-LATENCY-MILLISECOND")
+	c13 := dataframe.NewColumn("MAX-LATENCY-MILLISECOND")
+	for _, ev := range events {
+		c1.PushBack(dataframe.NewStringValue(ev.unixNano / int64(time.Second)))
+		c2.PushBack(dataframe.NewStringValue(ev.unixNano))
+		c3.PushBack(dataframe.NewStringValue(ev.typ))
+		c4.PushBack(dataframe.NewStringValue(ev.memberIndex))
+		c5.PushBack(dataframe.NewStringValue(ev.endpoint))
+		errTxt := ""
+		if ev.err != nil {
+			errTxt = ev.err.Error()
+		}
+		c6.PushBack(dataframe.NewStringValue(errTxt))
+		c7.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", toMillisecond(ev.unavailable))))
+		c8.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", toMillisecond(ev.catchUp))))
+		c9.PushBack(dataframe.NewStringValue(ev.elections))
+		c10.PushBack(dataframe.NewStringValue(ev.stats.requests))
+		c11.PushBack(dataframe.NewStringValue(ev.stats.errors))
+		c12.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", toMillisecond(ev.stats.avgLatency))))
+		c13.PushBack(dataframe.NewStringValue(fmt.Sprintf("%.3f", toMillisecond(ev.stats.maxLatency))))
+	}
+
+	fr := dataframe.New()
+	for _, c := range []dataframe.Column{c1, c2, c3, c4, c5, c6, c7, c8, c9, c10, c11, c12, c13} {
+		if err := fr.AddColumn(c); err != nil {
+			return err
+		}
+	}
+	return fr.CSV(cfg.ConfigClientMachineInitial.ClientFaultTimelinePath)
+}
@@ -132,6 +132,9 @@ type CumulativeKeyNumAndOtherData struct {
 
 	AvgReadBytesDelta  float64
 	AvgWriteBytesDelta float64
+
+	AvgDiskApparentBytes  float64
+	AvgDiskAllocatedBytes float64
 }
 
 // CumulativeKeyNumAndOtherDataSlice is a slice of CumulativeKeyNumAndOtherData to sort by CumulativeKeyNum.
@@ -206,6 +209,9 @@ func FindRangesData(data []CumulativeKeyNumAndOtherData, unit int64, totalReques
 			MaxMemoryMB:        v.MaxMemoryMB,
 			AvgReadBytesDelta:  v.AvgReadBytesDelta,
 			AvgWriteBytesDelta: v.AvgWriteBytesDelta,
+
+			AvgDiskApparentBytes:  v.AvgDiskApparentBytes,
+			AvgDiskAllocatedBytes: v.AvgDiskAllocatedBytes,
 		})
 	}
 
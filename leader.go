@@ -0,0 +1,75 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// findLeader returns the index of the current leader in 'PeerIPs'.
+func findLeader(gcfg dbtesterpb.ConfigClientMachineAgentControl) (int, error) {
+	dr, err := getDriver(gcfg)
+	if err != nil {
+		return -1, err
+	}
+	for i := range gcfg.PeerIPs {
+		st, err := dr.memberStatus(gcfg, i, 5*time.Second)
+		if err != nil {
+			continue
+		}
+		if st.leader != "" && st.leader == st.id {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("no leader found in %q", gcfg.DatabaseID)
+}
+
+// clusterTerm returns the highest leader term among the members
+// (etcd raft term, Zookeeper epoch, Consul raft term), whose
+// increments are leader elections. It returns 0 if no member answers.
+func clusterTerm(gcfg dbtesterpb.ConfigClientMachineAgentControl) int64 {
+	termc := make(chan int64, len(gcfg.PeerIPs))
+	for i := range gcfg.PeerIPs {
+		go func(idx int) {
+			term, err := memberTerm(gcfg, idx)
+			if err != nil {
+				term = 0
+			}
+			termc <- term
+		}(i)
+	}
+	var max int64
+	for range gcfg.PeerIPs {
+		if term := <-termc; term > max {
+			max = term
+		}
+	}
+	return max
+}
+
+func memberTerm(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) (int64, error) {
+	dr, err := getDriver(gcfg)
+	if err != nil {
+		return 0, err
+	}
+	st, err := dr.memberStatus(gcfg, idx, 2*time.Second)
+	if err != nil {
+		return 0, err
+	}
+	return st.term, nil
+}
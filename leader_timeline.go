@@ -0,0 +1,184 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// leaderSampleTimeout is the maximum duration to wait for the members
+// in one sample. Members that do not answer in time (e.g. paused)
+// are counted as not answered.
+const leaderSampleTimeout = 2 * time.Second
+
+// memberLeaderState is the leader and progress that a member reports.
+type memberLeaderState struct {
+	// id identifies the member, and leader is the id of the leader
+	// that the member knows, or empty if it does not know any.
+	id     string
+	leader string
+
+	// term is etcd raft term, Zookeeper epoch, or Consul raft term,
+	// and index is etcd raft index, Zookeeper zxid, or Consul raft
+	// applied index.
+	term  int64
+	index int64
+}
+
+// leaderSample is the cluster leader state sampled at a second.
+type leaderSample struct {
+	unixSecond int64
+
+	// leaderIndex is the index of the leader in 'PeerIPs',
+	// or -1 if no member knows the leader.
+	leaderIndex int
+	term        int64
+	index       int64
+	answered    int
+	changed     bool
+}
+
+// SampleLeaders polls all members for the leader and its term every
+// second, until 'stopc' is closed, and saves the leader timeline.
+func (cfg *Config) SampleLeaders(databaseID string, stopc <-chan struct{}) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var samples []leaderSample
+	for {
+		select {
+		case <-stopc:
+			cfg.saveLeaderTimeline(samples)
+			return nil
+		case now := <-ticker.C:
+			s := sampleLeader(gcfg, now)
+			if n := len(samples); n > 0 {
+				s.changed = s.leaderIndex != samples[n-1].leaderIndex || s.term != samples[n-1].term
+				if s.changed {
+					cfg.lg.Info("leader changed", zap.Int("leader-index", s.leaderIndex), zap.Int64("term", s.term))
+				}
+			}
+			samples = append(samples, s)
+		}
+	}
+}
+
+// sampleLeader asks all members at once, and picks the leader
+// that most members agree on.
+func sampleLeader(gcfg dbtesterpb.ConfigClientMachineAgentControl, now time.Time) leaderSample {
+	type result struct {
+		idx int
+		st  memberLeaderState
+	}
+	rc := make(chan result, len(gcfg.PeerIPs))
+	for i := range gcfg.PeerIPs {
+		go func(idx int) {
+			st, err := leaderState(gcfg, idx)
+			if err != nil {
+				idx = -1
+			}
+			rc <- result{idx: idx, st: st}
+		}(i)
+	}
+
+	s := leaderSample{unixSecond: now.Unix(), leaderIndex: -1}
+	idToIndex := make(map[string]int)
+	votes := make(map[string]int)
+	timeout := time.After(leaderSampleTimeout)
+collect:
+	for range gcfg.PeerIPs {
+		var rs result
+		select {
+		case rs = <-rc:
+		case <-timeout:
+			break collect
+		}
+		if rs.idx < 0 {
+			continue
+		}
+		s.answered++
+		idToIndex[rs.st.id] = rs.idx
+		if rs.st.leader != "" {
+			votes[rs.st.leader]++
+		}
+		if rs.st.term > s.term {
+			s.term = rs.st.term
+		}
+		if rs.st.index > s.index {
+			s.index = rs.st.index
+		}
+	}
+
+	var leader string
+	for id, v := range votes {
+		if leader == "" || v > votes[leader] {
+			leader = id
+		}
+	}
+	if idx, ok := idToIndex[leader]; ok {
+		s.leaderIndex = idx
+	}
+	return s
+}
+
+func leaderState(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) (memberLeaderState, error) {
+	dr, err := getDriver(gcfg)
+	if err != nil {
+		return memberLeaderState{}, err
+	}
+	return dr.memberStatus(gcfg, idx, time.Second)
+}
+
+func (cfg *Config) saveLeaderTimeline(samples []leaderSample) {
+	c1 := dataframe.NewColumn("UNIX-SECOND")
+	c2 := dataframe.NewColumn("LEADER-INDEX")
+	c3 := dataframe.NewColumn("TERM")
+	c4 := dataframe.NewColumn("INDEX")
+	c5 := dataframe.NewColumn("MEMBERS-ANSWERED")
+	c6 := dataframe.NewColumn("LEADER-CHANGED")
+	for _, s := range samples {
+		changed := 0
+		if s.changed {
+			changed = 1
+		}
+		c1.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", s.unixSecond)))
+		c2.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", s.leaderIndex)))
+		c3.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", s.term)))
+		c4.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", s.index)))
+		c5.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", s.answered)))
+		c6.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", changed)))
+	}
+
+	fr := dataframe.New()
+	for _, c := range []dataframe.Column{c1, c2, c3, c4, c5, c6} {
+		if err := fr.AddColumn(c); err != nil {
+			panic(err)
+		}
+	}
+	if err := fr.CSV(cfg.ConfigClientMachineInitial.ClientLeaderTimelinePath); err != nil {
+		panic(err)
+	}
+}
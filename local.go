@@ -0,0 +1,68 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// localPortStep is the port distance between the members on loopback.
+const localPortStep = 10
+
+// UseLocalPeers places all members of the database on this host, for
+// 'dbtester local'. Peers must be loopback addresses. "host" peers get
+// the default ports, shifted by 10 for each member (e.g. etcd 2379:2380,
+// 2389:2390, 2399:2400), and the agents listen on consecutive ports.
+func (cfg *Config) UseLocalPeers(databaseID string) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("database ID %q is not defined", databaseID)
+	}
+	did := dbtesterpb.DatabaseID(dbtesterpb.DatabaseID_value[databaseID])
+	clientPort, peerPort := dbtesterpb.DefaultPeerPorts(did)
+	if gcfg.DatabasePortToConnect != 0 {
+		clientPort = gcfg.DatabasePortToConnect
+	}
+
+	peerIPs := make([]string, len(gcfg.PeerIPs))
+	for i := range gcfg.PeerIPs {
+		shift := int64(i * localPortStep)
+		p, err := dbtesterpb.ParsePeer(gcfg.PeerIPs[i], clientPort+shift, peerPort+shift)
+		if err != nil {
+			return fmt.Errorf("%q has invalid peer (%v)", databaseID, err)
+		}
+		if !isLoopback(p.Host) {
+			return fmt.Errorf("%q has peer %q out of loopback", databaseID, gcfg.PeerIPs[i])
+		}
+		peerIPs[i] = p.String()
+	}
+	gcfg.PeerIPs = peerIPs
+	if err := setPeerEndpoints(&gcfg); err != nil {
+		return err
+	}
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+	return nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,189 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package local runs the agents, the database cluster and the tests on one host.
+package local
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/etcd-io/dbtester"
+	"github.com/etcd-io/dbtester/agent"
+	"github.com/etcd-io/dbtester/analyze"
+	"github.com/etcd-io/dbtester/control"
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// Command implements 'local' command.
+var Command = &cobra.Command{
+	Use:   "local",
+	Short: "Runs agents, database and tests on this host.",
+	Long: `Runs agents, database and tests on this host.
+
+'local' starts one in-process agent per peer on the loopback address,
+then runs 'control' and 'analyze' of the database with the same config.
+Peers must be loopback addresses (e.g. '127.0.0.1', or '127.0.0.2' with
+loopback aliases for network faults), and "host" peers get the default
+ports shifted by 10 for each member. Each member keeps its data, logs and
+metrics under '--dir', and the agent flags (e.g. '--etcd-exec') apply to
+all members. Logs are not uploaded.`,
+	RunE: commandFunc,
+}
+
+var databaseID string
+var configPath string
+var localDir string
+
+func init() {
+	ids := dbtesterpb.GetAllDatabaseIDs()
+	Command.PersistentFlags().StringVar(&databaseID, "database-id", ids[0], strings.Join(ids, ", "))
+	Command.PersistentFlags().StringVarP(&configPath, "config", "c", "", "YAML configuration file path.")
+	Command.PersistentFlags().StringVar(&localDir, "dir", filepath.Join(os.TempDir(), "dbtester-local"), "Directory to save the data, logs and results of all members.")
+	Command.PersistentFlags().AddFlagSet(agent.Command.PersistentFlags())
+}
+
+func commandFunc(cmd *cobra.Command, args []string) (err error) {
+	if !dbtesterpb.IsValidDatabaseID(databaseID) {
+		return fmt.Errorf("database id %q is unknown", databaseID)
+	}
+
+	cfg, err := dbtester.ReadConfig(configPath, false)
+	if err != nil {
+		return err
+	}
+	if err = cfg.UseLocalPeers(databaseID); err != nil {
+		return err
+	}
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	gcfg.ConfigClientMachineBenchmarkSteps.Step1StartDatabase = true
+	gcfg.ConfigClientMachineBenchmarkSteps.Step2StressDatabase = true
+	gcfg.ConfigClientMachineBenchmarkSteps.Step3StopDatabase = true
+	gcfg.ConfigClientMachineBenchmarkSteps.Step4UploadLogs = false
+	cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID] = gcfg
+
+	paths := make([]agent.LocalPaths, len(gcfg.AgentEndpoints))
+	for i, ep := range gcfg.AgentEndpoints {
+		dir := filepath.Join(localDir, fmt.Sprintf("%s-%d", databaseID, i+1))
+		srv, ps, err := agent.NewLocalServer(dir)
+		if err != nil {
+			return err
+		}
+		paths[i] = ps
+
+		ln, err := net.Listen("tcp", ep)
+		if err != nil {
+			return err
+		}
+		gs := grpc.NewServer()
+		dbtesterpb.RegisterTransporterServer(gs, srv)
+		go gs.Serve(ln)
+		defer gs.Stop()
+
+		lg.Info("started local agent", zap.String("endpoint", ep), zap.String("dir", dir))
+	}
+
+	if err = control.Run(cfg, databaseID); err != nil {
+		// best effort, in case control failed before stopping the database
+		if _, serr := cfg.BroadcaseRequest(databaseID, dbtesterpb.Operation_Stop); serr != nil {
+			lg.Warn("failed to stop database", zap.Error(serr))
+		}
+		return err
+	}
+
+	if _, ok := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]; !ok {
+		lg.Info("skipped analyze; database is not in 'analyze_machine_initial'", zap.String("database-id", databaseID))
+		return nil
+	}
+	useLocalResults(cfg, databaseID, paths)
+	return analyze.Run(cfg)
+}
+
+// useLocalResults points 'analyze' at the results of the local run,
+// instead of the files downloaded from cloud storage, and saves its
+// outputs under '--dir'.
+func useLocalResults(cfg *dbtester.Config, databaseID string, paths []agent.LocalPaths) {
+	cfg.AllDatabaseIDList = []string{databaseID}
+
+	cm := cfg.ConfigClientMachineInitial
+	amc := cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID]
+	amc.ClientSystemMetricsInterpolatedPath = cm.ClientSystemMetricsInterpolatedPath
+	amc.ClientLatencyThroughputTimeseriesPath = cm.ClientLatencyThroughputTimeseriesPath
+	amc.ClientLatencyDistributionAllPath = cm.ClientLatencyDistributionAllPath
+	amc.ClientLatencyDistributionPercentilePath = cm.ClientLatencyDistributionPercentilePath
+	amc.ClientLatencyDistributionSummaryPath = cm.ClientLatencyDistributionSummaryPath
+	amc.ClientLatencyByKeyNumberPath = cm.ClientLatencyByKeyNumberPath
+	amc.ServerDiskSpaceUsageSummaryPath = cm.ServerDiskSpaceUsageSummaryPath
+	if amc.ClientFaultTimelinePath != "" {
+		amc.ClientFaultTimelinePath = cm.ClientFaultTimelinePath
+	}
+	if amc.ClientLeaderTimelinePath != "" {
+		amc.ClientLeaderTimelinePath = cm.ClientLeaderTimelinePath
+	}
+
+	amc.ServerSystemMetricsInterpolatedPathList = make([]string, len(paths))
+	for i := range paths {
+		amc.ServerSystemMetricsInterpolatedPathList[i] = paths[i].SystemMetricsCSVInterpolated
+	}
+	if len(amc.ServerProcessMetricsPathList) > 0 {
+		amc.ServerProcessMetricsPathList = make([]string, len(paths))
+		for i := range paths {
+			amc.ServerProcessMetricsPathList[i] = paths[i].ProcessMetricsCSV
+		}
+	}
+	if len(amc.ServerDiskUsagePathList) > 0 {
+		amc.ServerDiskUsagePathList = make([]string, len(paths))
+		for i := range paths {
+			amc.ServerDiskUsagePathList[i] = paths[i].DiskUsageCSV
+		}
+	}
+	if len(amc.ServerGCMetricsPathList) > 0 {
+		amc.ServerGCMetricsPathList = make([]string, len(paths))
+		for i := range paths {
+			amc.ServerGCMetricsPathList[i] = paths[i].GCMetricsCSV
+		}
+	}
+
+	amc.ServerMemoryByKeyNumberPath = inDir(amc.ServerMemoryByKeyNumberPath)
+	amc.ServerReadBytesDeltaByKeyNumberPath = inDir(amc.ServerReadBytesDeltaByKeyNumberPath)
+	amc.ServerWriteBytesDeltaByKeyNumberPath = inDir(amc.ServerWriteBytesDeltaByKeyNumberPath)
+	amc.AllAggregatedOutputPath = inDir(amc.AllAggregatedOutputPath)
+	if amc.ServerDiskUsageByKeyNumberPath != "" {
+		amc.ServerDiskUsageByKeyNumberPath = inDir(amc.ServerDiskUsageByKeyNumberPath)
+	}
+	cfg.DatabaseIDToConfigAnalyzeMachineInitial[databaseID] = amc
+
+	cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV = inDir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathCSV)
+	cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT = inDir(cfg.ConfigAnalyzeMachineAllAggregatedOutput.AllAggregatedOutputPathTXT)
+	for i := range cfg.AnalyzePlotList {
+		cfg.AnalyzePlotList[i].OutputPathCSV = inDir(cfg.AnalyzePlotList[i].OutputPathCSV)
+		for j := range cfg.AnalyzePlotList[i].OutputPathList {
+			cfg.AnalyzePlotList[i].OutputPathList[j] = inDir(cfg.AnalyzePlotList[i].OutputPathList[j])
+		}
+	}
+	if cfg.ConfigAnalyzeMachineREADME.OutputPath != "" {
+		cfg.ConfigAnalyzeMachineREADME.OutputPath = inDir(cfg.ConfigAnalyzeMachineREADME.OutputPath)
+	}
+}
+
+func inDir(p string) string {
+	return filepath.Join(localDir, filepath.Base(p))
+}
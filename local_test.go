@@ -0,0 +1,57 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func TestUseLocalPeers(t *testing.T) {
+	cfg := &Config{
+		DatabaseIDToConfigClientMachineAgentControl: map[string]dbtesterpb.ConfigClientMachineAgentControl{
+			"zookeeper__r3_5_3_beta": {
+				DatabaseID:            "zookeeper__r3_5_3_beta",
+				PeerIPs:               []string{"127.0.0.1", "127.0.0.1", "127.0.0.2:2181:2888"},
+				DatabasePortToConnect: 2181,
+				AgentPortToConnect:    3500,
+			},
+			"etcd__v3_3": {
+				DatabaseID:         "etcd__v3_3",
+				PeerIPs:            []string{"127.0.0.1", "10.0.0.1"},
+				AgentPortToConnect: 3500,
+			},
+		},
+	}
+	if err := cfg.UseLocalPeers("zookeeper__r3_5_3_beta"); err != nil {
+		t.Fatal(err)
+	}
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl["zookeeper__r3_5_3_beta"]
+	if exp := "127.0.0.1:2181:2888___127.0.0.1:2191:2898___127.0.0.2:2181:2888"; gcfg.PeerIPsString != exp {
+		t.Fatalf("expected %q, got %q", exp, gcfg.PeerIPsString)
+	}
+	if exp := []string{"127.0.0.1:2181", "127.0.0.1:2191", "127.0.0.2:2181"}; !reflect.DeepEqual(gcfg.DatabaseEndpoints, exp) {
+		t.Fatalf("expected %q, got %q", exp, gcfg.DatabaseEndpoints)
+	}
+	if exp := []string{"127.0.0.1:3500", "127.0.0.1:3501", "127.0.0.2:3500"}; !reflect.DeepEqual(gcfg.AgentEndpoints, exp) {
+		t.Fatalf("expected %q, got %q", exp, gcfg.AgentEndpoints)
+	}
+
+	if err := cfg.UseLocalPeers("etcd__v3_3"); err == nil {
+		t.Fatal("expected error for peer out of loopback")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+// catchUpTimeout is the maximum duration to wait for
+// a new member to catch up with the cluster.
+const catchUpTimeout = 5 * time.Minute
+
+// hasMembershipFault returns true if the cluster membership
+// changes during the benchmark.
+func hasMembershipFault(gcfg dbtesterpb.ConfigClientMachineAgentControl) bool {
+	for _, ft := range gcfg.Faults {
+		if ft.Type == "member-add" || ft.Type == "member-remove" {
+			return true
+		}
+	}
+	return false
+}
+
+// initialClusterIPIndexes returns the indexes of the members to start
+// with, or nil if the cluster membership does not change.
+func initialClusterIPIndexes(gcfg dbtesterpb.ConfigClientMachineAgentControl) []uint32 {
+	n := int(gcfg.InitialClusterSize)
+	if n == 0 {
+		if !hasMembershipFault(gcfg) {
+			return nil
+		}
+		n = len(gcfg.PeerIPs)
+	}
+	idxs := make([]uint32, n)
+	for i := range idxs {
+		idxs[i] = uint32(i)
+	}
+	return idxs
+}
+
+// clusterMembers tracks the members of the cluster during the benchmark.
+type clusterMembers map[int]bool
+
+func newClusterMembers(gcfg dbtesterpb.ConfigClientMachineAgentControl) clusterMembers {
+	ms := make(clusterMembers)
+	idxs := initialClusterIPIndexes(gcfg)
+	if idxs == nil {
+		for i := range gcfg.PeerIPs {
+			ms[i] = true
+		}
+	}
+	for _, idx := range idxs {
+		ms[int(idx)] = true
+	}
+	return ms
+}
+
+// ipIndexes returns the sorted member indexes.
+func (ms clusterMembers) ipIndexes() []uint32 {
+	idxs := make([]uint32, 0, len(ms))
+	for idx := range ms {
+		idxs = append(idxs, uint32(idx))
+	}
+	sort.Slice(idxs, func(i, j int) bool { return idxs[i] < idxs[j] })
+	return idxs
+}
+
+// waitCatchUp waits until the member at 'idx' has applied all entries
+// that the other members had applied when it was called, and returns
+// the duration since 'joined'.
+func waitCatchUp(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int, others []uint32, joined time.Time) (time.Duration, error) {
+	var target int64
+	for _, o := range others {
+		p, err := memberProgress(gcfg, int(o))
+		if err != nil {
+			continue
+		}
+		if p > target {
+			target = p
+		}
+	}
+	if target == 0 {
+		return 0, fmt.Errorf("cannot get progress of members %v", others)
+	}
+
+	for time.Since(joined) < catchUpTimeout {
+		p, err := memberProgress(gcfg, idx)
+		if err == nil && p >= target {
+			return time.Since(joined), nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return 0, fmt.Errorf("member %d did not catch up with %d in %v", idx, target, catchUpTimeout)
+}
+
+// memberProgress returns the replication progress of the member:
+// etcd raft index, Zookeeper zxid, or Consul raft applied index.
+func memberProgress(gcfg dbtesterpb.ConfigClientMachineAgentControl, idx int) (int64, error) {
+	dr, err := getDriver(gcfg)
+	if err != nil {
+		return 0, err
+	}
+	st, err := dr.memberStatus(gcfg, idx, 5*time.Second)
+	if err != nil {
+		return 0, err
+	}
+	return st.index, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+)
+
+func Test_initialClusterIPIndexes(t *testing.T) {
+	peers := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}
+	tests := []struct {
+		gcfg dbtesterpb.ConfigClientMachineAgentControl
+		exp  []uint32
+	}{
+		{
+			dbtesterpb.ConfigClientMachineAgentControl{PeerIPs: peers},
+			nil,
+		},
+		{
+			dbtesterpb.ConfigClientMachineAgentControl{PeerIPs: peers, InitialClusterSize: 3},
+			[]uint32{0, 1, 2},
+		},
+		{
+			dbtesterpb.ConfigClientMachineAgentControl{
+				PeerIPs: peers,
+				Faults:  []*dbtesterpb.ConfigClientMachineFault{{Type: "member-remove", MemberIndex: 3}},
+			},
+			[]uint32{0, 1, 2, 3},
+		},
+	}
+	for i, tt := range tests {
+		idxs := initialClusterIPIndexes(tt.gcfg)
+		if !reflect.DeepEqual(idxs, tt.exp) {
+			t.Fatalf("#%d: expected %v, got %v", i, tt.exp, idxs)
+		}
+		if n := len(newClusterMembers(tt.gcfg)); tt.exp != nil && n != len(tt.exp) {
+			t.Fatalf("#%d: expected %d members, got %d", i, len(tt.exp), n)
+		}
+	}
+
+	ms := newClusterMembers(dbtesterpb.ConfigClientMachineAgentControl{PeerIPs: peers, InitialClusterSize: 2})
+	ms[3] = true
+	if idxs := ms.ipIndexes(); !reflect.DeepEqual(idxs, []uint32{0, 1, 3}) {
+		t.Fatalf("unexpected members %v", idxs)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup limits the resources of processes with cgroup v2.
+// See https://www.kernel.org/doc/Documentation/cgroup-v2.txt for more.
+package cgroup
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CPUPeriodMicrosecond is the period of 'cpu.max'.
+const CPUPeriodMicrosecond = 100000
+
+// Limits defines the resource limits. Zero values are unlimited.
+type Limits struct {
+	// CPUs is the CPU quota in the number of CPUs (e.g. 2, 0.5).
+	CPUs float64
+	// MemoryBytes is the hard memory limit.
+	MemoryBytes int64
+	// IOWeight is the proportional block IO weight, between 1 and 10000.
+	IOWeight uint64
+
+	// IODevice is the 'major:minor' number of the block device
+	// to throttle (e.g. "8:0"), required for the throttles below.
+	IODevice    string
+	IOReadBPS   int64
+	IOWriteBPS  int64
+	IOReadIOPS  int64
+	IOWriteIOPS int64
+}
+
+// File is a cgroup interface file and its value.
+type File struct {
+	Name  string
+	Value string
+}
+
+func (f File) String() string { return f.Name + "=" + f.Value }
+
+// Files returns the interface files to write, in order.
+func (l Limits) Files() []File {
+	var fs []File
+	if l.CPUs > 0 {
+		fs = append(fs, File{"cpu.max", fmt.Sprintf("%d %d", int64(l.CPUs*CPUPeriodMicrosecond), CPUPeriodMicrosecond)})
+	}
+	if l.MemoryBytes > 0 {
+		fs = append(fs, File{"memory.max", fmt.Sprintf("%d", l.MemoryBytes)})
+	}
+	if l.IOWeight > 0 {
+		fs = append(fs, File{"io.weight", fmt.Sprintf("default %d", l.IOWeight)})
+	}
+	if l.IODevice != "" {
+		var ss []string
+		for _, kv := range []struct {
+			key string
+			v   int64
+		}{{"rbps", l.IOReadBPS}, {"wbps", l.IOWriteBPS}, {"riops", l.IOReadIOPS}, {"wiops", l.IOWriteIOPS}} {
+			if kv.v > 0 {
+				ss = append(ss, fmt.Sprintf("%s=%d", kv.key, kv.v))
+			}
+		}
+		if len(ss) > 0 {
+			fs = append(fs, File{"io.max", l.IODevice + " " + strings.Join(ss, " ")})
+		}
+	}
+	return fs
+}
+
+// Controllers returns the controllers that the files require.
+func (l Limits) Controllers() []string {
+	var cs []string
+	seen := make(map[string]bool)
+	for _, f := range l.Files() {
+		c := f.Name[:strings.Index(f.Name, ".")]
+		if !seen[c] {
+			seen[c] = true
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// Group is a cgroup.
+type Group struct {
+	Path string
+}
+
+// New creates the cgroup 'name' (e.g. "dbtester/etcd") under the cgroup v2
+// mount point (e.g. "/sys/fs/cgroup"), enabling the controllers in all its
+// ancestors, and writes the limits. The group is reused if it exists.
+func New(mount, name string, l Limits) (*Group, error) {
+	dir := mount
+	cs := l.Controllers()
+	for _, part := range strings.Split(filepath.Clean(name), string(filepath.Separator)) {
+		if len(cs) > 0 {
+			enable := "+" + strings.Join(cs, " +")
+			if err := writeFile(filepath.Join(dir, "cgroup.subtree_control"), enable); err != nil {
+				return nil, fmt.Errorf("failed to enable %q in %q (%v)", enable, dir, err)
+			}
+		}
+		dir = filepath.Join(dir, part)
+		if err := os.Mkdir(dir, 0755); err != nil && !os.IsExist(err) {
+			return nil, err
+		}
+	}
+
+	g := &Group{Path: dir}
+	for _, f := range l.Files() {
+		if err := writeFile(filepath.Join(g.Path, f.Name), f.Value); err != nil {
+			return nil, fmt.Errorf("failed to write %q (%v)", f, err)
+		}
+	}
+	return g, nil
+}
+
+// AddProcess moves the process, with all its threads, into the group.
+// Child processes forked afterwards stay in the group.
+func (g *Group) AddProcess(pid int64) error {
+	return writeFile(filepath.Join(g.Path, "cgroup.procs"), fmt.Sprint(pid))
+}
+
+// Read reads the interface files of the names.
+func (g *Group) Read(names ...string) ([]File, error) {
+	fs := make([]File, 0, len(names))
+	for _, name := range names {
+		b, err := ioutil.ReadFile(filepath.Join(g.Path, name))
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, File{name, strings.TrimSpace(string(b))})
+	}
+	return fs, nil
+}
+
+// Remove removes the group, which must have no process.
+func (g *Group) Remove() error {
+	if err := os.Remove(g.Path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// BlockDevice returns the 'major:minor' number of the block device
+// (e.g. "sda1", "/dev/nvme0n1") from the sysfs block class directory
+// (e.g. "/sys/class/block"). Partitions return their disks, since
+// 'io.max' only throttles disks.
+func BlockDevice(sysBlockDir, device string) (string, error) {
+	dir := filepath.Join(sysBlockDir, filepath.Base(device))
+	if _, err := os.Stat(filepath.Join(dir, "partition")); err == nil {
+		rd, err := filepath.EvalSymlinks(dir)
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Dir(rd)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "dev"))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+func writeFile(fpath, v string) error {
+	return ioutil.WriteFile(fpath, []byte(v), 0644)
+}
@@ -0,0 +1,137 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLimits(t *testing.T) {
+	l := Limits{
+		CPUs:        2.5,
+		MemoryBytes: 4 * 1024 * 1024 * 1024,
+		IOWeight:    200,
+		IODevice:    "8:0",
+		IOWriteBPS:  100 * 1024 * 1024,
+		IOReadIOPS:  1000,
+	}
+	exp := []File{
+		{"cpu.max", "250000 100000"},
+		{"memory.max", "4294967296"},
+		{"io.weight", "default 200"},
+		{"io.max", "8:0 wbps=104857600 riops=1000"},
+	}
+	if fs := l.Files(); !reflect.DeepEqual(fs, exp) {
+		t.Fatalf("expected %v, got %v", exp, fs)
+	}
+	if cs := l.Controllers(); !reflect.DeepEqual(cs, []string{"cpu", "memory", "io"}) {
+		t.Fatalf("unexpected controllers %v", cs)
+	}
+
+	// throttles without device are ignored
+	l = Limits{MemoryBytes: 1024, IOReadBPS: 1}
+	if fs := l.Files(); !reflect.DeepEqual(fs, []File{{"memory.max", "1024"}}) {
+		t.Fatalf("unexpected files %v", fs)
+	}
+}
+
+func TestNew(t *testing.T) {
+	mount, err := ioutil.TempDir(os.TempDir(), "cgroup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(mount)
+
+	g, err := New(mount, "dbtester/etcd", Limits{CPUs: 1, MemoryBytes: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Path != filepath.Join(mount, "dbtester", "etcd") {
+		t.Fatalf("unexpected path %q", g.Path)
+	}
+	for _, dir := range []string{mount, filepath.Join(mount, "dbtester")} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, "cgroup.subtree_control"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "+cpu +memory" {
+			t.Fatalf("%q: unexpected subtree_control %q", dir, b)
+		}
+	}
+	if err = g.AddProcess(123); err != nil {
+		t.Fatal(err)
+	}
+	fs, err := g.Read("cpu.max", "memory.max", "cgroup.procs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []File{{"cpu.max", "100000 100000"}, {"memory.max", "1024"}, {"cgroup.procs", "123"}}
+	if !reflect.DeepEqual(fs, exp) {
+		t.Fatalf("expected %v, got %v", exp, fs)
+	}
+
+	// reuse the existing group
+	if _, err = New(mount, "dbtester/etcd", Limits{CPUs: 1}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBlockDevice(t *testing.T) {
+	sys, err := ioutil.TempDir(os.TempDir(), "sysfs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sys)
+
+	// '/sys/class/block/sda1' links to '/sys/devices/.../sda/sda1'
+	disk := filepath.Join(sys, "devices", "sda")
+	part := filepath.Join(disk, "sda1")
+	if err = os.MkdirAll(part, 0777); err != nil {
+		t.Fatal(err)
+	}
+	for fpath, v := range map[string]string{
+		filepath.Join(disk, "dev"):       "8:0\n",
+		filepath.Join(part, "dev"):       "8:1\n",
+		filepath.Join(part, "partition"): "1\n",
+	} {
+		if err = ioutil.WriteFile(fpath, []byte(v), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	class := filepath.Join(sys, "class", "block")
+	if err = os.MkdirAll(class, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(disk, filepath.Join(class, "sda")); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Symlink(part, filepath.Join(class, "sda1")); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dev := range []string{"sda", "/dev/sda1"} {
+		n, err := BlockDevice(class, dev)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != "8:0" {
+			t.Fatalf("%q: expected 8:0, got %q", dev, n)
+		}
+	}
+}
@@ -46,8 +46,9 @@ func Walk(targetDir string) (map[string]os.FileInfo, error) {
 	return rm, nil
 }
 
-// Size returns the size of target directory, in bytes.
-// Same as 'du -sh $DIR'.
+// Size returns the apparent size of target directory, in bytes.
+// Same as 'du -sb $DIR', except that hard links are counted for each
+// link. Use 'DiskUsage' for the size of allocated blocks.
 func Size(targetDir string) (int64, error) {
 	fm, err := Walk(targetDir)
 	if err != nil {
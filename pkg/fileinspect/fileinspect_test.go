@@ -89,3 +89,58 @@ func TestWalkSize(t *testing.T) {
 		t.Fatalf("size expected %d, got %d", n, size)
 	}
 }
+
+func TestDiskUsage(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "fileinspect-usage-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err = os.MkdirAll(filepath.Join(dir, "wal"), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = writeData(filepath.Join(dir, "wal", "0.wal"), bytes.Repeat([]byte("a"), 10)); err != nil {
+		t.Fatal(err)
+	}
+	// hard link is counted once
+	if err = os.Link(filepath.Join(dir, "wal", "0.wal"), filepath.Join(dir, "wal", "1.wal")); err != nil {
+		t.Fatal(err)
+	}
+	// sparse file allocates fewer blocks than its size
+	f, err := os.Create(filepath.Join(dir, "db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Truncate(64 * 1024 * 1024); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	u, err := DiskUsage(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ApparentBytes != 64*1024*1024+10 || u.Files != 2 {
+		t.Fatalf("unexpected usage %+v", u)
+	}
+	if u.AllocatedBytes >= u.ApparentBytes {
+		t.Fatalf("expected allocated bytes < %d, got %d", u.ApparentBytes, u.AllocatedBytes)
+	}
+
+	um, err := DiskUsageByExtension(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(um) != 2 || um[".wal"].ApparentBytes != 10 || um[""].ApparentBytes != 64*1024*1024 {
+		t.Fatalf("unexpected usage by extension %+v", um)
+	}
+
+	um, err = DiskUsageBySubdirectory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(um) != 2 || um["wal"].Files != 1 || um["."].Files != 1 {
+		t.Fatalf("unexpected usage by subdirectory %+v", um)
+	}
+}
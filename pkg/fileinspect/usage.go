@@ -0,0 +1,127 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileinspect
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// Usage is the disk usage of regular files.
+type Usage struct {
+	// ApparentBytes is the sum of file sizes, same as 'du -sb $DIR'.
+	ApparentBytes int64
+	// AllocatedBytes is the size of the allocated blocks, same as
+	// 'du -s --block-size=1 $DIR'. It is smaller than the apparent size
+	// for sparse files, and larger for preallocated files (e.g. etcd WAL).
+	AllocatedBytes int64
+	// Files is the number of files.
+	Files int64
+}
+
+// Add adds the usage.
+func (u *Usage) Add(v Usage) {
+	u.ApparentBytes += v.ApparentBytes
+	u.AllocatedBytes += v.AllocatedBytes
+	u.Files += v.Files
+}
+
+type inode struct {
+	dev uint64
+	ino uint64
+}
+
+// DiskUsage returns the disk usage of the target directory.
+// Hard links to the same file are counted once.
+func DiskUsage(targetDir string) (Usage, error) {
+	var u Usage
+	err := walkUsage(targetDir, func(_ string, v Usage) { u.Add(v) })
+	return u, err
+}
+
+// DiskUsageBy returns the disk usage of the target directory grouped by
+// 'key', which is called with the slash-separated path of each file
+// relative to the target directory. Hard links to the same file are
+// counted once, in the group of the first path walked in lexical order.
+func DiskUsageBy(targetDir string, key func(rel string) string) (map[string]Usage, error) {
+	um := make(map[string]Usage)
+	err := walkUsage(targetDir, func(rel string, v Usage) {
+		k := key(rel)
+		u := um[k]
+		u.Add(v)
+		um[k] = u
+	})
+	if err != nil {
+		return nil, err
+	}
+	return um, nil
+}
+
+// DiskUsageByExtension returns the disk usage of the target directory
+// by file extension (e.g. ".wal"), with "" for files without extension.
+func DiskUsageByExtension(targetDir string) (map[string]Usage, error) {
+	return DiskUsageBy(targetDir, func(rel string) string { return filepath.Ext(rel) })
+}
+
+// DiskUsageBySubdirectory returns the disk usage of the target directory
+// by its top-level subdirectory (e.g. "member"), with "." for the files
+// directly under the target directory.
+func DiskUsageBySubdirectory(targetDir string) (map[string]Usage, error) {
+	return DiskUsageBy(targetDir, func(rel string) string {
+		if i := strings.Index(rel, "/"); i > 0 {
+			return rel[:i]
+		}
+		return "."
+	})
+}
+
+// walkUsage calls 'fn' with the usage of each regular file in the target
+// directory. Files removed during the walk (e.g. WAL purge) are skipped.
+func walkUsage(targetDir string, fn func(rel string, v Usage)) error {
+	seen := make(map[inode]struct{})
+	return filepath.Walk(targetDir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		v := Usage{ApparentBytes: fi.Size(), Files: 1}
+		if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+			if st.Nlink > 1 {
+				in := inode{dev: uint64(st.Dev), ino: uint64(st.Ino)}
+				if _, ok := seen[in]; ok {
+					return nil
+				}
+				seen[in] = struct{}{}
+			}
+			// 'st_blocks' is in 512-byte units, regardless of the file system block size
+			v.AllocatedBytes = int64(st.Blocks) * 512
+		}
+
+		rel, err := filepath.Rel(targetDir, path)
+		if err != nil {
+			return err
+		}
+		fn(filepath.ToSlash(rel), v)
+		return nil
+	})
+}
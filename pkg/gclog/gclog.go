@@ -0,0 +1,294 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gclog parses the GC logs of Java 8 (HotSpot) and the unified
+// GC logs of Java 9+, and aggregates the GC pauses by unix second.
+package gclog
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Flags returns the flags to write the GC log to the file, for the
+// Java major version (e.g. 8, 11). Java 9+ removed the Java 8 flags,
+// in favor of unified logging, which is written without rotation.
+// The file is overwritten when the JVM starts.
+func Flags(fpath string, javaMajor int) []string {
+	if javaMajor >= 9 {
+		return []string{"-Xlog:gc*:file=" + fpath + ":time,uptime:filecount=0"}
+	}
+	return []string{
+		"-Xloggc:" + fpath,
+		"-XX:+PrintGCDetails",
+		"-XX:+PrintGCDateStamps",
+	}
+}
+
+// e.g. 'java version "1.8.0_121"', 'openjdk version "11.0.2" 2019-01-15', 'openjdk version "17" 2021-09-14'
+var javaVersionRegex = regexp.MustCompile(`version "(\d+)(?:\.(\d+))?`)
+
+// JavaMajorVersion parses the major version from the output
+// of 'java -version' (e.g. 8 from "1.8.0_121", 11 from "11.0.2").
+func JavaMajorVersion(out string) (int, error) {
+	m := javaVersionRegex.FindStringSubmatch(out)
+	if m == nil {
+		return 0, fmt.Errorf("no Java version in %q", out)
+	}
+	major, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, err
+	}
+	// versions before 9 are "1.x"
+	if major == 1 && m[2] != "" {
+		return strconv.Atoi(m[2])
+	}
+	return major, nil
+}
+
+// Pause is a stop-the-world GC pause.
+type Pause struct {
+	Time time.Time
+	Took time.Duration
+	Full bool
+
+	// HeapBeforeBytes and HeapAfterBytes are the heap usages
+	// before and after the GC, zero if not logged.
+	HeapBeforeBytes int64
+	HeapAfterBytes  int64
+}
+
+const dateStampLayout = "2006-01-02T15:04:05.000-0700"
+
+var (
+	// e.g. '2017-02-10T19:22:16.549+0000: 12.345: [GC (Allocation Failure) ...'
+	dateStampRegex = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}[+-]\d{4}): `)
+
+	// e.g. '..., 0.0123456 secs]', but not '[Times: user=0.03 sys=0.01, real=0.01 secs]'
+	tookRegex = regexp.MustCompile(`, ([0-9.]+) secs\]`)
+
+	// e.g. '[PSYoungGen: 65536K->10720K(76288K)] 65536K->10744K(251392K), ...'
+	heapKBRegex = regexp.MustCompile(`\] (\d+)K->(\d+)K\(\d+K\)`)
+
+	// e.g. '[Eden: 24.0M(24.0M)->0.0B(13.0M) Survivors: 0.0B->3072.0K Heap: 24.0M(256.0M)->5.2M(256.0M)]' (G1)
+	heapG1Regex = regexp.MustCompile(`Heap: ([0-9.]+[BKMG])\([0-9.]+[BKMG]\)->([0-9.]+[BKMG])`)
+
+	// e.g. '[Full GC (Allocation Failure)  240M->180M(256M), 0.5 secs]' (G1)
+	heapG1FullRegex = regexp.MustCompile(`\) +([0-9.]+[BKMG])->([0-9.]+[BKMG])\([0-9.]+[BKMG]\)`)
+
+	// e.g. '[2019-01-15T10:22:16.549+0000][1.234s] GC(0) Pause Young (Normal) (G1 Evacuation Pause) 24M->5M(256M) 5.123ms' (Java 9+)
+	unifiedPauseRegex = regexp.MustCompile(`^\[(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{3}[+-]\d{4})\]\[[0-9.]+s\] GC\(\d+\) Pause (\w+).*? (?:([0-9.]+[BKMG])->([0-9.]+[BKMG])\([0-9.]+[BKMG]\) )?([0-9.]+)ms$`)
+)
+
+// Parse parses the GC log written with 'Flags'. Concurrent phases
+// (e.g. CMS and G1 concurrent marking) are not pauses, thus skipped.
+// In the unified GC log, a pause is one line that ends with its time,
+// and the other lines (e.g. phases, 'Pause Young' when it starts) are skipped.
+func Parse(r io.Reader) ([]Pause, error) {
+	var (
+		pauses []Pause
+		cur    *Pause
+	)
+	flush := func() {
+		if cur != nil && cur.Took > 0 {
+			pauses = append(pauses, *cur)
+		}
+		cur = nil
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		if m := unifiedPauseRegex.FindStringSubmatch(line); m != nil {
+			flush()
+			ts, err := time.Parse(dateStampLayout, m[1])
+			if err != nil {
+				return nil, err
+			}
+			ms, err := strconv.ParseFloat(m[5], 64)
+			if err != nil {
+				return nil, err
+			}
+			p := Pause{Time: ts, Took: time.Duration(ms * float64(time.Millisecond)), Full: m[2] == "Full"}
+			if m[3] != "" {
+				p.HeapBeforeBytes, p.HeapAfterBytes = parseSize(m[3]), parseSize(m[4])
+			}
+			pauses = append(pauses, p)
+			continue
+		}
+		if m := dateStampRegex.FindStringSubmatch(line); m != nil {
+			rest := line[len(m[0]):]
+			flush()
+			// e.g. '[CMS-concurrent-mark-start]', '[GC concurrent-mark-end, 0.01 secs]'
+			if (!strings.Contains(rest, "[GC") && !strings.Contains(rest, "[Full GC")) || strings.Contains(rest, "concurrent") {
+				continue
+			}
+			ts, err := time.Parse(dateStampLayout, m[1])
+			if err != nil {
+				return nil, err
+			}
+			cur = &Pause{Time: ts, Full: strings.Contains(rest, "[Full GC")}
+			parseDetails(cur, rest)
+			continue
+		}
+		if cur != nil {
+			parseDetails(cur, line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	flush()
+	return pauses, nil
+}
+
+// parseDetails parses the pause time and heap usages, if not yet found.
+// The last pause time in the line is of the whole event, since the
+// details of each generation come first (e.g. '[ParNew: ..., 0.01 secs]').
+func parseDetails(p *Pause, line string) {
+	if p.Took == 0 {
+		if ms := tookRegex.FindAllStringSubmatch(line, -1); len(ms) > 0 {
+			if secs, err := strconv.ParseFloat(ms[len(ms)-1][1], 64); err == nil {
+				p.Took = time.Duration(secs * float64(time.Second))
+			}
+		}
+	}
+	if p.HeapBeforeBytes == 0 && p.HeapAfterBytes == 0 {
+		if m := heapKBRegex.FindStringSubmatch(line); m != nil {
+			before, _ := strconv.ParseInt(m[1], 10, 64)
+			after, _ := strconv.ParseInt(m[2], 10, 64)
+			p.HeapBeforeBytes, p.HeapAfterBytes = before*1024, after*1024
+		} else if m := heapG1Regex.FindStringSubmatch(line); m != nil {
+			p.HeapBeforeBytes, p.HeapAfterBytes = parseSize(m[1]), parseSize(m[2])
+		} else if m := heapG1FullRegex.FindStringSubmatch(line); m != nil {
+			p.HeapBeforeBytes, p.HeapAfterBytes = parseSize(m[1]), parseSize(m[2])
+		}
+	}
+}
+
+// parseSize parses G1 size (e.g. '5.2M').
+func parseSize(s string) int64 {
+	n, err := strconv.ParseFloat(s[:len(s)-1], 64)
+	if err != nil {
+		return 0
+	}
+	switch s[len(s)-1] {
+	case 'K':
+		n *= 1024
+	case 'M':
+		n *= 1024 * 1024
+	case 'G':
+		n *= 1024 * 1024 * 1024
+	}
+	return int64(n)
+}
+
+// Second is the GC pauses in a unix second.
+type Second struct {
+	UnixSecond int64
+
+	Pauses     int64
+	PauseTotal time.Duration
+	PauseMax   time.Duration
+
+	// HeapBeforeBytes and HeapAfterBytes are of the last pause
+	// in or before the second.
+	HeapBeforeBytes int64
+	HeapAfterBytes  int64
+}
+
+// PerSecond aggregates the pauses sorted by time into every second
+// from the first to the last pause. Seconds without pauses have the
+// heap usages of the previous pause.
+func PerSecond(pauses []Pause) []Second {
+	if len(pauses) == 0 {
+		return nil
+	}
+	first, last := pauses[0].Time.Unix(), pauses[len(pauses)-1].Time.Unix()
+	secs := make([]Second, 0, last-first+1)
+	i := 0
+	for ts := first; ts <= last; ts++ {
+		s := Second{UnixSecond: ts}
+		if n := len(secs); n > 0 {
+			s.HeapBeforeBytes, s.HeapAfterBytes = secs[n-1].HeapBeforeBytes, secs[n-1].HeapAfterBytes
+		}
+		for ; i < len(pauses) && pauses[i].Time.Unix() <= ts; i++ {
+			p := pauses[i]
+			s.Pauses++
+			s.PauseTotal += p.Took
+			if s.PauseMax < p.Took {
+				s.PauseMax = p.Took
+			}
+			if p.HeapBeforeBytes != 0 || p.HeapAfterBytes != 0 {
+				s.HeapBeforeBytes, s.HeapAfterBytes = p.HeapBeforeBytes, p.HeapAfterBytes
+			}
+		}
+		secs = append(secs, s)
+	}
+	return secs
+}
+
+// Header lists the CSV columns of 'Second'.
+var Header = []string{
+	"UNIX-SECOND",
+	"GC-PAUSE-COUNT",
+	"GC-PAUSE-MS",
+	"GC-MAX-PAUSE-MS",
+	"HEAP-BEFORE-MB",
+	"HEAP-AFTER-MB",
+}
+
+// ToRow converts 'Second' to string slice, in the order of 'Header'.
+func (s Second) ToRow() []string {
+	return []string{
+		fmt.Sprintf("%d", s.UnixSecond),
+		fmt.Sprintf("%d", s.Pauses),
+		fmt.Sprintf("%.3f", float64(s.PauseTotal)/float64(time.Millisecond)),
+		fmt.Sprintf("%.3f", float64(s.PauseMax)/float64(time.Millisecond)),
+		fmt.Sprintf("%.2f", float64(s.HeapBeforeBytes)*0.000001),
+		fmt.Sprintf("%.2f", float64(s.HeapAfterBytes)*0.000001),
+	}
+}
+
+// SaveCSV saves the seconds to CSV, overwriting the existing file.
+func SaveCSV(fpath string, secs []Second) error {
+	f, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write(Header); err != nil {
+		return err
+	}
+	for _, s := range secs {
+		if err = wr.Write(s.ToRow()); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	if err = wr.Error(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,147 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gclog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testLog = `Java HotSpot(TM) 64-Bit Server VM (25.121-b13) for linux-amd64 JRE (1.8.0_121-b13), built on Dec 12 2016 16:36:53 by "java_re" with gcc 4.3.0 20080428 (Red Hat 4.3.0-8)
+Memory: 4k page, physical 15400952k(11781232k free), swap 0k(0k free)
+CommandLine flags: -XX:+PrintGCDateStamps -XX:+PrintGCDetails -XX:+PrintGCTimeStamps -XX:+UseParallelGC
+2017-02-10T19:22:16.549+0000: 1.234: [GC (Allocation Failure) [PSYoungGen: 65536K->10720K(76288K)] 65536K->10744K(251392K), 0.0123450 secs] [Times: user=0.03 sys=0.01, real=0.01 secs]
+2017-02-10T19:22:16.900+0000: 1.585: [Full GC (Ergonomics) [PSYoungGen: 10720K->0K(76288K)] [ParOldGen: 24K->10500K(175104K)] 10744K->10500K(251392K), [Metaspace: 3000K->3000K(1056768K)], 0.1000000 secs] [Times: user=0.20 sys=0.00, real=0.10 secs]
+2017-02-10T19:22:19.100+0000: 3.785: [GC (Allocation Failure) 2017-02-10T19:22:19.100+0000: 3.785: [ParNew: 78656K->8704K(78656K), 0.0200000 secs] 90000K->30000K(253440K), 0.0210000 secs] [Times: user=0.05 sys=0.00, real=0.02 secs]
+2017-02-10T19:22:19.200+0000: 3.885: [CMS-concurrent-mark-start]
+2017-02-10T19:22:19.300+0000: 3.985: [CMS-concurrent-mark: 0.100/0.100 secs] [Times: user=0.10 sys=0.00, real=0.10 secs]
+2017-02-10T19:22:19.400+0000: 4.085: [GC pause (G1 Evacuation Pause) (young), 0.0050000 secs]
+   [Parallel Time: 4.5 ms, GC Workers: 4]
+   [Eden: 24.0M(24.0M)->0.0B(13.0M) Survivors: 0.0B->3072.0K Heap: 24.0M(256.0M)->5.5M(256.0M)]
+ [Times: user=0.01 sys=0.00, real=0.01 secs]
+2017-02-10T19:22:19.500+0000: 4.185: [GC concurrent-root-region-scan-end, 0.0010000 secs]
+2017-02-10T19:22:19.600+0000: 4.285: [Full GC (Allocation Failure)  240M->180M(256M), 0.5000000 secs]
+`
+
+func TestParse(t *testing.T) {
+	pauses, err := Parse(strings.NewReader(testLog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := func(s string) time.Time {
+		tm, err := time.Parse(dateStampLayout, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	exp := []Pause{
+		{Time: ts("2017-02-10T19:22:16.549+0000"), Took: 12345 * time.Microsecond, HeapBeforeBytes: 65536 * 1024, HeapAfterBytes: 10744 * 1024},
+		{Time: ts("2017-02-10T19:22:16.900+0000"), Took: 100 * time.Millisecond, Full: true, HeapBeforeBytes: 10744 * 1024, HeapAfterBytes: 10500 * 1024},
+		{Time: ts("2017-02-10T19:22:19.100+0000"), Took: 21 * time.Millisecond, HeapBeforeBytes: 90000 * 1024, HeapAfterBytes: 30000 * 1024},
+		{Time: ts("2017-02-10T19:22:19.400+0000"), Took: 5 * time.Millisecond, HeapBeforeBytes: 24 * 1024 * 1024, HeapAfterBytes: 5.5 * 1024 * 1024},
+		{Time: ts("2017-02-10T19:22:19.600+0000"), Took: 500 * time.Millisecond, Full: true, HeapBeforeBytes: 240 * 1024 * 1024, HeapAfterBytes: 180 * 1024 * 1024},
+	}
+	if !reflect.DeepEqual(pauses, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, pauses)
+	}
+
+	secs := PerSecond(pauses)
+	if len(secs) != 4 {
+		t.Fatalf("expected 4 seconds, got %+v", secs)
+	}
+	if s := secs[0]; s.Pauses != 2 || s.PauseTotal != 112345*time.Microsecond || s.PauseMax != 100*time.Millisecond || s.HeapAfterBytes != 10500*1024 {
+		t.Fatalf("unexpected first second %+v", s)
+	}
+	// no pause, with the heap usages of the previous pause
+	if s := secs[1]; s.Pauses != 0 || s.PauseTotal != 0 || s.HeapAfterBytes != 10500*1024 {
+		t.Fatalf("unexpected second %+v", s)
+	}
+	if s := secs[3]; s.Pauses != 3 || s.PauseMax != 500*time.Millisecond || s.HeapAfterBytes != 180*1024*1024 {
+		t.Fatalf("unexpected last second %+v", s)
+	}
+	if row := secs[3].ToRow(); !reflect.DeepEqual(row, []string{"1486754539", "3", "526.000", "500.000", "251.66", "188.74"}) {
+		t.Fatalf("unexpected row %q", row)
+	}
+}
+
+// e.g. 'java -Xlog:gc*:file=gc.log:time,uptime:filecount=0' with Java 11 (G1)
+const testUnifiedLog = `[2019-01-15T10:22:16.500+0000][0.012s] Using G1
+[2019-01-15T10:22:16.549+0000][1.234s] GC(0) Pause Young (Normal) (G1 Evacuation Pause)
+[2019-01-15T10:22:16.549+0000][1.234s] GC(0) Using 4 workers of 4 for evacuation
+[2019-01-15T10:22:16.554+0000][1.239s] GC(0)   Evacuate Collection Set: 4.5ms
+[2019-01-15T10:22:16.554+0000][1.239s] GC(0) Eden regions: 24->0(13)
+[2019-01-15T10:22:16.554+0000][1.239s] GC(0) Pause Young (Normal) (G1 Evacuation Pause) 24M->5M(256M) 5.123ms
+[2019-01-15T10:22:16.554+0000][1.239s] GC(0) User=0.01s Sys=0.00s Real=0.01s
+[2019-01-15T10:22:18.100+0000][2.785s] GC(1) Concurrent Cycle
+[2019-01-15T10:22:18.200+0000][2.885s] GC(1) Pause Remark 30M->30M(256M) 1.500ms
+[2019-01-15T10:22:18.300+0000][2.985s] GC(1) Concurrent Cycle 200.000ms
+[2019-01-15T10:22:19.600+0000][4.285s] GC(2) Pause Full (Allocation Failure)
+[2019-01-15T10:22:20.100+0000][4.785s] GC(2) Pause Full (Allocation Failure) 240M->180M(256M) 500.000ms
+`
+
+func TestParseUnified(t *testing.T) {
+	pauses, err := Parse(strings.NewReader(testUnifiedLog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := func(s string) time.Time {
+		tm, err := time.Parse(dateStampLayout, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	exp := []Pause{
+		{Time: ts("2019-01-15T10:22:16.554+0000"), Took: 5123 * time.Microsecond, HeapBeforeBytes: 24 * 1024 * 1024, HeapAfterBytes: 5 * 1024 * 1024},
+		{Time: ts("2019-01-15T10:22:18.200+0000"), Took: 1500 * time.Microsecond, HeapBeforeBytes: 30 * 1024 * 1024, HeapAfterBytes: 30 * 1024 * 1024},
+		{Time: ts("2019-01-15T10:22:20.100+0000"), Took: 500 * time.Millisecond, Full: true, HeapBeforeBytes: 240 * 1024 * 1024, HeapAfterBytes: 180 * 1024 * 1024},
+	}
+	if !reflect.DeepEqual(pauses, exp) {
+		t.Fatalf("expected %+v, got %+v", exp, pauses)
+	}
+}
+
+func TestJavaMajorVersion(t *testing.T) {
+	tests := []struct {
+		out   string
+		major int
+		flag  string
+	}{
+		{`java version "1.8.0_121"
+Java(TM) SE Runtime Environment (build 1.8.0_121-b13)`, 8, "-XX:+PrintGCDateStamps"},
+		{`openjdk version "9-ea"`, 9, "-Xlog:gc*:file=gc.log:time,uptime:filecount=0"},
+		{`openjdk version "11.0.2" 2019-01-15
+OpenJDK Runtime Environment 18.9 (build 11.0.2+9)`, 11, "-Xlog:gc*:file=gc.log:time,uptime:filecount=0"},
+		{`openjdk version "17" 2021-09-14`, 17, "-Xlog:gc*:file=gc.log:time,uptime:filecount=0"},
+	}
+	for i, tt := range tests {
+		major, err := JavaMajorVersion(tt.out)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if major != tt.major {
+			t.Fatalf("#%d: expected %d, got %d", i, tt.major, major)
+		}
+		if fs := Flags("gc.log", major); fs[len(fs)-1] != tt.flag {
+			t.Fatalf("#%d: expected %q, got %q", i, tt.flag, fs)
+		}
+	}
+	if _, err := JavaMajorVersion("command not found"); err == nil {
+		t.Fatal("expected error")
+	}
+}
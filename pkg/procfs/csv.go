@@ -0,0 +1,248 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gyuho/linux-inspect/inspect"
+)
+
+// MinInterval is the minimum sample interval.
+const MinInterval = 100 * time.Millisecond
+
+// Header lists all CSV columns, exactly as 'inspect.ProcHeader',
+// so that the CSV files are read the same as of 'inspect.CSV'.
+var Header = inspect.ProcHeader
+
+// IOHeader lists the columns of the storage I/O of the process,
+// which are saved in a separate CSV file to keep 'Header' as is.
+var IOHeader = []string{
+	"UNIX-NANOSECOND",
+	"UNIX-SECOND",
+	"PROCESS-READ-BYTES",
+	"PROCESS-WRITE-BYTES",
+	"PROCESS-READ-BYTES-DELTA",
+	"PROCESS-WRITE-BYTES-DELTA",
+}
+
+// CSV collects the samples of a process, as a drop-in for 'inspect.CSV'.
+type CSV struct {
+	FilePath string
+	// IOFilePath is the path to save the storage I/O of the process,
+	// with 'IOHeader' columns. Not saved if empty.
+	IOFilePath string
+	Interval   time.Duration
+
+	// Rows are sorted by unix time in nanoseconds.
+	Rows []Row
+
+	sampler *Sampler
+}
+
+// NewCSV returns a new CSV, to be sampled every 'interval'.
+func NewCSV(fpath string, pid int64, diskDevice string, networkInterface string, extraPath string, interval time.Duration) (*CSV, error) {
+	if interval < MinInterval {
+		return nil, fmt.Errorf("sample interval %v is shorter than %v", interval, MinInterval)
+	}
+	return &CSV{
+		FilePath: fpath,
+		Interval: interval,
+		sampler: &Sampler{
+			PID:              pid,
+			DiskDevice:       diskDevice,
+			NetworkInterface: networkInterface,
+			ExtraPath:        extraPath,
+		},
+	}, nil
+}
+
+// PID returns the process ID being sampled.
+func (c *CSV) PID() int64 { return c.sampler.PID }
+
+// SetPID samples the new process (e.g. after restart) from the next 'Add'.
+func (c *CSV) SetPID(pid int64) { c.sampler.PID = pid }
+
+// Add is called every interval to append a new sample.
+func (c *CSV) Add() error {
+	r, err := c.sampler.Sample()
+	if err != nil {
+		return err
+	}
+	if n := len(c.Rows); n > 0 && c.Rows[n-1].UnixNanosecond >= r.UnixNanosecond {
+		return fmt.Errorf("clock went backwards: got %v, but expected more than %v", r.UnixNanosecond, c.Rows[n-1].UnixNanosecond)
+	}
+	c.Rows = append(c.Rows, r)
+	return nil
+}
+
+// Save saves CSV to disk, overwriting the existing files.
+func (c *CSV) Save() error {
+	if err := c.save(c.FilePath, Header, Row.ToRow); err != nil {
+		return err
+	}
+	if c.IOFilePath == "" {
+		return nil
+	}
+	return c.save(c.IOFilePath, IOHeader, Row.ToIORow)
+}
+
+func (c *CSV) save(fpath string, header []string, toRow func(Row) []string) error {
+	f, err := os.Create(fpath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	wr := csv.NewWriter(f)
+	if err = wr.Write(header); err != nil {
+		return err
+	}
+	for _, r := range c.Rows {
+		if err = wr.Write(toRow(r)); err != nil {
+			return err
+		}
+	}
+	wr.Flush()
+	if err = wr.Error(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// ToRow converts 'Row' to string slice, in the order of 'Header'.
+func (r Row) ToRow() []string {
+	row := r.Proc.ToRow()
+
+	// 'inspect.Proc.ToRow' writes the number of threads
+	// in the context switch columns
+	row[inspect.ProcHeaderIndex["VOLUNTARY-CTXT-SWITCHES"]] = fmt.Sprintf("%d", r.PSEntry.VoluntaryCtxtSwitches)
+	row[inspect.ProcHeaderIndex["NON-VOLUNTARY-CTXT-SWITCHES"]] = fmt.Sprintf("%d", r.PSEntry.NonvoluntaryCtxtSwitches)
+	return row
+}
+
+// ToIORow converts 'Row' to string slice, in the order of 'IOHeader'.
+func (r Row) ToIORow() []string {
+	return []string{
+		fmt.Sprintf("%d", r.UnixNanosecond),
+		fmt.Sprintf("%d", r.UnixSecond),
+		fmt.Sprintf("%d", r.ProcessIO.ReadBytes),
+		fmt.Sprintf("%d", r.ProcessIO.WriteBytes),
+		fmt.Sprintf("%d", r.ProcessReadBytesDelta),
+		fmt.Sprintf("%d", r.ProcessWriteBytesDelta),
+	}
+}
+
+// Interpolate returns a new CSV with one row per unix second, with all
+// unix nanoseconds set to 0. Rows in the same second are combined: deltas
+// are summed, CPU and memory usages are averaged, and the others are from
+// the last row. Missing seconds are estimated from the rows around them.
+func (c *CSV) Interpolate() (*CSV, error) {
+	cc := &CSV{FilePath: c.FilePath, IOFilePath: c.IOFilePath, Interval: time.Second, sampler: c.sampler}
+	for i := 0; i < len(c.Rows); {
+		j := i
+		for j < len(c.Rows) && c.Rows[j].UnixSecond == c.Rows[i].UnixSecond {
+			j++
+		}
+		cur := combine(c.Rows[i:j])
+		i = j
+
+		if n := len(cc.Rows); n > 0 {
+			missing, err := interpolate(cc.Rows[n-1], cur)
+			if err != nil {
+				return nil, err
+			}
+			cc.Rows = append(cc.Rows, missing...)
+		}
+		cc.Rows = append(cc.Rows, cur)
+	}
+	return cc, nil
+}
+
+// combine combines rows in the same unix second.
+func combine(rows []Row) Row {
+	c := rows[len(rows)-1]
+	c.UnixNanosecond = 0
+	if len(rows) == 1 {
+		return c
+	}
+
+	var (
+		cpuNum    float64
+		vmRSSNum  uint64
+		vmSizeNum uint64
+	)
+	c.ReadsCompletedDelta, c.SectorsReadDelta, c.WritesCompletedDelta, c.SectorsWrittenDelta = 0, 0, 0, 0
+	c.ReadBytesDelta, c.WriteBytesDelta = 0, 0
+	c.ReceivePacketsDelta, c.TransmitPacketsDelta, c.ReceiveBytesNumDelta, c.TransmitBytesNumDelta = 0, 0, 0, 0
+	c.ProcessReadBytesDelta, c.ProcessWriteBytesDelta = 0, 0
+	for _, r := range rows {
+		cpuNum += r.PSEntry.CPUNum
+		vmRSSNum += r.PSEntry.VMRSSNum
+		vmSizeNum += r.PSEntry.VMSizeNum
+
+		c.ReadsCompletedDelta += r.ReadsCompletedDelta
+		c.SectorsReadDelta += r.SectorsReadDelta
+		c.WritesCompletedDelta += r.WritesCompletedDelta
+		c.SectorsWrittenDelta += r.SectorsWrittenDelta
+		c.ReadBytesDelta += r.ReadBytesDelta
+		c.WriteBytesDelta += r.WriteBytesDelta
+
+		c.ReceivePacketsDelta += r.ReceivePacketsDelta
+		c.TransmitPacketsDelta += r.TransmitPacketsDelta
+		c.ReceiveBytesNumDelta += r.ReceiveBytesNumDelta
+		c.TransmitBytesNumDelta += r.TransmitBytesNumDelta
+
+		c.ProcessReadBytesDelta += r.ProcessReadBytesDelta
+		c.ProcessWriteBytesDelta += r.ProcessWriteBytesDelta
+	}
+	n := len(rows)
+	c.PSEntry.CPUNum = cpuNum / float64(n)
+	c.PSEntry.VMRSSNum = vmRSSNum / uint64(n)
+	c.PSEntry.VMSizeNum = vmSizeNum / uint64(n)
+	setHumanized(&c)
+	return c
+}
+
+// interpolate returns the estimated rows between 'lower' and 'upper'.
+func interpolate(lower, upper Row) ([]Row, error) {
+	if upper.UnixSecond <= lower.UnixSecond {
+		return nil, fmt.Errorf("lower unix second %d >= upper unix second %d", lower.UnixSecond, upper.UnixSecond)
+	}
+	procs, err := inspect.Interpolate(lower.Proc, upper.Proc)
+	if err != nil {
+		return nil, err
+	}
+
+	n := int64(len(procs) + 1)
+	rows := make([]Row, len(procs))
+	for i, p := range procs {
+		k := int64(i + 1)
+		rows[i] = Row{Proc: p}
+		rows[i].ProcessIO.ReadBytes = estimate(lower.ProcessIO.ReadBytes, upper.ProcessIO.ReadBytes, k, n)
+		rows[i].ProcessIO.WriteBytes = estimate(lower.ProcessIO.WriteBytes, upper.ProcessIO.WriteBytes, k, n)
+		rows[i].ProcessReadBytesDelta = estimate(lower.ProcessReadBytesDelta, upper.ProcessReadBytesDelta, k, n)
+		rows[i].ProcessWriteBytesDelta = estimate(lower.ProcessWriteBytesDelta, upper.ProcessWriteBytesDelta, k, n)
+	}
+	return rows, nil
+}
+
+// estimate returns the k-th of n-1 values evenly between lower and upper.
+func estimate(lower, upper uint64, k, n int64) uint64 {
+	return uint64(int64(lower) + (int64(upper)-int64(lower))*k/n)
+}
@@ -0,0 +1,343 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procfs samples process and system metrics from '/proc',
+// without exec'ing 'top'.
+package procfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gyuho/linux-inspect/inspect"
+	"github.com/gyuho/linux-inspect/pkg/timeutil"
+	"github.com/gyuho/linux-inspect/proc"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+// DefaultRoot is where procfs is mounted.
+const DefaultRoot = "/proc"
+
+// ClockTicks is 'sysconf(_SC_CLK_TCK)', the unit of CPU times
+// in '/proc/$PID/stat', which is 100 on all supported Linux platforms.
+const ClockTicks = 100
+
+// Stat is '/proc/$PID/stat'.
+type Stat struct {
+	PID     int64
+	Program string
+	State   string
+	PPID    int64
+
+	// UTime and STime are the user and system CPU times, in clock ticks.
+	UTime uint64
+	STime uint64
+
+	Threads  int64
+	RSSBytes int64
+}
+
+// ListPIDs lists all process IDs under procfs.
+func ListPIDs(root string) ([]int64, error) {
+	ds, err := ioutil.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int64, 0, len(ds))
+	for _, d := range ds {
+		pid, err := strconv.ParseInt(d.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
+// ReadStat reads '/proc/$PID/stat'.
+func ReadStat(root string, pid int64) (Stat, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, fmt.Sprint(pid), "stat"))
+	if err != nil {
+		return Stat{}, err
+	}
+
+	// program name may have spaces and parentheses
+	s := strings.TrimSpace(string(b))
+	i, j := strings.Index(s, "("), strings.LastIndex(s, ")")
+	if i < 0 || j < i {
+		return Stat{}, fmt.Errorf("invalid stat %q", s)
+	}
+	st := Stat{PID: pid, Program: s[i+1 : j]}
+
+	// fields from the 3rd, 'state'
+	fds := strings.Fields(s[j+1:])
+	if len(fds) < 22 {
+		return Stat{}, fmt.Errorf("invalid stat %q", s)
+	}
+	st.State = fds[0]
+	if st.PPID, err = strconv.ParseInt(fds[1], 10, 64); err != nil {
+		return Stat{}, err
+	}
+	if st.UTime, err = strconv.ParseUint(fds[11], 10, 64); err != nil {
+		return Stat{}, err
+	}
+	if st.STime, err = strconv.ParseUint(fds[12], 10, 64); err != nil {
+		return Stat{}, err
+	}
+	if st.Threads, err = strconv.ParseInt(fds[17], 10, 64); err != nil {
+		return Stat{}, err
+	}
+	rss, err := strconv.ParseInt(fds[21], 10, 64)
+	if err != nil {
+		return Stat{}, err
+	}
+	st.RSSBytes = rss * int64(os.Getpagesize())
+	return st, nil
+}
+
+// Status is '/proc/$PID/status'.
+type Status struct {
+	Program string
+	State   string
+	PPID    int64
+
+	VMRSSBytes  uint64
+	VMSizeBytes uint64
+	Threads     uint64
+
+	VoluntaryCtxtSwitches    uint64
+	NonvoluntaryCtxtSwitches uint64
+}
+
+// ReadStatus reads '/proc/$PID/status'.
+func ReadStatus(root string, pid int64) (Status, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, fmt.Sprint(pid), "status"))
+	if err != nil {
+		return Status{}, err
+	}
+
+	var st Status
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		// e.g. 'VmRSS:	   13600 kB'
+		kv := strings.SplitN(sc.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		k, v := kv[0], strings.TrimSpace(kv[1])
+		switch k {
+		case "Name":
+			st.Program = v
+		case "State":
+			st.State = v
+		case "PPid":
+			st.PPID, err = strconv.ParseInt(v, 10, 64)
+		case "VmRSS":
+			st.VMRSSBytes, err = parseKilobytes(v)
+		case "VmSize":
+			st.VMSizeBytes, err = parseKilobytes(v)
+		case "Threads":
+			st.Threads, err = strconv.ParseUint(v, 10, 64)
+		case "voluntary_ctxt_switches":
+			st.VoluntaryCtxtSwitches, err = strconv.ParseUint(v, 10, 64)
+		case "nonvoluntary_ctxt_switches":
+			st.NonvoluntaryCtxtSwitches, err = strconv.ParseUint(v, 10, 64)
+		}
+		if err != nil {
+			return Status{}, fmt.Errorf("invalid status %q (%v)", sc.Text(), err)
+		}
+	}
+	return st, sc.Err()
+}
+
+func parseKilobytes(v string) (uint64, error) {
+	n, err := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(v, "kB")), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return n * 1024, nil
+}
+
+// IO is '/proc/$PID/io', the bytes that the process
+// has caused to be fetched from or sent to the storage.
+type IO struct {
+	ReadBytes  uint64
+	WriteBytes uint64
+}
+
+// ReadIO reads '/proc/$PID/io'.
+func ReadIO(root string, pid int64) (IO, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, fmt.Sprint(pid), "io"))
+	if err != nil {
+		return IO{}, err
+	}
+
+	var io IO
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		kv := strings.SplitN(sc.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "read_bytes":
+			io.ReadBytes, err = strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 64)
+		case "write_bytes":
+			io.WriteBytes, err = strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 64)
+		}
+		if err != nil {
+			return IO{}, fmt.Errorf("invalid io %q (%v)", sc.Text(), err)
+		}
+	}
+	return io, sc.Err()
+}
+
+// CountFDs returns the number of open file descriptors in '/proc/$PID/fd'.
+func CountFDs(root string, pid int64) (uint64, error) {
+	f, err := os.Open(filepath.Join(root, fmt.Sprint(pid), "fd"))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	names, err := f.Readdirnames(-1)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(names)), nil
+}
+
+// ReadLoadAvg reads '/proc/loadavg'.
+func ReadLoadAvg(root string) (proc.LoadAvg, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, "loadavg"))
+	if err != nil {
+		return proc.LoadAvg{}, err
+	}
+
+	// e.g. '0.34 0.41 0.48 2/579 12345'
+	fds := strings.Fields(string(b))
+	if len(fds) != 5 {
+		return proc.LoadAvg{}, fmt.Errorf("invalid loadavg %q", string(b))
+	}
+	var lavg proc.LoadAvg
+	if lavg.LoadAvg1Minute, err = strconv.ParseFloat(fds[0], 64); err != nil {
+		return proc.LoadAvg{}, err
+	}
+	if lavg.LoadAvg5Minute, err = strconv.ParseFloat(fds[1], 64); err != nil {
+		return proc.LoadAvg{}, err
+	}
+	if lavg.LoadAvg15Minute, err = strconv.ParseFloat(fds[2], 64); err != nil {
+		return proc.LoadAvg{}, err
+	}
+	ss := strings.SplitN(fds[3], "/", 2)
+	if len(ss) != 2 {
+		return proc.LoadAvg{}, fmt.Errorf("invalid loadavg %q", string(b))
+	}
+	if lavg.RunnableKernelSchedulingEntities, err = strconv.ParseInt(ss[0], 10, 64); err != nil {
+		return proc.LoadAvg{}, err
+	}
+	if lavg.CurrentKernelSchedulingEntities, err = strconv.ParseInt(ss[1], 10, 64); err != nil {
+		return proc.LoadAvg{}, err
+	}
+	if lavg.Pid, err = strconv.ParseInt(fds[4], 10, 64); err != nil {
+		return proc.LoadAvg{}, err
+	}
+	return lavg, nil
+}
+
+// ReadDiskStat reads the statistics of the disk device in '/proc/diskstats'.
+func ReadDiskStat(root, device string) (inspect.DSEntry, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, "diskstats"))
+	if err != nil {
+		return inspect.DSEntry{}, err
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		// major, minor, device, reads completed, reads merged,
+		// sectors read, ms reading, writes completed, writes merged,
+		// sectors written, ms writing, ...
+		fds := strings.Fields(sc.Text())
+		if len(fds) < 11 || fds[2] != device {
+			continue
+		}
+		var vs [8]uint64
+		for i := range vs {
+			if vs[i], err = strconv.ParseUint(fds[i+3], 10, 64); err != nil {
+				return inspect.DSEntry{}, fmt.Errorf("invalid diskstats %q (%v)", sc.Text(), err)
+			}
+		}
+		return inspect.DSEntry{
+			Device:               device,
+			ReadsCompleted:       vs[0],
+			SectorsRead:          vs[2],
+			TimeSpentOnReading:   timeutil.HumanizeDurationMs(vs[3]),
+			WritesCompleted:      vs[4],
+			SectorsWritten:       vs[6],
+			TimeSpentOnWriting:   timeutil.HumanizeDurationMs(vs[7]),
+			TimeSpentOnReadingMs: vs[3],
+			TimeSpentOnWritingMs: vs[7],
+		}, nil
+	}
+	if err = sc.Err(); err != nil {
+		return inspect.DSEntry{}, err
+	}
+	return inspect.DSEntry{}, fmt.Errorf("disk device %q was not found", device)
+}
+
+// ReadNetDev reads the statistics of the network interface in '/proc/net/dev'.
+func ReadNetDev(root, networkInterface string) (inspect.NSEntry, error) {
+	b, err := ioutil.ReadFile(filepath.Join(root, "net", "dev"))
+	if err != nil {
+		return inspect.NSEntry{}, err
+	}
+
+	sc := bufio.NewScanner(bytes.NewReader(b))
+	for sc.Scan() {
+		// e.g. '  eth0: 1234 56 0 0 0 0 0 0 7890 12 0 0 0 0 0 0'
+		kv := strings.SplitN(sc.Text(), ":", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) != networkInterface {
+			continue
+		}
+		fds := strings.Fields(kv[1])
+		if len(fds) < 10 {
+			return inspect.NSEntry{}, fmt.Errorf("invalid net/dev %q", sc.Text())
+		}
+		var vs [4]uint64
+		for i, idx := range []int{0, 1, 8, 9} {
+			if vs[i], err = strconv.ParseUint(fds[idx], 10, 64); err != nil {
+				return inspect.NSEntry{}, fmt.Errorf("invalid net/dev %q (%v)", sc.Text(), err)
+			}
+		}
+		return inspect.NSEntry{
+			Interface:        networkInterface,
+			ReceiveBytes:     humanize.Bytes(vs[0]),
+			ReceivePackets:   vs[1],
+			TransmitBytes:    humanize.Bytes(vs[2]),
+			TransmitPackets:  vs[3],
+			ReceiveBytesNum:  vs[0],
+			TransmitBytesNum: vs[2],
+		}, nil
+	}
+	if err = sc.Err(); err != nil {
+		return inspect.NSEntry{}, err
+	}
+	return inspect.NSEntry{}, fmt.Errorf("network interface %q was not found", networkInterface)
+}
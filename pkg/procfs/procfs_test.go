@@ -0,0 +1,220 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gyuho/linux-inspect/inspect"
+)
+
+const testPID = 1234
+
+// writeProc writes a fake procfs under 'root', with the process
+// CPU time in clock ticks and the system-wide counters.
+func writeProc(t *testing.T, root string, ticks, sectors, rxBytes, ioBytes uint64) {
+	pdir := filepath.Join(root, fmt.Sprint(testPID))
+	for _, dir := range []string{filepath.Join(pdir, "fd"), filepath.Join(root, "net")} {
+		if err := os.MkdirAll(dir, 0777); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if err := ioutil.WriteFile(filepath.Join(pdir, "fd", fmt.Sprint(i)), nil, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files := map[string]string{
+		filepath.Join(pdir, "stat"): fmt.Sprintf("%d (java (main)) S 1 %d %d 0 -1 4194560 100 0 0 0 %d %d 0 0 20 0 42 0 12345 1000000 2500 18446744073709551615\n",
+			testPID, testPID, testPID, ticks, ticks),
+		filepath.Join(pdir, "status"): `Name:	java
+State:	S (sleeping)
+PPid:	1
+VmSize:	  100000 kB
+VmRSS:	   10000 kB
+Threads:	42
+voluntary_ctxt_switches:	150
+nonvoluntary_ctxt_switches:	7
+`,
+		filepath.Join(pdir, "io"):      fmt.Sprintf("rchar: 1\nwchar: 2\nread_bytes: %d\nwrite_bytes: %d\ncancelled_write_bytes: 0\n", ioBytes, 2*ioBytes),
+		filepath.Join(root, "loadavg"): "0.50 0.40 0.30 2/579 12345\n",
+		filepath.Join(root, "diskstats"): fmt.Sprintf("   8       0 sda 10 0 %d 30 20 0 %d 60 0 70 90\n   8       1 sda1 1 0 2 3 4 0 5 6 0 7 8\n",
+			sectors, 2*sectors),
+		filepath.Join(root, "net", "dev"): fmt.Sprintf(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo: 999 9 0 0 0 0 0 0 999 9 0 0 0 0 0 0
+  eth0: %d 10 0 0 0 0 0 0 %d 20 0 0 0 0 0 0
+`, rxBytes, 2*rxBytes),
+	}
+	for fpath, data := range files {
+		if err := ioutil.WriteFile(fpath, []byte(data), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadStat(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "procfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	writeProc(t, root, 100, 0, 0, 0)
+
+	st, err := ReadStat(root, testPID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := Stat{
+		PID:      testPID,
+		Program:  "java (main)",
+		State:    "S",
+		PPID:     1,
+		UTime:    100,
+		STime:    100,
+		Threads:  42,
+		RSSBytes: 2500 * int64(os.Getpagesize()),
+	}
+	if st != exp {
+		t.Fatalf("expected %+v, got %+v", exp, st)
+	}
+}
+
+func TestSampler(t *testing.T) {
+	root, err := ioutil.TempDir(os.TempDir(), "procfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	now := time.Unix(100, 0)
+	s := &Sampler{Root: root, PID: testPID, DiskDevice: "sda", NetworkInterface: "eth0", now: func() time.Time { return now }}
+
+	writeProc(t, root, 100, 1000, 5000, 300)
+	r1, err := s.Sample()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r1.PSEntry.CPUNum != 0 || r1.SectorsReadDelta != 0 {
+		t.Fatalf("expected no delta in first sample, got %+v", r1)
+	}
+	if r1.PSEntry.FD != 3 || r1.PSEntry.Threads != 42 || r1.PSEntry.VMRSSNum != 10000*1024 || r1.PSEntry.VoluntaryCtxtSwitches != 150 {
+		t.Fatalf("unexpected process stats %+v", r1.PSEntry)
+	}
+	if r1.LoadAvg.LoadAvg1Minute != 0.5 || r1.LoadAvg.CurrentKernelSchedulingEntities != 579 {
+		t.Fatalf("unexpected load average %+v", r1.LoadAvg)
+	}
+	if r1.DSEntry.SectorsRead != 1000 || r1.DSEntry.SectorsWritten != 2000 || r1.DSEntry.TimeSpentOnWritingMs != 60 {
+		t.Fatalf("unexpected disk stats %+v", r1.DSEntry)
+	}
+	if r1.NSEntry.ReceiveBytesNum != 5000 || r1.NSEntry.TransmitPackets != 20 {
+		t.Fatalf("unexpected network stats %+v", r1.NSEntry)
+	}
+
+	// 10 + 10 ticks (200ms) of CPU time in 500ms
+	now = now.Add(500 * time.Millisecond)
+	writeProc(t, root, 110, 1100, 5500, 400)
+	r2, err := s.Sample()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r2.PSEntry.CPUNum != 40 {
+		t.Fatalf("expected CPU 40%%, got %v", r2.PSEntry.CPUNum)
+	}
+	if r2.SectorsReadDelta != 100 || r2.ReadBytesDelta != 100*512 || r2.SectorsWrittenDelta != 200 {
+		t.Fatalf("unexpected disk deltas %+v", r2)
+	}
+	if r2.ReceiveBytesNumDelta != 500 || r2.TransmitBytesNumDelta != 1000 {
+		t.Fatalf("unexpected network deltas %+v", r2)
+	}
+	if r2.ProcessReadBytesDelta != 100 || r2.ProcessWriteBytesDelta != 200 {
+		t.Fatalf("unexpected process I/O deltas %+v", r2)
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	row := func(ns int64, cpu float64, sectorsDelta, ioDelta uint64) Row {
+		var r Row
+		r.UnixNanosecond = ns
+		r.UnixSecond = ns / int64(time.Second)
+		r.PSEntry.CPUNum = cpu
+		r.SectorsReadDelta = sectorsDelta
+		r.ProcessReadBytesDelta = ioDelta
+		return r
+	}
+	c := &CSV{Rows: []Row{
+		row(10*int64(time.Second), 10, 1, 10),
+		row(10*int64(time.Second)+int64(500*time.Millisecond), 30, 2, 20),
+		row(13*int64(time.Second), 20, 6, 60),
+	}}
+	cc, err := c.Interpolate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := []struct {
+		unixSecond   int64
+		cpu          float64
+		sectorsDelta uint64
+		ioDelta      uint64
+	}{
+		{10, 20, 3, 30}, // combined
+		{11, 20, 4, 40}, // estimated
+		{12, 20, 5, 50}, // estimated
+		{13, 20, 6, 60},
+	}
+	if len(cc.Rows) != len(exp) {
+		t.Fatalf("expected %d rows, got %d", len(exp), len(cc.Rows))
+	}
+	for i, r := range cc.Rows {
+		if r.UnixNanosecond != 0 || r.UnixSecond != exp[i].unixSecond || r.PSEntry.CPUNum != exp[i].cpu ||
+			r.SectorsReadDelta != exp[i].sectorsDelta || r.ProcessReadBytesDelta != exp[i].ioDelta {
+			t.Fatalf("#%d: expected %+v, got %+v", i, exp[i], r)
+		}
+	}
+}
+
+func TestRowToRow(t *testing.T) {
+	var r Row
+	r.PSEntry.Threads = 42
+	r.PSEntry.VoluntaryCtxtSwitches = 150
+	r.ProcessWriteBytesDelta = 7
+
+	row := r.ToRow()
+	if !reflect.DeepEqual(Header, inspect.ProcHeader) {
+		t.Fatalf("expected header %v, got %v", inspect.ProcHeader, Header)
+	}
+	if len(row) != len(Header) {
+		t.Fatalf("expected %d columns, got %d", len(Header), len(row))
+	}
+	if v := row[inspect.ProcHeaderIndex["VOLUNTARY-CTXT-SWITCHES"]]; v != "150" {
+		t.Fatalf("expected 150, got %q", v)
+	}
+
+	row = r.ToIORow()
+	if len(row) != len(IOHeader) {
+		t.Fatalf("expected %d columns, got %d", len(IOHeader), len(row))
+	}
+	if v := row[len(row)-1]; v != "7" {
+		t.Fatalf("expected 7, got %q", v)
+	}
+}
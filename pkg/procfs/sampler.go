@@ -0,0 +1,171 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procfs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/gyuho/linux-inspect/inspect"
+
+	humanize "github.com/dustin/go-humanize"
+)
+
+// Row is a sample with the same fields as 'inspect.Proc',
+// plus the storage I/O of the process.
+type Row struct {
+	inspect.Proc
+
+	ProcessIO              IO
+	ProcessReadBytesDelta  uint64
+	ProcessWriteBytesDelta uint64
+
+	// cpuTicks is the CPU time of the process
+	// to compute the CPU usage in the next sample.
+	cpuTicks uint64
+}
+
+// Sampler samples a process and the system, and computes the CPU usage
+// and the deltas from its previous sample. Unlike 'top', the CPU usage
+// is measured in clock ticks (10ms), which limits its precision when
+// sampled more often than every second.
+type Sampler struct {
+	// Root is where procfs is mounted, 'DefaultRoot' if empty.
+	Root string
+
+	PID              int64
+	DiskDevice       string
+	NetworkInterface string
+
+	// ExtraPath is the file whose content is recorded as 'EXTRA'.
+	ExtraPath string
+
+	prev *Row
+
+	// now is overridden in tests.
+	now func() time.Time
+}
+
+// Sample samples the process, and the disk and network statistics if
+// the device and interface are set. The process deltas (e.g. CPU usage)
+// are zero in the first sample after the PID changes.
+func (s *Sampler) Sample() (Row, error) {
+	root := s.Root
+	if root == "" {
+		root = DefaultRoot
+	}
+	now := time.Now()
+	if s.now != nil {
+		now = s.now()
+	}
+	r := Row{Proc: inspect.Proc{UnixNanosecond: now.UnixNano(), UnixSecond: now.Unix()}}
+
+	st, err := ReadStat(root, s.PID)
+	if err != nil {
+		return Row{}, err
+	}
+	status, err := ReadStatus(root, s.PID)
+	if err != nil {
+		return Row{}, err
+	}
+	fds, err := CountFDs(root, s.PID)
+	if err != nil {
+		return Row{}, err
+	}
+	if r.ProcessIO, err = ReadIO(root, s.PID); err != nil {
+		return Row{}, err
+	}
+	r.cpuTicks = st.UTime + st.STime
+	r.PSEntry = inspect.PSEntry{
+		Program:                  status.Program,
+		State:                    status.State,
+		PID:                      s.PID,
+		PPID:                     status.PPID,
+		FD:                       fds,
+		Threads:                  status.Threads,
+		VoluntaryCtxtSwitches:    status.VoluntaryCtxtSwitches,
+		NonvoluntaryCtxtSwitches: status.NonvoluntaryCtxtSwitches,
+		VMRSSNum:                 status.VMRSSBytes,
+		VMSizeNum:                status.VMSizeBytes,
+	}
+
+	if r.LoadAvg, err = ReadLoadAvg(root); err != nil {
+		return Row{}, err
+	}
+	if s.DiskDevice != "" {
+		if r.DSEntry, err = ReadDiskStat(root, s.DiskDevice); err != nil {
+			return Row{}, err
+		}
+	}
+	if s.NetworkInterface != "" {
+		if r.NSEntry, err = ReadNetDev(root, s.NetworkInterface); err != nil {
+			return Row{}, err
+		}
+	}
+	if s.ExtraPath != "" {
+		if r.Extra, err = ioutil.ReadFile(s.ExtraPath); err != nil {
+			return Row{}, err
+		}
+	}
+
+	if p := s.prev; p != nil {
+		if p.PSEntry.PID == s.PID {
+			if elapsed := time.Duration(r.UnixNanosecond - p.UnixNanosecond); elapsed > 0 {
+				r.PSEntry.CPUNum = float64(delta(r.cpuTicks, p.cpuTicks)) / ClockTicks / elapsed.Seconds() * 100
+			}
+			r.ProcessReadBytesDelta = delta(r.ProcessIO.ReadBytes, p.ProcessIO.ReadBytes)
+			r.ProcessWriteBytesDelta = delta(r.ProcessIO.WriteBytes, p.ProcessIO.WriteBytes)
+		}
+
+		r.ReadsCompletedDelta = delta(r.DSEntry.ReadsCompleted, p.DSEntry.ReadsCompleted)
+		r.SectorsReadDelta = delta(r.DSEntry.SectorsRead, p.DSEntry.SectorsRead)
+		r.WritesCompletedDelta = delta(r.DSEntry.WritesCompleted, p.DSEntry.WritesCompleted)
+		r.SectorsWrittenDelta = delta(r.DSEntry.SectorsWritten, p.DSEntry.SectorsWritten)
+
+		// SECTOR_SIZE is 512 (one sector is 512-byte) in Linux kernel
+		r.ReadBytesDelta = r.SectorsReadDelta * 512
+		r.WriteBytesDelta = r.SectorsWrittenDelta * 512
+
+		r.ReceiveBytesNumDelta = delta(r.NSEntry.ReceiveBytesNum, p.NSEntry.ReceiveBytesNum)
+		r.TransmitBytesNumDelta = delta(r.NSEntry.TransmitBytesNum, p.NSEntry.TransmitBytesNum)
+		r.ReceivePacketsDelta = delta(r.NSEntry.ReceivePackets, p.NSEntry.ReceivePackets)
+		r.TransmitPacketsDelta = delta(r.NSEntry.TransmitPackets, p.NSEntry.TransmitPackets)
+	}
+	setHumanized(&r)
+
+	prev := r
+	s.prev = &prev
+	return r, nil
+}
+
+// delta returns zero if the counter was reset.
+func delta(cur, prev uint64) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
+
+// setHumanized sets the fields derived from the numbers.
+func setHumanized(r *Row) {
+	r.PSEntry.CPU = fmt.Sprintf("%3.2f %%", r.PSEntry.CPUNum)
+	r.PSEntry.VMRSS = humanize.Bytes(r.PSEntry.VMRSSNum)
+	r.PSEntry.VMSize = humanize.Bytes(r.PSEntry.VMSizeNum)
+	r.ReadMegabytesDelta = r.ReadBytesDelta / 1000000
+	r.WriteMegabytesDelta = r.WriteBytesDelta / 1000000
+	r.ReceiveBytesDelta = humanize.Bytes(r.ReceiveBytesNumDelta)
+	r.TransmitBytesDelta = humanize.Bytes(r.TransmitBytesNumDelta)
+}
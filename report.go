@@ -38,6 +38,9 @@ type benchmark struct {
 
 	mu           sync.RWMutex
 	inflightReqs chan request
+
+	// avail records the results of requests, if not nil
+	avail *availability
 }
 
 // pass totalN in case that 'cfg' is manipulated
@@ -90,7 +93,11 @@ func (b *benchmark) startRequests() {
 				}
 				st := time.Now()
 				err := rh(context.Background(), &req)
-				b.report.Results() <- report.Result{Err: err, Start: st, End: time.Now()}
+				end := time.Now()
+				if b.avail != nil {
+					b.avail.record(st, end, err)
+				}
+				b.report.Results() <- report.Result{Err: err, Start: st, End: end}
 				b.bar.Increment()
 			}
 		}(b.reqHandlers[i])
@@ -138,6 +145,7 @@ func printStats(st report.Stats) {
 
 func (cfg *Config) generateReport(gcfg dbtesterpb.ConfigClientMachineAgentControl, h []ReqHandler, reqDone func(), reqGen func(chan<- request)) {
 	b := newBenchmark(gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber, h, reqDone, reqGen)
+	b.avail = cfg.avail
 	b.startRequests()
 	b.waitAll()
 
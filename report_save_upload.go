@@ -35,6 +35,8 @@ var DiskSpaceUsageSummaryColumns = []string{
 	"DATABASE-ENDPOINT",
 	"DISK-SPACE-USAGE",
 	"DISK-SPACE-USAGE-BYTES-NUM",
+	"DISK-SPACE-ALLOCATED",
+	"DISK-SPACE-ALLOCATED-BYTES-NUM",
 }
 
 // SaveDiskSpaceUsageSummary saves data size summary.
@@ -48,11 +50,15 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	c2 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[1])
 	c3 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[2])
 	c4 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[3])
+	c5 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[4])
+	c6 := dataframe.NewColumn(DiskSpaceUsageSummaryColumns[5])
 	for i := range gcfg.DatabaseEndpoints {
 		c1.PushBack(dataframe.NewStringValue(i))
 		c2.PushBack(dataframe.NewStringValue(gcfg.DatabaseEndpoints[i]))
 		c3.PushBack(dataframe.NewStringValue(humanize.Bytes(uint64(idxToResponse[i].DiskSpaceUsageBytes))))
 		c4.PushBack(dataframe.NewStringValue(idxToResponse[i].DiskSpaceUsageBytes))
+		c5.PushBack(dataframe.NewStringValue(humanize.Bytes(uint64(idxToResponse[i].DiskSpaceAllocatedBytes))))
+		c6.PushBack(dataframe.NewStringValue(idxToResponse[i].DiskSpaceAllocatedBytes))
 	}
 
 	fr := dataframe.New()
@@ -68,10 +74,74 @@ func (cfg *Config) SaveDiskSpaceUsageSummary(databaseID string, idxToResponse ma
 	if err := fr.AddColumn(c4); err != nil {
 		return err
 	}
+	if err := fr.AddColumn(c5); err != nil {
+		return err
+	}
+	if err := fr.AddColumn(c6); err != nil {
+		return err
+	}
 
 	return fr.CSV(cfg.ConfigClientMachineInitial.ServerDiskSpaceUsageSummaryPath)
 }
 
+// StartupSummaryColumns defines startup summary columns.
+var StartupSummaryColumns = []string{
+	"INDEX",
+	"DATABASE-ENDPOINT",
+	"READY-MILLISECOND",
+	"LEADER-MILLISECOND",
+	"RESOURCE-LIMITS",
+	"DATABASE-VERSION",
+}
+
+// SaveStartupSummary saves the time from the process start until
+// each member is ready, and until it knows the leader, with the
+// resource limits that each agent applied, if any, and the version
+// of the database binary.
+func (cfg *Config) SaveStartupSummary(databaseID string, idxToResponse map[int]dbtesterpb.Response) error {
+	gcfg, ok := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	if !ok {
+		return fmt.Errorf("%q does not exist", databaseID)
+	}
+
+	c1 := dataframe.NewColumn(StartupSummaryColumns[0])
+	c2 := dataframe.NewColumn(StartupSummaryColumns[1])
+	c3 := dataframe.NewColumn(StartupSummaryColumns[2])
+	c4 := dataframe.NewColumn(StartupSummaryColumns[3])
+	c5 := dataframe.NewColumn(StartupSummaryColumns[4])
+	c6 := dataframe.NewColumn(StartupSummaryColumns[5])
+	for i := range gcfg.DatabaseEndpoints {
+		c1.PushBack(dataframe.NewStringValue(i))
+		c2.PushBack(dataframe.NewStringValue(gcfg.DatabaseEndpoints[i]))
+		c3.PushBack(dataframe.NewStringValue(idxToResponse[i].ReadyMillisecond))
+		c4.PushBack(dataframe.NewStringValue(idxToResponse[i].LeaderMillisecond))
+		c5.PushBack(dataframe.NewStringValue(idxToResponse[i].ResourceLimits))
+		c6.PushBack(dataframe.NewStringValue(idxToResponse[i].DatabaseVersion))
+	}
+
+	fr := dataframe.New()
+	if err := fr.AddColumn(c1); err != nil {
+		return err
+	}
+	if err := fr.AddColumn(c2); err != nil {
+		return err
+	}
+	if err := fr.AddColumn(c3); err != nil {
+		return err
+	}
+	if err := fr.AddColumn(c4); err != nil {
+		return err
+	}
+	if err := fr.AddColumn(c5); err != nil {
+		return err
+	}
+	if err := fr.AddColumn(c6); err != nil {
+		return err
+	}
+
+	return fr.CSV(cfg.ConfigClientMachineInitial.ServerStartupSummaryPath)
+}
+
 func (cfg *Config) saveDataLatencyDistributionSummary(st report.Stats) {
 	fr := dataframe.New()
 
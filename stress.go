@@ -24,9 +24,7 @@ import (
 
 	"github.com/etcd-io/dbtester/dbtesterpb"
 
-	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/pkg/report"
-	consulapi "github.com/hashicorp/consul/api"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
 	"golang.org/x/time/rate"
@@ -34,13 +32,11 @@ import (
 
 type values struct {
 	bytes      [][]byte
-	strings    []string
 	sampleSize int
 }
 
 func newValues(gcfg dbtesterpb.ConfigClientMachineAgentControl) (v values, rerr error) {
 	v.bytes = [][]byte{randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)}
-	v.strings = []string{string(v.bytes[0])}
 	v.sampleSize = 1
 	return
 }
@@ -51,20 +47,32 @@ func (cfg *Config) Stress(databaseID string) error {
 	if !ok {
 		return fmt.Errorf("%q does not exist", databaseID)
 	}
+	dr, err := getDriver(gcfg)
+	if err != nil {
+		return err
+	}
 
 	vals, err := newValues(gcfg)
 	if err != nil {
 		return err
 	}
 
+	if len(gcfg.Faults) > 0 {
+		cfg.avail = newAvailability()
+	}
+	// clients connect to the initial members
+	if n := gcfg.InitialClusterSize; n > 0 {
+		gcfg.DatabaseEndpoints = gcfg.DatabaseEndpoints[:n:n]
+	}
+
 	switch gcfg.ConfigClientMachineBenchmarkOptions.Type {
 	case "write":
 		cfg.lg.Info("write generateReport is started...")
 
 		// fixed number of client numbers
 		if len(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionClientNumbers) == 0 {
-			h, done := newWriteHandlers(cfg.lg, gcfg)
-			reqGen := func(inflightReqs chan<- request) { generateWrites(gcfg, 0, vals, inflightReqs) }
+			h, done := newWriteHandlers(cfg.lg, dr, gcfg)
+			reqGen := func(inflightReqs chan<- request) { generateWrites(dr, gcfg, 0, vals, inflightReqs) }
 			cfg.generateReport(gcfg, h, done, reqGen)
 
 		} else {
@@ -88,9 +96,10 @@ func (cfg *Config) Stress(databaseID string) error {
 					}
 				}()
 
-				h, done := newWriteHandlers(cfg.lg, copied)
-				reqGen := func(inflightReqs chan<- request) { generateWrites(copied, reqCompleted, vals, inflightReqs) }
+				h, done := newWriteHandlers(cfg.lg, dr, copied)
+				reqGen := func(inflightReqs chan<- request) { generateWrites(dr, copied, reqCompleted, vals, inflightReqs) }
 				b := newBenchmark(copied.ConfigClientMachineBenchmarkOptions.RequestNumber, copied.ConfigClientMachineBenchmarkOptions.ClientNumber, h, done, reqGen)
+				b.avail = cfg.avail
 
 				// wait until rs[i] requests are finished
 				// do not end reports yet
@@ -173,242 +182,75 @@ func (cfg *Config) Stress(databaseID string) error {
 		cfg.lg.Info("write generateReport is finished...")
 
 		cfg.lg.Info("checking total keys on", zap.Strings("endpoints", gcfg.DatabaseEndpoints))
-		var totalKeysFunc func(*zap.Logger, []string) map[string]int64
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			totalKeysFunc = getTotalKeysEtcdv3
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			totalKeysFunc = getTotalKeysZk
-		case "consul__v1_0_2", "cetcd__beta":
-			totalKeysFunc = getTotalKeysConsul
-		default:
-			cfg.lg.Fatal("unknown database ID", zap.String("database", gcfg.DatabaseID))
-		}
-		for k, v := range totalKeysFunc(cfg.lg, gcfg.DatabaseEndpoints) {
+		for k, v := range dr.totalKeys(cfg.lg, gcfg.DatabaseEndpoints) {
 			cfg.lg.Sugar().Infof("expected write total results [expected_total: %d | database: %q | endpoint: %q | number_of_keys: %d]",
 				gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber, gcfg.DatabaseID, k, v)
 		}
 
 	case "read":
-		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
-
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-					totalConns:   1,
-					totalClients: 1,
-				})
-				_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
-				if err != nil {
-					continue
-				}
-				cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				cfg.lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
 
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
-				if err != nil {
-					continue
-				}
-				for j := range conns {
-					conns[j].Close()
-				}
-				cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				cfg.lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
-
-		case "consul__v1_0_2", "cetcd__beta":
-			cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
-				if err != nil {
-					continue
-				}
-				cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				cfg.lg.Sugar().Fatalf("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
+		cfg.lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+		var err error
+		for i := 0; i < 7; i++ {
+			if err = dr.put(gcfg, key, vals.bytes[0]); err != nil {
+				continue
 			}
-
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+			cfg.lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+			break
+		}
+		if err != nil {
+			cfg.lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
+			os.Exit(1)
 		}
 
-		h, done := newReadHandlers(gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
+		h, done := newReadHandlers(dr, gcfg)
+		reqGen := func(inflightReqs chan<- request) { generateReads(dr, gcfg, key, inflightReqs) }
 		cfg.generateReport(gcfg, h, done, reqGen)
 		cfg.lg.Info("read generateReport is finished...")
 
 	case "read-oneshot":
-		key, value := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes), vals.strings[0]
+		key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
 		cfg.lg.Sugar().Infof("writing key for read-oneshot [key: %q | database: %q]", key, gcfg.DatabaseID)
-		var err error
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-				totalConns:   1,
-				totalClients: 1,
-			})
-			_, err = clients[0].Do(context.Background(), clientv3.OpPut(key, value))
-			clients[0].Close()
-
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, 1)
-			_, err = conns[0].Create("/"+key, vals.bytes[0], zkCreateFlags, zkCreateACL)
-			conns[0].Close()
-
-		case "consul__v1_0_2", "cetcd__beta":
-			clients := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
-			_, err = clients[0].Put(&consulapi.KVPair{Key: key, Value: vals.bytes[0]}, nil)
-
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
-		}
-		if err != nil {
+		if err := dr.put(gcfg, key, vals.bytes[0]); err != nil {
 			cfg.lg.Sugar().Fatalf("write error on read-oneshot (%v)", err)
 			os.Exit(1)
 		}
 
-		h := newReadOneshotHandlers(cfg.lg, gcfg)
-		reqGen := func(inflightReqs chan<- request) { generateReads(gcfg, key, inflightReqs) }
+		h := newReadOneshotHandlers(cfg.lg, dr, gcfg)
+		reqGen := func(inflightReqs chan<- request) { generateReads(dr, gcfg, key, inflightReqs) }
 		cfg.generateReport(gcfg, h, nil, reqGen)
 		cfg.lg.Info("read-oneshot generateReport is finished...")
-	}
 
-	return nil
-}
-
-func newReadHandlers(gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
-	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
-	switch gcfg.DatabaseID {
-	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		clients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
-			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
-		})
-		for i := range clients {
-			rhs[i] = newGetEtcd3(clients[i].KV)
-		}
-		done = func() {
-			for i := range clients {
-				clients[i].Close()
-			}
-		}
+	case "session":
+		h, done, cnt := newSessionHandlers(cfg.lg, dr, gcfg)
+		reqGen := func(inflightReqs chan<- request) { generateSessionRequests(gcfg, inflightReqs) }
+		cfg.generateReport(gcfg, h, done, reqGen)
+		cfg.saveSessionConsistency(cnt)
+		cfg.lg.Info("session generateReport is finished...")
 
-	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			rhs[i] = newGetZK(conns[i])
-		}
-		done = func() {
-			for i := range conns {
-				conns[i].Close()
-			}
+	case "crash-recovery":
+		if err = cfg.stressCrashRecovery(databaseID, dr, gcfg, vals); err != nil {
+			return err
 		}
+		cfg.lg.Info("crash-recovery generateReport is finished...")
 
-	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			rhs[i] = newGetConsul(conns[i])
+	case "snapshot":
+		if err = cfg.stressSnapshot(databaseID, dr, gcfg, vals); err != nil {
+			return err
 		}
-
-	default:
-		panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
+		cfg.lg.Info("snapshot generateReport is finished...")
 	}
-	return rhs, done
-}
-
-func newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
-	rhs = make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
-	switch gcfg.DatabaseID {
-	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		etcdClients := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-			totalConns:   gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber,
-			totalClients: gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber,
-		})
-		for i := range etcdClients {
-			rhs[i] = newPutEtcd3(etcdClients[i])
-		}
 
-		done = func() {
-			// this "done" function may decrease throughput at the end
-			for i := range etcdClients {
-				go func(idx int) {
-					etcdClients[idx].Close()
-				}(i)
-			}
-		}
-
-	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
-			key := sameKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes)
-			valueBts := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
-			lg.Sugar().Infof("write started [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-			var err error
-			for i := 0; i < 7; i++ {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				_, err = conns[0].Create("/"+key, valueBts, zkCreateFlags, zkCreateACL)
-				if err != nil {
-					continue
-				}
-				for j := range conns {
-					conns[j].Close()
-				}
-				lg.Sugar().Infof("write done [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				break
-			}
-			if err != nil {
-				lg.Sugar().Fatalf("write error [request: PUT | key: %q | database: %q]", key, gcfg.DatabaseID)
-				os.Exit(1)
-			}
-		}
-
-		conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
-				rhs[i] = newPutOverwriteZK(conns[i])
-			} else {
-				rhs[i] = newPutCreateZK(conns[i])
-			}
-		}
-		done = func() {
-			for i := range conns {
-				go func(idx int) {
-					conns[idx].Close()
-				}(i)
-			}
-		}
-
-	case "consul__v1_0_2", "cetcd__beta":
-		conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-		for i := range conns {
-			rhs[i] = newPutConsul(conns[i])
-		}
+	return nil
+}
 
-	default:
-		lg.Sugar().Fatalf("%q is unknown database ID", gcfg.DatabaseID)
-	}
+func newReadHandlers(dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	return dr.newReadHandlers(gcfg)
+}
 
+func newWriteHandlers(lg *zap.Logger, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func()) {
+	rhs, done = dr.newWriteHandlers(lg, gcfg)
 	for k := range rhs {
 		if rhs[k] == nil {
 			lg.Sugar().Fatalf("%d-th write handler is nil (out of %d)", k, len(rhs))
@@ -417,44 +259,11 @@ func newWriteHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentCo
 	return
 }
 
-func newReadOneshotHandlers(lg *zap.Logger, gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
-	rhs := make([]ReqHandler, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
-	switch gcfg.DatabaseID {
-	case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateClientsEtcdv3(gcfg.DatabaseEndpoints, etcdv3ClientCfg{
-					totalConns:   1,
-					totalClients: 1,
-				})
-				defer conns[0].Close()
-				return newGetEtcd3(conns[0])(ctx, req)
-			}
-		}
-
-	case "zookeeper__r3_5_3_beta", "zetcd__beta":
-		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsZk(gcfg.DatabaseEndpoints, gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
-				defer conns[0].Close()
-				return newGetZK(conns[0])(ctx, req)
-			}
-		}
-
-	case "consul__v1_0_2", "cetcd__beta":
-		for i := range rhs {
-			rhs[i] = func(ctx context.Context, req *request) error {
-				conns := mustCreateConnsConsul(gcfg.DatabaseEndpoints, 1)
-				return newGetConsul(conns[0])(ctx, req)
-			}
-		}
-	default:
-		lg.Sugar().Fatalf("%q is unknown database ID", gcfg.DatabaseID)
-	}
-	return rhs
+func newReadOneshotHandlers(lg *zap.Logger, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) []ReqHandler {
+	return dr.newReadOneshotHandlers(gcfg)
 }
 
-func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
+func generateReads(dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, key string, inflightReqs chan<- request) {
 	defer close(inflightReqs)
 
 	var rateLimiter *rate.Limiter
@@ -470,34 +279,11 @@ func generateReads(gcfg dbtesterpb.ConfigClientMachineAgentControl, key string,
 			rateLimiter.Wait(context.TODO())
 		}
 
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			opts := []clientv3.OpOption{clientv3.WithRange("")}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				opts = append(opts, clientv3.WithSerializable())
-			}
-			inflightReqs <- request{etcdv3Op: clientv3.OpGet(key, opts...)}
-
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			op := zkOp{key: key}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				op.staleRead = true
-			}
-			inflightReqs <- request{zkOp: op}
-
-		case "consul__v1_0_2", "cetcd__beta":
-			op := consulOp{key: key}
-			if gcfg.ConfigClientMachineBenchmarkOptions.StaleRead {
-				op.staleRead = true
-			}
-			inflightReqs <- request{consulOp: op}
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
-		}
+		inflightReqs <- dr.readRequest(key, gcfg.ConfigClientMachineBenchmarkOptions.StaleRead)
 	}
 }
 
-func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- request) {
+func generateWrites(dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx int64, vals values, inflightReqs chan<- request) {
 	var rateLimiter *rate.Limiter
 	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
 		rateLimiter = rate.NewLimiter(
@@ -519,24 +305,11 @@ func generateWrites(gcfg dbtesterpb.ConfigClientMachineAgentControl, startIdx in
 		}
 
 		v := vals.bytes[i%int64(vals.sampleSize)]
-		vs := vals.strings[i%int64(vals.sampleSize)]
 
 		if rateLimiter != nil {
 			rateLimiter.Wait(context.TODO())
 		}
 
-		switch gcfg.DatabaseID {
-		case "etcd__other", "etcd__tip", "etcd__v3_2", "etcd__v3_3":
-			inflightReqs <- request{etcdv3Op: clientv3.OpPut(k, vs)}
-
-		case "zookeeper__r3_5_3_beta", "zetcd__beta":
-			inflightReqs <- request{zkOp: zkOp{key: "/" + k, value: v}}
-
-		case "consul__v1_0_2", "cetcd__beta":
-			inflightReqs <- request{consulOp: consulOp{key: k, value: v}}
-
-		default:
-			panic(fmt.Sprintf("%q is unknown database ID", gcfg.DatabaseID))
-		}
+		inflightReqs <- dr.writeRequest(k, v)
 	}
 }
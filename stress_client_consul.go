@@ -68,6 +68,25 @@ func newGetConsul(conn *consulapi.KV) ReqHandler {
 	}
 }
 
+func newSessionConsul(wconn, rconn *consulapi.KV, staleRead bool) (sessionWriteFunc, sessionReadFunc) {
+	write := func(ctx context.Context, key string, value []byte) error {
+		_, err := wconn.Put(&consulapi.KVPair{Key: key, Value: value}, nil)
+		return err
+	}
+	read := func(ctx context.Context, key string) ([]byte, int64, error) {
+		opt := &consulapi.QueryOptions{AllowStale: staleRead, RequireConsistent: !staleRead}
+		pair, _, err := rconn.Get(key, opt)
+		if err != nil {
+			return nil, 0, err
+		}
+		if pair == nil {
+			return nil, 0, nil
+		}
+		return pair.Value, int64(pair.ModifyIndex), nil
+	}
+	return write, read
+}
+
 func getTotalKeysConsul(lg *zap.Logger, endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
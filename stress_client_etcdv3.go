@@ -77,6 +77,18 @@ func mustCreateClientsEtcdv3(endpoints []string, cfg etcdv3ClientCfg) []*clientv
 	return clients
 }
 
+// mustCreateConnsEtcdv3 creates connections that are each pinned to
+// one endpoint in round-robin order, unlike mustCreateConnEtcdv3.
+func mustCreateConnsEtcdv3(endpoints []string, total int64) []*clientv3.Client {
+	conns := make([]*clientv3.Client, total)
+	for i := range conns {
+		endpoint := endpoints[dialTotal%len(endpoints)]
+		dialTotal++
+		conns[i] = mustCreateConnEtcdv3([]string{endpoint})
+	}
+	return conns
+}
+
 func newGetEtcd3(conn clientv3.KV) ReqHandler {
 	return func(ctx context.Context, req *request) error {
 		_, err := conn.Do(ctx, req.etcdv3Op)
@@ -84,6 +96,28 @@ func newGetEtcd3(conn clientv3.KV) ReqHandler {
 	}
 }
 
+func newSessionEtcd3(wconn, rconn clientv3.KV, staleRead bool) (sessionWriteFunc, sessionReadFunc) {
+	write := func(ctx context.Context, key string, value []byte) error {
+		_, err := wconn.Put(ctx, key, string(value))
+		return err
+	}
+	read := func(ctx context.Context, key string) ([]byte, int64, error) {
+		var opts []clientv3.OpOption
+		if staleRead {
+			opts = append(opts, clientv3.WithSerializable())
+		}
+		resp, err := rconn.Get(ctx, key, opts...)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(resp.Kvs) == 0 {
+			return nil, 0, nil
+		}
+		return resp.Kvs[0].Value, resp.Kvs[0].ModRevision, nil
+	}
+	return write, read
+}
+
 func getTotalKeysEtcdv3(lg *zap.Logger, endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	for _, ep := range endpoints {
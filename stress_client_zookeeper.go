@@ -89,6 +89,32 @@ func newGetZK(conn *zk.Conn) ReqHandler {
 	}
 }
 
+func newSessionZK(wconn, rconn *zk.Conn, staleRead bool) (sessionWriteFunc, sessionReadFunc) {
+	write := func(ctx context.Context, key string, value []byte) error {
+		_, err := wconn.Set("/"+key, value, int32(-1))
+		if err == zk.ErrNoNode {
+			_, err = wconn.Create("/"+key, value, zkCreateFlags, zkCreateACL)
+		}
+		return err
+	}
+	read := func(ctx context.Context, key string) ([]byte, int64, error) {
+		if !staleRead {
+			if _, err := rconn.Sync("/" + key); err != nil {
+				return nil, 0, err
+			}
+		}
+		v, st, err := rconn.Get("/" + key)
+		if err == zk.ErrNoNode {
+			return nil, 0, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		return v, st.Mzxid, nil
+	}
+	return write, read
+}
+
 func getTotalKeysZk(lg *zap.Logger, endpoints []string) map[string]int64 {
 	rs := make(map[string]int64)
 	stats, ok := zk.FLWSrvr(endpoints, 5*time.Second)
@@ -0,0 +1,322 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+)
+
+// crashRecoveryTimeout is the maximum duration to wait for
+// the crashed cluster to serve writes again.
+const crashRecoveryTimeout = 5 * time.Minute
+
+// ackedKeys records the keys of acknowledged writes.
+type ackedKeys struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (a *ackedKeys) add(key string) {
+	a.mu.Lock()
+	a.keys = append(a.keys, key)
+	a.mu.Unlock()
+}
+
+// requestKey returns the key that the write request is for.
+func requestKey(req *request) string {
+	if k := req.etcdv3Op.KeyBytes(); len(k) > 0 {
+		return string(k)
+	}
+	if req.zkOp.key != "" {
+		return req.zkOp.key
+	}
+	return req.consulOp.key
+}
+
+// newAckedHandler wraps the write handler to record the keys
+// of acknowledged writes.
+func newAckedHandler(rh ReqHandler, acks *ackedKeys) ReqHandler {
+	return func(ctx context.Context, req *request) error {
+		err := rh(ctx, req)
+		if err == nil {
+			acks.add(requestKey(req))
+		}
+		return err
+	}
+}
+
+// crashRecovery is the result of crash-recovery benchmark.
+type crashRecovery struct {
+	crashUnixNano int64
+
+	// killTook is the duration to kill all members.
+	killTook time.Duration
+	// restartToQuorum is the duration from restarting all members
+	// to the first acknowledged write.
+	restartToQuorum time.Duration
+	// crashToQuorum is the duration from killing all members
+	// to the first acknowledged write.
+	crashToQuorum time.Duration
+
+	acknowledged int64
+	lost         int64
+}
+
+// checkCrashOffset returns an error if the crash offset is not positive,
+// or not shorter than the expected run. The run is only known with
+// a rate limit, which spreads 'RequestNumber' over time.
+func checkCrashOffset(opts dbtesterpb.ConfigClientMachineBenchmarkOptions) error {
+	if opts.CrashOffsetMillisecond <= 0 {
+		return fmt.Errorf("has crash offset %d ms, which must be positive", opts.CrashOffsetMillisecond)
+	}
+	if opts.RateLimitRequestsPerSecond <= 0 {
+		return nil
+	}
+	runMs := opts.RequestNumber * 1000 / opts.RateLimitRequestsPerSecond
+	if opts.CrashOffsetMillisecond >= runMs {
+		return fmt.Errorf("has crash offset %d ms, which must be shorter than the run of %d ms (%d requests at %d per second)",
+			opts.CrashOffsetMillisecond, runMs, opts.RequestNumber, opts.RateLimitRequestsPerSecond)
+	}
+	return nil
+}
+
+// stressCrashRecovery writes under load, kills all members at once
+// after 'CrashOffsetMillisecond', restarts them with their existing
+// data directories, and verifies that every acknowledged write survived.
+func (cfg *Config) stressCrashRecovery(databaseID string, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+		return fmt.Errorf("crash-recovery does not support same key writes")
+	}
+
+	acks := &ackedKeys{}
+	h, done := newWriteHandlers(cfg.lg, dr, gcfg)
+	for i := range h {
+		h[i] = newAckedHandler(h[i], acks)
+	}
+	reqGen := func(inflightReqs chan<- request) { generateWrites(dr, gcfg, 0, vals, inflightReqs) }
+
+	crashc := make(chan error, 1)
+	var cr crashRecovery
+	go func() {
+		time.Sleep(time.Duration(gcfg.ConfigClientMachineBenchmarkOptions.CrashOffsetMillisecond) * time.Millisecond)
+		crashc <- cfg.crashAndRecover(databaseID, dr, gcfg, &cr)
+	}()
+
+	cfg.generateReport(gcfg, h, done, reqGen)
+	if err := <-crashc; err != nil {
+		return err
+	}
+
+	cr.acknowledged = int64(len(acks.keys))
+	lost, err := verifyAckedKeys(cfg.lg, dr, gcfg, acks.keys)
+	if err != nil {
+		return err
+	}
+	cr.lost = lost
+
+	cfg.saveCrashRecovery(cr)
+	return nil
+}
+
+// crashAndRecover kills all members at once, restarts them,
+// and waits until the cluster acknowledges a write.
+func (cfg *Config) crashAndRecover(databaseID string, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, cr *crashRecovery) error {
+	cfg.lg.Info("killing all members", zap.String("database-id", databaseID))
+	cr.crashUnixNano = time.Now().UnixNano()
+	if err := cfg.sendRequestToAll(databaseID, dbtesterpb.Operation_Kill); err != nil {
+		return err
+	}
+	cr.killTook = time.Since(time.Unix(0, cr.crashUnixNano))
+
+	cfg.lg.Info("restarting all members", zap.String("database-id", databaseID))
+	restartStart := time.Now()
+	if err := cfg.sendRequestToAll(databaseID, dbtesterpb.Operation_Restart); err != nil {
+		return err
+	}
+
+	probe := newProbeWriter(cfg.lg, dr, gcfg)
+	defer probe.done()
+	for i := 0; ; i++ {
+		if time.Since(restartStart) > crashRecoveryTimeout {
+			return fmt.Errorf("%q did not recover in %v", databaseID, crashRecoveryTimeout)
+		}
+		err := probe.write(fmt.Sprintf("dbtester-crash-recovery-probe-%d", i))
+		if err == nil {
+			break
+		}
+		cfg.lg.Debug("waiting for quorum", zap.Error(err))
+		time.Sleep(100 * time.Millisecond)
+	}
+	now := time.Now()
+	cr.restartToQuorum = now.Sub(restartStart)
+	cr.crashToQuorum = now.Sub(time.Unix(0, cr.crashUnixNano))
+	cfg.lg.Info("recovered",
+		zap.String("database-id", databaseID),
+		zap.Duration("restart-to-quorum", cr.restartToQuorum),
+		zap.Duration("crash-to-quorum", cr.crashToQuorum),
+	)
+	return nil
+}
+
+// sendRequestToAll sends the request to all agents at once,
+// unlike 'BroadcaseRequest' that sends one by one.
+func (cfg *Config) sendRequestToAll(databaseID string, op dbtesterpb.Operation) error {
+	gcfg := cfg.DatabaseIDToConfigClientMachineAgentControl[databaseID]
+	errc := make(chan error, len(gcfg.AgentEndpoints))
+	for i := range gcfg.AgentEndpoints {
+		go func(idx int) {
+			_, err := cfg.SendRequest(databaseID, op, idx)
+			errc <- err
+		}(i)
+	}
+	var rerr error
+	for range gcfg.AgentEndpoints {
+		if err := <-errc; err != nil && rerr == nil {
+			rerr = err
+		}
+	}
+	return rerr
+}
+
+// probeWriter writes a key with timeout, to check if the cluster
+// serves quorum writes.
+type probeWriter struct {
+	write func(key string) error
+	done  func()
+}
+
+func newProbeWriter(lg *zap.Logger, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) probeWriter {
+	copied := gcfg
+	copied.ConfigClientMachineBenchmarkOptions.ClientNumber = 1
+	copied.ConfigClientMachineBenchmarkOptions.ConnectionNumber = 1
+	h, done := newWriteHandlers(lg, dr, copied)
+	if done == nil {
+		done = func() {}
+	}
+
+	value := randBytes(gcfg.ConfigClientMachineBenchmarkOptions.ValueSizeBytes)
+	write := func(key string) error {
+		req := dr.writeRequest(key, value)
+
+		// some clients block until reconnected, regardless of context
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		errc := make(chan error, 1)
+		go func() { errc <- h[0](ctx, &req) }()
+		select {
+		case err := <-errc:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return probeWriter{write: write, done: done}
+}
+
+// verifyAckedKeys returns the number of acknowledged keys
+// that do not exist in the database.
+func verifyAckedKeys(lg *zap.Logger, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, keys []string) (int64, error) {
+	n := int(gcfg.ConfigClientMachineBenchmarkOptions.ConnectionNumber)
+	if n < 1 {
+		n = 1
+	}
+
+	exists, done := dr.newKeyCheckers(gcfg, n)
+	if done == nil {
+		done = func() {}
+	}
+	defer done()
+
+	lg.Info("verifying acknowledged writes", zap.Int("keys", len(keys)))
+	keyc := make(chan string, n)
+	go func() {
+		for _, k := range keys {
+			keyc <- k
+		}
+		close(keyc)
+	}()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		lost int64
+		rerr error
+	)
+	wg.Add(len(exists))
+	for i := range exists {
+		go func(exist func(string) (bool, error)) {
+			defer wg.Done()
+			for k := range keyc {
+				ok, err := exist(k)
+				mu.Lock()
+				switch {
+				case err != nil:
+					if rerr == nil {
+						rerr = fmt.Errorf("failed to verify %q (%v)", k, err)
+					}
+				case !ok:
+					lost++
+					if lost <= 10 {
+						lg.Warn("lost acknowledged write", zap.String("key", k))
+					}
+				}
+				mu.Unlock()
+			}
+		}(exists[i])
+	}
+	wg.Wait()
+	return lost, rerr
+}
+
+func (cfg *Config) saveCrashRecovery(cr crashRecovery) {
+	cfg.lg.Info("crash recovery results",
+		zap.Int64("acknowledged-writes", cr.acknowledged),
+		zap.Int64("lost-writes", cr.lost),
+		zap.Duration("kill-took", cr.killTook),
+		zap.Duration("restart-to-quorum", cr.restartToQuorum),
+		zap.Duration("crash-to-quorum", cr.crashToQuorum),
+	)
+
+	fr := dataframe.New()
+	for _, col := range []struct {
+		name string
+		v    string
+	}{
+		{"CRASH-UNIX-SECOND", fmt.Sprintf("%d", cr.crashUnixNano/int64(time.Second))},
+		{"ACKNOWLEDGED-WRITES", fmt.Sprintf("%d", cr.acknowledged)},
+		{"LOST-WRITES", fmt.Sprintf("%d", cr.lost)},
+		{"KILL-MILLISECOND", fmt.Sprintf("%.3f", toMillisecond(cr.killTook))},
+		{"RESTART-TO-QUORUM-MILLISECOND", fmt.Sprintf("%.3f", toMillisecond(cr.restartToQuorum))},
+		{"CRASH-TO-QUORUM-MILLISECOND", fmt.Sprintf("%.3f", toMillisecond(cr.crashToQuorum))},
+	} {
+		c := dataframe.NewColumn(col.name)
+		c.PushBack(dataframe.NewStringValue(col.v))
+		if err := fr.AddColumn(c); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientCrashRecoveryPath); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+)
+
+// sessionCounter counts the session guarantee violations of all clients.
+type sessionCounter struct {
+	writes int64
+	reads  int64
+
+	readYourWrites  int64
+	monotonicReads  int64
+	monotonicWrites int64
+}
+
+// sessionChecker checks the reads of one client against its own writes.
+// Values encode the session sequence number, and versions are the
+// store-wide revisions that the database attached to the key
+// (etcd mod revision, zookeeper mzxid, consul modify index).
+type sessionChecker struct {
+	cnt *sessionCounter
+
+	lastWriteSeq    int64 // sequence of the last acknowledged write
+	lastReadSeq     int64 // sequence returned by the last read
+	lastReadVersion int64 // version returned by the last read
+}
+
+// wrote records that the write of 'seq' was acknowledged.
+func (c *sessionChecker) wrote(seq int64) {
+	atomic.AddInt64(&c.cnt.writes, 1)
+	if seq > c.lastWriteSeq {
+		c.lastWriteSeq = seq
+	}
+}
+
+// read checks the read result of the client's own key.
+// 'seq' is 0 if the key was not found.
+func (c *sessionChecker) read(seq, version int64) {
+	atomic.AddInt64(&c.cnt.reads, 1)
+
+	// must observe its own last acknowledged write (or a later one)
+	if seq < c.lastWriteSeq {
+		atomic.AddInt64(&c.cnt.readYourWrites, 1)
+	}
+
+	switch {
+	case seq < c.lastReadSeq:
+		// must not go back to an older state of the key
+		atomic.AddInt64(&c.cnt.monotonicReads, 1)
+		return
+
+	case seq > c.lastReadSeq && c.lastReadSeq > 0 && version <= c.lastReadVersion:
+		// later write must be ordered after the earlier write
+		atomic.AddInt64(&c.cnt.monotonicWrites, 1)
+	}
+
+	c.lastReadSeq, c.lastReadVersion = seq, version
+}
+
+// sessionWriteFunc writes the value to the client's own key.
+type sessionWriteFunc func(ctx context.Context, key string, value []byte) error
+
+// sessionReadFunc reads the client's own key, returning nil value when not found.
+type sessionReadFunc func(ctx context.Context, key string) (value []byte, version int64, err error)
+
+// encodeSessionValue returns '12|aaaa' when seq is 12 and size is 7.
+func encodeSessionValue(seq, size int64) []byte {
+	txt := strconv.FormatInt(seq, 10) + "|"
+	if int64(len(txt)) >= size {
+		return []byte(txt)
+	}
+	return append([]byte(txt), bytes.Repeat([]byte("a"), int(size)-len(txt))...)
+}
+
+// decodeSessionValue returns the sequence number of the value, or 0
+// when the value is empty or was not written in session mode.
+func decodeSessionValue(v []byte) int64 {
+	i := bytes.IndexByte(v, '|')
+	if i < 0 {
+		return 0
+	}
+	seq, err := strconv.ParseInt(string(v[:i]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return seq
+}
+
+// newSessionHandler returns a handler that alternates between writing and
+// reading the client's own key, checking each read against the session.
+func newSessionHandler(key string, valueSize int64, write sessionWriteFunc, read sessionReadFunc, cnt *sessionCounter) ReqHandler {
+	c := &sessionChecker{cnt: cnt}
+	seq, reading := int64(0), false
+	return func(ctx context.Context, req *request) error {
+		if reading {
+			reading = false
+			v, ver, err := read(ctx, key)
+			if err != nil {
+				return err
+			}
+			c.read(decodeSessionValue(v), ver)
+			return nil
+		}
+
+		reading = true
+		seq++
+		if err := write(ctx, key, encodeSessionValue(seq, valueSize)); err != nil {
+			return err
+		}
+		c.wrote(seq)
+		return nil
+	}
+}
+
+// newSessionHandlers creates one handler per client. Each client writes
+// and reads through two different connections, which are assigned to
+// endpoints in round-robin order, so that reads may be served by
+// a different member than the one that took the write.
+func newSessionHandlers(lg *zap.Logger, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl) (rhs []ReqHandler, done func(), cnt *sessionCounter) {
+	cnt = &sessionCounter{}
+	keys := make([]string, gcfg.ConfigClientMachineBenchmarkOptions.ClientNumber)
+	for i := range keys {
+		keys[i] = "session" + sequentialKey(gcfg.ConfigClientMachineBenchmarkOptions.KeySizeBytes, int64(i))
+	}
+
+	rhs, done = dr.newSessionHandlers(gcfg, keys, cnt)
+	return rhs, done, cnt
+}
+
+func generateSessionRequests(gcfg dbtesterpb.ConfigClientMachineAgentControl, inflightReqs chan<- request) {
+	defer close(inflightReqs)
+
+	var rateLimiter *rate.Limiter
+	if gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond > 0 {
+		rateLimiter = rate.NewLimiter(
+			rate.Limit(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+			int(gcfg.ConfigClientMachineBenchmarkOptions.RateLimitRequestsPerSecond),
+		)
+	}
+
+	// each client decides whether to write or read its own key
+	for i := int64(0); i < gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber; i++ {
+		if rateLimiter != nil {
+			rateLimiter.Wait(context.TODO())
+		}
+		inflightReqs <- request{}
+	}
+}
+
+func (cfg *Config) saveSessionConsistency(cnt *sessionCounter) {
+	cfg.lg.Info("session consistency results",
+		zap.Int64("writes", cnt.writes),
+		zap.Int64("reads", cnt.reads),
+		zap.Int64("read-your-writes-violations", cnt.readYourWrites),
+		zap.Int64("monotonic-reads-violations", cnt.monotonicReads),
+		zap.Int64("monotonic-writes-violations", cnt.monotonicWrites),
+	)
+
+	fr := dataframe.New()
+	for _, col := range []struct {
+		name string
+		v    int64
+	}{
+		{"TOTAL-WRITES", cnt.writes},
+		{"TOTAL-READS", cnt.reads},
+		{"READ-YOUR-WRITES-VIOLATIONS", cnt.readYourWrites},
+		{"MONOTONIC-READS-VIOLATIONS", cnt.monotonicReads},
+		{"MONOTONIC-WRITES-VIOLATIONS", cnt.monotonicWrites},
+	} {
+		c := dataframe.NewColumn(col.name)
+		c.PushBack(dataframe.NewStringValue(fmt.Sprintf("%d", col.v)))
+		if err := fr.AddColumn(c); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientSessionConsistencyPath); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import "testing"
+
+func Test_sessionValue(t *testing.T) {
+	v := encodeSessionValue(12, 7)
+	if string(v) != "12|aaaa" {
+		t.Fatalf("unexpected value %q", v)
+	}
+	if seq := decodeSessionValue(v); seq != 12 {
+		t.Fatalf("expected 12, got %d", seq)
+	}
+	if seq := decodeSessionValue(nil); seq != 0 {
+		t.Fatalf("expected 0, got %d", seq)
+	}
+}
+
+func Test_sessionChecker(t *testing.T) {
+	tests := []struct {
+		ops      [][3]int64 // {0: write, 1: read}, seq, version
+		expected sessionCounter
+	}{
+		{
+			ops:      [][3]int64{{0, 1, 0}, {1, 1, 10}, {0, 2, 0}, {1, 2, 11}},
+			expected: sessionCounter{writes: 2, reads: 2},
+		},
+		{ // stale read right after write
+			ops:      [][3]int64{{0, 1, 0}, {1, 0, 0}},
+			expected: sessionCounter{writes: 1, reads: 1, readYourWrites: 1},
+		},
+		{ // read goes back in time
+			ops:      [][3]int64{{0, 1, 0}, {1, 1, 10}, {0, 2, 0}, {1, 2, 11}, {1, 1, 10}},
+			expected: sessionCounter{writes: 2, reads: 3, readYourWrites: 1, monotonicReads: 1},
+		},
+		{ // later write ordered before earlier write
+			ops:      [][3]int64{{0, 1, 0}, {1, 1, 10}, {0, 2, 0}, {1, 2, 9}},
+			expected: sessionCounter{writes: 2, reads: 2, monotonicWrites: 1},
+		},
+	}
+	for i, tt := range tests {
+		cnt := &sessionCounter{}
+		c := &sessionChecker{cnt: cnt}
+		for _, op := range tt.ops {
+			if op[0] == 0 {
+				c.wrote(op[1])
+			} else {
+				c.read(op[1], op[2])
+			}
+		}
+		if *cnt != tt.expected {
+			t.Fatalf("#%d: expected %+v, got %+v", i, tt.expected, *cnt)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dbtester
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/etcd-io/dbtester/dbtesterpb"
+
+	"github.com/gyuho/dataframe"
+	"go.uber.org/zap"
+)
+
+// snapshotRecovery is the result of "snapshot" benchmark.
+type snapshotRecovery struct {
+	keys int64
+
+	snapshotSizeBytes int64
+	snapshotTook      time.Duration
+
+	// recoverTook is the time for the agent to wipe the member
+	// and recover it in the cluster (see 'SnapshotRecover' for
+	// how each database uses the snapshot), and catchUpTook is the time
+	// from then until it applies all entries of the other members.
+	recoverTook time.Duration
+	catchUpTook time.Duration
+}
+
+// stressSnapshot writes the keys, saves the snapshot of the member at
+// 'SnapshotMemberIndex', wipes and recovers the member, and waits for
+// the member to catch up with the others.
+// Run it with different 'RequestNumber' to compare by keyspace size.
+func (cfg *Config) stressSnapshot(databaseID string, dr driver, gcfg dbtesterpb.ConfigClientMachineAgentControl, vals values) error {
+	h, done := newWriteHandlers(cfg.lg, dr, gcfg)
+	reqGen := func(inflightReqs chan<- request) { generateWrites(dr, gcfg, 0, vals, inflightReqs) }
+	cfg.generateReport(gcfg, h, done, reqGen)
+
+	sr := snapshotRecovery{keys: gcfg.ConfigClientMachineBenchmarkOptions.RequestNumber}
+	if gcfg.ConfigClientMachineBenchmarkOptions.SameKey {
+		sr.keys = 1
+	}
+
+	idx := int(gcfg.ConfigClientMachineBenchmarkOptions.SnapshotMemberIndex)
+	cfg.lg.Info("saving snapshot", zap.String("database-id", databaseID), zap.Int("member-index", idx))
+	resp, err := cfg.SendRequest(databaseID, dbtesterpb.Operation_SnapshotSave, idx)
+	if err != nil {
+		return err
+	}
+	sr.snapshotSizeBytes = resp.SnapshotSizeBytes
+	sr.snapshotTook = time.Duration(resp.SnapshotMillisecond) * time.Millisecond
+
+	cfg.lg.Info("recovering member", zap.String("database-id", databaseID), zap.Int("member-index", idx))
+	resp, err = cfg.SendRequest(databaseID, dbtesterpb.Operation_SnapshotRecover, idx)
+	if err != nil {
+		return err
+	}
+	sr.recoverTook = time.Duration(resp.RecoverMillisecond) * time.Millisecond
+	recovered := time.Now()
+
+	var others []uint32
+	for _, o := range newClusterMembers(gcfg).ipIndexes() {
+		if int(o) != idx {
+			others = append(others, o)
+		}
+	}
+	if sr.catchUpTook, err = waitCatchUp(gcfg, idx, others, recovered); err != nil {
+		return err
+	}
+
+	cfg.saveSnapshotRecovery(sr)
+	return nil
+}
+
+func (cfg *Config) saveSnapshotRecovery(sr snapshotRecovery) {
+	cfg.lg.Info("snapshot results",
+		zap.Int64("keys", sr.keys),
+		zap.Int64("snapshot-size-bytes", sr.snapshotSizeBytes),
+		zap.Duration("snapshot-took", sr.snapshotTook),
+		zap.Duration("recover-took", sr.recoverTook),
+		zap.Duration("catch-up-took", sr.catchUpTook),
+	)
+
+	fr := dataframe.New()
+	for _, col := range []struct {
+		name string
+		v    string
+	}{
+		{"KEYS", fmt.Sprintf("%d", sr.keys)},
+		{"SNAPSHOT-SIZE-BYTES", fmt.Sprintf("%d", sr.snapshotSizeBytes)},
+		{"SNAPSHOT-MILLISECOND", fmt.Sprintf("%.3f", toMillisecond(sr.snapshotTook))},
+		{"RECOVER-MILLISECOND", fmt.Sprintf("%.3f", toMillisecond(sr.recoverTook))},
+		{"CATCH-UP-MILLISECOND", fmt.Sprintf("%.3f", toMillisecond(sr.catchUpTook))},
+	} {
+		c := dataframe.NewColumn(col.name)
+		c.PushBack(dataframe.NewStringValue(col.v))
+		if err := fr.AddColumn(c); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := fr.CSVHorizontal(cfg.ConfigClientMachineInitial.ClientSnapshotPath); err != nil {
+		panic(err)
+	}
+}